@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/v6/binary"
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/printer"
+	"github.com/philandstuff/dhall-golang/v6/term"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// cmdFormat rewrites each file named in its arguments in the standard
+// format, keeping its comments.  With no arguments, it formats stdin
+// to stdout.  With --check, it changes nothing, but lists the files
+// which aren't formatted and fails if there are any.
+func cmdFormat(c *cli.Context) error {
+	check := c.Bool("check")
+	if c.NArg() == 0 {
+		source, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		formatted, err := format("(stdin)", source)
+		if err != nil {
+			return err
+		}
+		if check {
+			if !bytes.Equal(source, formatted) {
+				return fmt.Errorf("(stdin) is not formatted")
+			}
+			return nil
+		}
+		_, err = os.Stdout.Write(formatted)
+		return err
+	}
+	var unformatted int
+	for _, filename := range c.Args().Slice() {
+		source, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		formatted, err := format(filename, source)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(source, formatted) {
+			continue
+		}
+		if check {
+			fmt.Println(filename)
+			unformatted++
+			continue
+		}
+		if err = rewriteFile(filename, formatted); err != nil {
+			return err
+		}
+	}
+	if unformatted > 0 {
+		return fmt.Errorf("%d of %d files are not formatted", unformatted, c.NArg())
+	}
+	return nil
+}
+
+// format returns source in the standard format, with its comments.
+func format(name string, source []byte) ([]byte, error) {
+	expr, comments, err := parseWithComments(name, source)
+	if err != nil {
+		return nil, err
+	}
+	return printWithComments(name, expr, comments)
+}
+
+// parseWithComments parses source, recording its comments.
+func parseWithComments(name string, source []byte) (term.Term, *parser.Comments, error) {
+	comments := parser.NewComments()
+	expr, err := parser.Parse(name, source, parser.RecordComments(comments))
+	if err != nil {
+		return nil, nil, err
+	}
+	return expr, comments, nil
+}
+
+// printWithComments prints expr in the standard format, with
+// comments, and checks that what it prints parses back as expr with
+// all the comments.
+func printWithComments(name string, expr term.Term, comments *parser.Comments) ([]byte, error) {
+	formatted := []byte((&printer.Config{Comments: comments}).Sprint(expr) + "\n")
+	reparsedComments := parser.NewComments()
+	reparsed, err := parser.Parse(name, formatted, parser.RecordComments(reparsedComments))
+	if err != nil || !sameTerm(expr, reparsed) ||
+		len(reparsedComments.All()) != len(comments.All()) {
+		return nil, fmt.Errorf("Can't format %s: formatting would change it", name)
+	}
+	return formatted, nil
+}
+
+// rewriteFile replaces the contents of filename with data, keeping
+// its permissions.
+func rewriteFile(filename string, data []byte) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, info.Mode())
+}
+
+// sameTerm reports whether a and b have the same binary encoding,
+// which unlike reflect.DeepEqual treats NaN as equal to itself.
+func sameTerm(a, b term.Term) bool {
+	var bufA, bufB bytes.Buffer
+	if binary.EncodeAsCbor(&bufA, a) != nil || binary.EncodeAsCbor(&bufB, b) != nil {
+		return false
+	}
+	return bytes.Equal(bufA.Bytes(), bufB.Bytes())
+}
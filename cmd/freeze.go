@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/philandstuff/dhall-golang/v6/term"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// cmdFreeze protects the remote imports of a file with their semantic
+// hashes, rewriting it in the standard format with its comments.  With
+// --all, it protects local imports too, and with --cache, it adds an
+// unprotected fallback to each protected import.  With no arguments,
+// it freezes stdin to stdout.
+func cmdFreeze(c *cli.Context) error {
+	var source []byte
+	var name string
+	var ancestors []term.Fetchable
+	var err error
+	switch c.NArg() {
+	case 0:
+		name = "(stdin)"
+		source, err = ioutil.ReadAll(os.Stdin)
+	case 1:
+		name = c.Args().First()
+		ancestors = []term.Fetchable{term.LocalFile(name)}
+		source, err = ioutil.ReadFile(name)
+	default:
+		return errors.New("Expected at most one argument, the file containing the Dhall expression")
+	}
+	if err != nil {
+		return err
+	}
+	expr, comments, err := parseWithComments(name, source)
+	if err != nil {
+		return err
+	}
+	freezer := imports.Freezer{All: c.Bool("all"), Cache: c.Bool("cache")}
+	frozen, err := freezer.Freeze(expr, ancestors...)
+	if err != nil {
+		return err
+	}
+	comments.Transfer(expr, frozen)
+	formatted, err := printWithComments(name, frozen, comments)
+	if err != nil {
+		return err
+	}
+	if c.NArg() == 0 {
+		_, err = os.Stdout.Write(formatted)
+		return err
+	}
+	return rewriteFile(name, formatted)
+}
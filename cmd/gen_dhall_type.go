@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// genDhallTypeMain is a program which prints the Dhall type for a
+// single Go type.  We need to compile the Go type into a program to
+// be able to inspect it with reflection.
+var genDhallTypeMain = template.Must(template.New("main").Parse(`package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/philandstuff/dhall-golang/v6"
+	"github.com/philandstuff/dhall-golang/v6/core"
+
+	target {{printf "%q" .Package}}
+)
+
+func main() {
+	typ, err := dhall.TypeFor(reflect.TypeOf((*target.{{.Type}})(nil)).Elem())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(core.Quote(typ))
+}
+`))
+
+// cmdGenDhallType prints the Dhall type corresponding to a Go type,
+// given as PACKAGE.TYPE.  It works by generating a small program in
+// the current module and running it with `go run`, so it must be run
+// from within a Go module which can import both the package and
+// dhall-golang.
+func cmdGenDhallType(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return errors.New("Expected exactly one argument, of the form PACKAGE.TYPE")
+	}
+	arg := c.Args().First()
+	dot := strings.LastIndex(arg, ".")
+	if dot <= strings.LastIndex(arg, "/") || dot == len(arg)-1 {
+		return fmt.Errorf("Can't find a type name in %s; expected PACKAGE.TYPE", arg)
+	}
+	pkg, typeName := arg[:dot], arg[dot+1:]
+	if strings.HasPrefix(pkg, ".") {
+		// resolve a relative package path to an import path
+		out, err := exec.Command("go", "list", "-f", "{{.ImportPath}}", pkg).Output()
+		if err != nil {
+			return fmt.Errorf("Can't find package %s: %v", pkg, err)
+		}
+		pkg = strings.TrimSpace(string(out))
+	}
+
+	// the program must be inside the current module, so that it
+	// can import the package
+	dir, err := ioutil.TempDir(".", "gen-dhall-type")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	mainFile, err := os.Create(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return err
+	}
+	err = genDhallTypeMain.Execute(mainFile, struct{ Package, Type string }{pkg, typeName})
+	if err != nil {
+		mainFile.Close()
+		return err
+	}
+	if err = mainFile.Close(); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "run", "./"+filepath.Base(dir))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/gengo"
+	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// cmdGenGo reads a Dhall type from a file (or stdin) and prints Go
+// type definitions corresponding to it.
+func cmdGenGo(c *cli.Context) error {
+	expr, _, ancestors, err := parseInput(c)
+	if err != nil {
+		return err
+	}
+	resolved, err := imports.Load(expr, ancestors...)
+	if err != nil {
+		return err
+	}
+	kind, err := core.TypeOf(resolved)
+	if err != nil {
+		return err
+	}
+	if kind != core.Type {
+		return fmt.Errorf("Expected a Dhall type, but got an expression of type %v", core.Quote(kind))
+	}
+	src, err := gengo.Generate(c.String("package"), c.String("type"), core.Eval(resolved))
+	if err != nil {
+		return err
+	}
+	if output := c.String("output"); output != "" {
+		return ioutil.WriteFile(output, src, 0644)
+	}
+	_, err = os.Stdout.Write(src)
+	return err
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/term"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// parseInput parses Dhall source from the file named by the single
+// argument of c, or from stdin if there are no arguments.  It also
+// returns the name of the input, and the ancestors to resolve the
+// source's imports against.
+func parseInput(c *cli.Context) (term.Term, string, []term.Fetchable, error) {
+	switch c.NArg() {
+	case 0:
+		expr, err := parser.ParseReader("-", os.Stdin)
+		return expr, "(stdin)", nil, err
+	case 1:
+		filename := c.Args().First()
+		expr, err := parser.ParseFile(filename)
+		return expr, filename, []term.Fetchable{term.LocalFile(filename)}, err
+	default:
+		return nil, "", nil, errors.New("Expected at most one argument, the file containing the Dhall expression")
+	}
+}
@@ -11,7 +11,8 @@ import (
 
 func cmdJSON(c *cli.Context) error {
 	var data interface{}
-	err := dhall.UnmarshalReader("-", os.Stdin, &data)
+	config := dhall.DecoderConfig{PreserveFieldOrder: c.Bool("preserve-order")}
+	err := config.UnmarshalReader("-", os.Stdin, &data)
 	if err != nil {
 		return err
 	}
@@ -21,13 +21,103 @@ func main() {
 			{
 				Name:   "json",
 				Usage:  "output Dhall code as JSON",
+				Flags:  []cli.Flag{preserveOrderFlag},
 				Action: cmdJSON,
 			},
 			{
 				Name:   "yaml",
 				Usage:  "output Dhall code as YAML",
+				Flags:  []cli.Flag{preserveOrderFlag},
 				Action: cmdYAML,
 			},
+			{
+				Name:      "type",
+				Usage:     "output the type of Dhall code",
+				ArgsUsage: "[FILE]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:    "quiet",
+						Aliases: []string{"q"},
+						Usage:   "only check that the code is well-typed",
+					},
+				},
+				Action: cmdType,
+			},
+			{
+				Name:      "normalize",
+				Usage:     "output the normal form of Dhall code",
+				ArgsUsage: "[FILE]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "alpha",
+						Usage: "also alpha-normalize, renaming every bound variable to _",
+					},
+				},
+				Action: cmdNormalize,
+			},
+			{
+				Name:      "resolve",
+				Usage:     "output Dhall code with its imports resolved",
+				ArgsUsage: "[FILE]",
+				Action:    cmdResolve,
+			},
+			{
+				Name:      "format",
+				Usage:     "rewrite Dhall files in the standard format",
+				ArgsUsage: "[FILE...]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "check",
+						Usage: "only list the files which aren't formatted, failing if there are any",
+					},
+				},
+				Action: cmdFormat,
+			},
+			{
+				Name:      "freeze",
+				Usage:     "protect the imports of a Dhall file with their semantic hashes",
+				ArgsUsage: "[FILE]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "all",
+						Usage: "protect local imports as well as remote ones",
+					},
+					&cli.BoolFlag{
+						Name:  "cache",
+						Usage: "add an unprotected fallback to each protected import, using the hash only for caching",
+					},
+				},
+				Action: cmdFreeze,
+			},
+			{
+				Name:      "gen-dhall-type",
+				Usage:     "output the Dhall type corresponding to a Go type",
+				ArgsUsage: "PACKAGE.TYPE",
+				Action:    cmdGenDhallType,
+			},
+			{
+				Name:      "gen-go",
+				Usage:     "output Go type definitions corresponding to a Dhall type",
+				ArgsUsage: "[FILE]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "package",
+						Usage:    "Go package name for the generated code",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "type",
+						Usage: "name of the generated Go type",
+						Value: "Config",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "write to `FILE` instead of stdout",
+					},
+				},
+				Action: cmdGenGo,
+			},
 		},
 		Action: cmdDebug,
 	}
@@ -38,6 +128,11 @@ func main() {
 	}
 }
 
+var preserveOrderFlag = &cli.BoolFlag{
+	Name:  "preserve-order",
+	Usage: "output record fields in the order they appear in the source, rather than sorted",
+}
+
 // cmdDebug is the original scrappy debug command
 func cmdDebug(c *cli.Context) error {
 	expr, err := parser.ParseReader("-", os.Stdin)
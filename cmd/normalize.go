@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// cmdNormalize reads a Dhall expression from a file (or stdin),
+// resolves its imports, typechecks it and prints its beta-normal
+// form.  With --alpha, it prints the alpha-beta-normal form, in which
+// every bound variable is named _.
+func cmdNormalize(c *cli.Context) error {
+	expr, name, ancestors, err := parseInput(c)
+	if err != nil {
+		return err
+	}
+	resolved, err := imports.Load(expr, ancestors...)
+	if err != nil {
+		return err
+	}
+	if _, err = core.TypeOf(resolved); err != nil {
+		return fmt.Errorf("Type error in %s: %v", name, err)
+	}
+	value := core.Eval(resolved)
+	if c.Bool("alpha") {
+		fmt.Println(core.QuoteAlphaNormal(value))
+	} else {
+		fmt.Println(core.Quote(value))
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// cmdResolve reads a Dhall expression from a file (or stdin) and
+// prints it with its imports resolved and inlined, but otherwise
+// unevaluated.
+func cmdResolve(c *cli.Context) error {
+	expr, _, ancestors, err := parseInput(c)
+	if err != nil {
+		return err
+	}
+	resolved, err := imports.Loader{Unevaluated: true}.Load(expr, ancestors...)
+	if err != nil {
+		return err
+	}
+	fmt.Println(resolved)
+	return nil
+}
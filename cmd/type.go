@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/urfave/cli/v2" // imports as package "cli"
+)
+
+// cmdType reads a Dhall expression from a file (or stdin), resolves
+// its imports and prints its type.  With --quiet, it prints nothing,
+// so it only checks that the expression is well-typed.
+func cmdType(c *cli.Context) error {
+	expr, name, ancestors, err := parseInput(c)
+	if err != nil {
+		return err
+	}
+	resolved, err := imports.Load(expr, ancestors...)
+	if err != nil {
+		return err
+	}
+	typ, err := core.TypeOf(resolved)
+	if err != nil {
+		return fmt.Errorf("Type error in %s: %v", name, err)
+	}
+	if !c.Bool("quiet") {
+		fmt.Println(core.Quote(typ))
+	}
+	return nil
+}
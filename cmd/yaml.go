@@ -11,14 +11,41 @@ import (
 
 func cmdYAML(c *cli.Context) error {
 	var data interface{}
-	err := dhall.UnmarshalReader("-", os.Stdin, &data)
+	config := dhall.DecoderConfig{PreserveFieldOrder: c.Bool("preserve-order")}
+	err := config.UnmarshalReader("-", os.Stdin, &data)
 	if err != nil {
 		return err
 	}
-	b, err := yaml.Marshal(data)
+	b, err := yaml.Marshal(yamlValue(data))
 	if err != nil {
 		return err
 	}
 	fmt.Print(string(b))
 	return nil
 }
+
+// yamlValue converts the dhall.OrderedMaps in data to yaml.MapSlices,
+// which yaml.Marshal keeps in order.
+func yamlValue(data interface{}) interface{} {
+	switch data := data.(type) {
+	case dhall.OrderedMap:
+		slice := make(yaml.MapSlice, len(data))
+		for i, item := range data {
+			slice[i] = yaml.MapItem{Key: item.Key, Value: yamlValue(item.Value)}
+		}
+		return slice
+	case []interface{}:
+		for i, elem := range data {
+			data[i] = yamlValue(elem)
+		}
+	case map[string]interface{}:
+		for key, elem := range data {
+			data[key] = yamlValue(elem)
+		}
+	case map[interface{}]interface{}:
+		for key, elem := range data {
+			data[key] = yamlValue(elem)
+		}
+	}
+	return data
+}
@@ -207,7 +207,7 @@ func (l lambda) ArgType() Value {
 }
 
 func (u unionConstructor) Call(v Value) Value {
-	return unionVal{
+	return UnionVal{
 		Type:        u.Type,
 		Alternative: u.Alternative,
 		Val:         v,
@@ -357,7 +357,9 @@ type (
 		Alternative string
 	}
 
-	unionVal struct {
+	// A UnionVal is a Value representing a Dhall union value: one
+	// Alternative of the union Type, together with its Val.
+	UnionVal struct {
 		Type        UnionType
 		Alternative string
 		Val         Value // nil for empty alternatives
@@ -411,6 +413,6 @@ func (project) isValue()          {}
 func (with) isValue()             {}
 func (UnionType) isValue()        {}
 func (unionConstructor) isValue() {}
-func (unionVal) isValue()         {}
+func (UnionVal) isValue()         {}
 func (merge) isValue()            {}
 func (assert) isValue()           {}
@@ -55,10 +55,19 @@ func (fold naturalFold) Call(x Value) Value {
 			succ: x,
 		}
 	}
-	zero := x
+	return fold.run(x, nil)
+}
+
+// run applies a fully-applied Natural/fold to zero, calling check (if
+// not nil) with the result so far before each application of succ.
+// It returns nil if n is not a literal.
+func (fold naturalFold) run(zero Value, check func(Value)) Value {
 	if n, ok := fold.n.(NaturalLit); ok {
 		result := zero
 		for i := 0; i < int(n); i++ {
+			if check != nil {
+				check(result)
+			}
 			result = apply(fold.succ, result)
 		}
 		return result
@@ -53,6 +53,17 @@ func alphaEquivalentWith(level int, v1 Value, v2 Value) bool {
 				v1.Codomain(quoteVar{Name: "_", Index: level}),
 				v2.Codomain(quoteVar{Name: "_", Index: level}),
 			)
+	case goFunc:
+		v2, ok := v2.(goFunc)
+		if !ok || v1.name != v2.name || len(v1.args) != len(v2.args) {
+			return false
+		}
+		for i := range v1.args {
+			if !alphaEquivalentWith(level, v1.args[i], v2.args[i]) {
+				return false
+			}
+		}
+		return true
 	case app:
 		v2, ok := v2.(app)
 		if !ok {
@@ -241,8 +252,8 @@ func alphaEquivalentWith(level int, v1 Value, v2 Value) bool {
 			return false
 		}
 		return alphaEquivalentWith(level, v1.Type, v2.Type)
-	case unionVal:
-		v2, ok := v2.(unionVal)
+	case UnionVal:
+		v2, ok := v2.(UnionVal)
 		if !ok {
 			return false
 		}
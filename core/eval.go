@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -8,14 +9,76 @@ import (
 	"github.com/philandstuff/dhall-golang/v6/term"
 )
 
-type env map[string][]Value
+// An env holds the values of the variables in scope, and the
+// evaluation they are being evaluated in, if any.
+type env struct {
+	vars map[string][]Value
+	eval *evaluation
+}
+
+func emptyEnv() env { return env{vars: map[string][]Value{}} }
+
+// extend returns a copy of e with label bound to x.
+func (e env) extend(label string, x Value) env {
+	newEnv := env{vars: make(map[string][]Value, len(e.vars)+1), eval: e.eval}
+	for k, v := range e.vars {
+		newEnv.vars[k] = v
+	}
+	newEnv.vars[label] = append([]Value{x}, newEnv.vars[label]...)
+	return newEnv
+}
 
 // Eval normalizes Term to a Value.
 func Eval(t term.Term) Value {
-	return evalWith(t, env{})
+	return evalWith(t, emptyEnv())
+}
+
+// EvalContext normalizes Term to a Value, like Eval, but gives up and
+// returns ctx.Err() if ctx is cancelled or its deadline passes before
+// it has finished.  Functions in the returned Value don't check ctx
+// when they are later applied.
+func EvalContext(ctx context.Context, t term.Term) (Value, error) {
+	return Evaluator{}.Eval(ctx, t)
+}
+
+// EvalWithContext is like EvalWith, but gives up in the same way as
+// EvalContext.
+func EvalWithContext(ctx context.Context, bindings map[string]Binding, t term.Term) (Value, error) {
+	return Evaluator{Bindings: bindings}.Eval(ctx, t)
+}
+
+// A Binding is a value, and its type, which is given to a Term as a
+// free variable by TypeOfWith and EvalWith.
+type Binding struct {
+	Type  Value
+	Value Value
+}
+
+// EvalWith evaluates a well-typed Term in which the names in bindings
+// are free variables with the given values.
+func EvalWith(bindings map[string]Binding, t term.Term) Value {
+	return evalWith(t, bindingsEnv(bindings))
+}
+
+func bindingsEnv(bindings map[string]Binding) env {
+	e := emptyEnv()
+	for name, binding := range bindings {
+		e.vars[name] = []Value{binding.Value}
+	}
+	return e
 }
 
 func evalWith(t term.Term, e env) Value {
+	if e.eval == nil {
+		return evalTerm(t, e)
+	}
+	e.eval.enter()
+	v := evalTerm(t, e)
+	e.eval.leave(v)
+	return v
+}
+
+func evalTerm(t term.Term, e env) Value {
 	switch t := t.(type) {
 	case term.Universe:
 		return Universe(t)
@@ -85,10 +148,10 @@ func evalWith(t term.Term, e env) Value {
 			return Builtin(t)
 		}
 	case term.Var:
-		if t.Index >= len(e[t.Name]) {
-			return freeVar{t.Name, t.Index - len(e[t.Name])}
+		if t.Index >= len(e.vars[t.Name]) {
+			return freeVar{t.Name, t.Index - len(e.vars[t.Name])}
 		}
-		return e[t.Name][t.Index]
+		return e.vars[t.Name][t.Index]
 	case term.LocalVar:
 		return localVar(t)
 	case term.Lambda:
@@ -96,12 +159,7 @@ func evalWith(t term.Term, e env) Value {
 			Label:  t.Label,
 			Domain: evalWith(t.Type, e),
 			Fn: func(x Value) Value {
-				newEnv := env{}
-				for k, v := range e {
-					newEnv[k] = v
-				}
-				newEnv[t.Label] = append([]Value{x}, newEnv[t.Label]...)
-				return evalWith(t.Body, newEnv)
+				return evalWith(t.Body, e.extend(t.Label, x))
 			},
 		}
 	case term.Pi:
@@ -109,26 +167,24 @@ func evalWith(t term.Term, e env) Value {
 			Label:  t.Label,
 			Domain: evalWith(t.Type, e),
 			Codomain: func(x Value) Value {
-				newEnv := env{}
-				for k, v := range e {
-					newEnv[k] = v
-				}
-				newEnv[t.Label] = append([]Value{x}, newEnv[t.Label]...)
-				return evalWith(t.Body, newEnv)
+				return evalWith(t.Body, e.extend(t.Label, x))
 			}}
 	case term.App:
 		fn := evalWith(t.Fn, e)
 		arg := evalWith(t.Arg, e)
+		if fold, ok := fn.(naturalFold); ok && fold.succ != nil && e.eval != nil {
+			// Natural/fold can loop for a long time without
+			// evaluating any terms, so we check as it goes
+			if result := fold.run(arg, e.eval.iterate); result != nil {
+				return result
+			}
+		}
 		return apply(fn, arg)
 	case term.Let:
-		newEnv := env{}
-		for k, v := range e {
-			newEnv[k] = v
-		}
-
+		newEnv := e
 		for _, b := range t.Bindings {
 			val := evalWith(b.Value, newEnv)
-			newEnv[b.Variable] = append([]Value{val}, newEnv[b.Variable]...)
+			newEnv = newEnv.extend(b.Variable, val)
 		}
 		return evalWith(t.Body, newEnv)
 	case term.Annot:
@@ -464,7 +520,7 @@ func evalWith(t term.Term, e env) Value {
 		}
 		if union, ok := record.(UnionType); ok {
 			if union[t.FieldName] == nil {
-				return unionVal{
+				return UnionVal{
 					Type:        union,
 					Alternative: t.FieldName,
 				}
@@ -558,7 +614,7 @@ func evalWith(t term.Term, e env) Value {
 		handlerVal := evalWith(t.Handler, e)
 		union := evalWith(t.Union, e)
 		if handlers, ok := handlerVal.(RecordLit); ok {
-			if unionLit, ok := union.(unionVal); ok {
+			if unionLit, ok := union.(UnionVal); ok {
 				if unionLit.Val == nil {
 					// empty union alternative
 					return handlers[unionLit.Alternative]
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/philandstuff/dhall-golang/v6/term"
@@ -11,11 +14,11 @@ var _ = Describe("Eval", func() {
 		Expect(Eval(term.Type)).To(Equal(Type))
 	})
 	It("Bound variable", func() {
-		Expect(evalWith(term.Var{Name: "foo"}, env{"foo": []Value{Type}})).
+		Expect(evalWith(term.Var{Name: "foo"}, emptyEnv().extend("foo", Type))).
 			To(Equal(Type))
 	})
 	It("Free variable", func() {
-		Expect(evalWith(term.Var{Name: "foo"}, env{})).
+		Expect(evalWith(term.Var{Name: "foo"}, emptyEnv())).
 			To(Equal(freeVar{Name: "foo"}))
 	})
 	It("Lambda id function", func() {
@@ -49,3 +52,45 @@ var _ = Describe("Eval", func() {
 		})
 	})
 })
+
+var _ = Describe("EvalContext", func() {
+	// Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0
+	slowFold := term.Apply(term.NaturalFold,
+		term.NaturalLit(1000000000),
+		term.Natural,
+		term.NewLambda("x", term.Natural, term.NaturalPlus(term.NewVar("x"), term.NaturalLit(1))),
+		term.NaturalLit(0))
+	It("Evaluates like Eval", func() {
+		Expect(EvalContext(context.Background(), term.NaturalPlus(term.NaturalLit(1), term.NaturalLit(2)))).
+			To(Equal(NaturalLit(3)))
+	})
+	It("Fails if the context is already cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := EvalContext(ctx, term.NaturalLit(1))
+		Expect(err).To(Equal(context.Canceled))
+	})
+	It("Gives up when the deadline passes", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := EvalContext(ctx, slowFold)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+	It("Gives up on Natural/fold of a builtin", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := EvalContext(ctx, term.Apply(term.NaturalFold,
+			term.NaturalLit(1000000000000),
+			term.Integer,
+			term.IntegerNegate,
+			term.IntegerLit(1)))
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+	It("Returns functions which ignore the context", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		f, err := EvalContext(ctx, term.NewLambda("x", term.Natural, term.NaturalPlus(term.NewVar("x"), term.NaturalLit(1))))
+		Expect(err).ToNot(HaveOccurred())
+		cancel()
+		Expect(apply(f, NaturalLit(1))).To(Equal(NaturalLit(2)))
+	})
+})
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// Limits bound the resources used by a single typecheck or
+// evaluation, so that untrusted Dhall can't run forever or use up
+// all available memory.  A zero field means no limit.
+type Limits struct {
+	// MaxSteps is the maximum number of evaluation steps.  Each
+	// subterm evaluated is a step, as is each iteration of
+	// Natural/fold.
+	MaxSteps int
+	// MaxDepth is the maximum nesting of subterms being evaluated,
+	// which bounds the stack space used.
+	MaxDepth int
+	// MaxListLength is the maximum length of a List value.
+	MaxListLength int
+	// MaxTextLength is the maximum length, in bytes, of a Text
+	// value.
+	MaxTextLength int
+}
+
+// A LimitError is returned when a typecheck or evaluation exceeds one
+// of its Limits.
+type LimitError struct {
+	// Limit is the limit which was exceeded: one of "steps",
+	// "depth", "list length" or "text length".
+	Limit string
+	Max   int // the value of the limit
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("Exceeded evaluation limit: %s is limited to %d", e.Limit, e.Max)
+}
+
+// An Evaluator typechecks and evaluates Terms in which the names in
+// Bindings are free variables, giving up if its context is done or
+// if it exceeds its Limits.  The zero Evaluator has no bindings and
+// no limits.
+type Evaluator struct {
+	Bindings map[string]Binding
+	Limits   Limits
+}
+
+// TypeOf typechecks t, as TypeOfWith does with ev.Bindings.  The
+// evaluation done while typechecking, such as of let bindings and
+// assertions, is subject to ctx and to ev.Limits.
+func (ev Evaluator) TypeOf(ctx context.Context, t term.Term) (typ Value, err error) {
+	eval, err := ev.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	abortErr := eval.run(func() {
+		typ, err = typeOfWith(eval, ev.Bindings, t)
+	})
+	if abortErr != nil {
+		return nil, abortErr
+	}
+	return typ, err
+}
+
+// Eval evaluates the well-typed Term t, as EvalWith does with
+// ev.Bindings.  It returns ctx.Err() if ctx is cancelled or its
+// deadline passes before it has finished, or a *LimitError if it
+// exceeds one of ev.Limits.  Functions in the returned Value are not
+// limited when they are later applied.
+func (ev Evaluator) Eval(ctx context.Context, t term.Term) (result Value, err error) {
+	e := bindingsEnv(ev.Bindings)
+	e.eval, err = ev.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = e.eval.run(func() {
+		result = evalWith(t, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// start returns a new evaluation with ev's limits and ctx, or nil if
+// there is nothing for it to check.
+func (ev Evaluator) start(ctx context.Context) (*evaluation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if ctx.Done() == nil && ev.Limits == (Limits{}) {
+		return nil, nil
+	}
+	return &evaluation{ctx: ctx, done: ctx.Done(), limits: ev.Limits}, nil
+}
+
+// An evaluation holds the state of a single call to Evaluator.TypeOf
+// or Evaluator.Eval.  A nil *evaluation checks nothing.
+type evaluation struct {
+	ctx    context.Context
+	done   <-chan struct{}
+	limits Limits
+	steps  int
+	depth  int
+	// finished is set when the evaluation returns, so that
+	// functions it returned are no longer checked.
+	finished bool
+}
+
+// An evalAbort is panicked with to abandon an evaluation, and
+// recovered by evaluation.run.
+type evalAbort struct{ err error }
+
+// run calls f, returning the error which abandoned the evaluation, if
+// any.
+func (ev *evaluation) run(f func()) (err error) {
+	if ev == nil {
+		f()
+		return nil
+	}
+	defer func() {
+		ev.finished = true
+		if r := recover(); r != nil {
+			abort, ok := r.(evalAbort)
+			if !ok {
+				panic(r)
+			}
+			err = abort.err
+		}
+	}()
+	f()
+	return nil
+}
+
+// enter is called before evaluating a subterm.
+func (ev *evaluation) enter() {
+	if ev.finished {
+		return
+	}
+	ev.step()
+	ev.depth++
+	if ev.limits.MaxDepth > 0 && ev.depth > ev.limits.MaxDepth {
+		panic(evalAbort{&LimitError{Limit: "depth", Max: ev.limits.MaxDepth}})
+	}
+}
+
+// leave is called with the result of evaluating a subterm.
+func (ev *evaluation) leave(v Value) {
+	if ev.finished {
+		return
+	}
+	ev.depth--
+	ev.checkSize(v)
+}
+
+// iterate is called before each iteration of a builtin which loops,
+// with its result so far.
+func (ev *evaluation) iterate(result Value) {
+	if ev.finished {
+		return
+	}
+	ev.checkSize(result)
+	ev.step()
+}
+
+func (ev *evaluation) step() {
+	select {
+	case <-ev.done:
+		panic(evalAbort{ev.ctx.Err()})
+	default:
+	}
+	ev.steps++
+	if ev.limits.MaxSteps > 0 && ev.steps > ev.limits.MaxSteps {
+		panic(evalAbort{&LimitError{Limit: "steps", Max: ev.limits.MaxSteps}})
+	}
+}
+
+func (ev *evaluation) checkSize(v Value) {
+	switch v := v.(type) {
+	case PlainTextLit:
+		if ev.limits.MaxTextLength > 0 && len(v) > ev.limits.MaxTextLength {
+			panic(evalAbort{&LimitError{Limit: "text length", Max: ev.limits.MaxTextLength}})
+		}
+	case NonEmptyList:
+		if ev.limits.MaxListLength > 0 && len(v) > ev.limits.MaxListLength {
+			panic(evalAbort{&LimitError{Limit: "list length", Max: ev.limits.MaxListLength}})
+		}
+	}
+}
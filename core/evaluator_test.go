@@ -0,0 +1,73 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+func expectLimitError(err error, limit string) {
+	var limitErr *core.LimitError
+	Expect(errors.As(err, &limitErr)).To(BeTrue(), "expected a LimitError, got %v", err)
+	Expect(limitErr.Limit).To(Equal(limit))
+}
+
+var _ = Describe("Evaluator", func() {
+	limits := core.Limits{
+		MaxSteps:      100000,
+		MaxDepth:      100,
+		MaxListLength: 1000,
+		MaxTextLength: 1000,
+	}
+	DescribeTable("Eval gives up on exceeding limits", func(src, limit string) {
+		t, err := parser.Parse("-", []byte(src))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = core.Evaluator{Limits: limits}.Eval(context.Background(), t)
+		expectLimitError(err, limit)
+	},
+		Entry("slow Natural/fold", `Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0`, "steps"),
+		Entry("Natural/fold of a builtin", `Natural/fold 1000000000000 Integer Integer/negate +1`, "steps"),
+		Entry("exponential List", `Natural/fold 30 (List Natural) (λ(xs : List Natural) → xs # xs) [ 1 ]`, "list length"),
+		Entry("long List/build", `List/build Natural (λ(list : Type) → λ(cons : Natural → list → list) → Natural/fold 2000 list (cons 1))`, "list length"),
+		Entry("exponential Text", `Natural/fold 30 Text (λ(t : Text) → t ++ t) "a"`, "text length"),
+		Entry("exponential Text/replace", `Natural/fold 30 Text (Text/replace "a" "aa") "a"`, "text length"),
+	)
+	It("Eval gives up on deep nesting", func() {
+		var t term.Term = term.NaturalLit(1)
+		for i := 0; i < 200; i++ {
+			t = term.NaturalPlus(term.NaturalLit(1), t)
+		}
+		_, err := core.Evaluator{Limits: limits}.Eval(context.Background(), t)
+		expectLimitError(err, "depth")
+	})
+	It("Evaluates within its limits", func() {
+		t, err := parser.Parse("-", []byte(`Natural/fold 10 (List Natural) (λ(xs : List Natural) → xs # [ 1 ]) ([] : List Natural)`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(core.Evaluator{Limits: limits}.Eval(context.Background(), t)).
+			To(HaveLen(10))
+	})
+	It("Limits evaluation while typechecking", func() {
+		t, err := parser.Parse("-", []byte(`let n = Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0 in n`))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = core.Evaluator{Limits: limits}.TypeOf(context.Background(), t)
+		expectLimitError(err, "steps")
+	})
+	It("Typechecks and evaluates with bindings", func() {
+		t, err := parser.Parse("-", []byte(`x + 1`))
+		Expect(err).ToNot(HaveOccurred())
+		ev := core.Evaluator{
+			Bindings: map[string]core.Binding{
+				"x": {Type: core.Natural, Value: core.NaturalLit(2)},
+			},
+			Limits: limits,
+		}
+		Expect(ev.TypeOf(context.Background(), t)).To(Equal(core.Natural))
+		Expect(ev.Eval(context.Background(), t)).To(Equal(core.NaturalLit(3)))
+	})
+})
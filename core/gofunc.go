@@ -0,0 +1,54 @@
+package core
+
+import "github.com/philandstuff/dhall-golang/v6/term"
+
+// A goFunc is a Callable implemented by a Go function, made by
+// NewCallable.
+type goFunc struct {
+	name    string
+	args    []Value // the arguments applied so far, for quoting
+	argType Value
+	fn      func(Value) Value
+}
+
+// NewCallable returns a Callable whose Call method calls fn, so that
+// Go functions can be used as Dhall functions.  argType is the Dhall
+// type of fn's argument.
+//
+// fn should return nil if it can't compute a result for its argument
+// (for example, if the argument is a free variable rather than a
+// literal), in which case the application is left unevaluated.  To
+// take several arguments, fn can return another Callable made by
+// NewCallable with the same name, which is treated as a partial
+// application.
+//
+// A Callable made by NewCallable has no Dhall source.  It is quoted
+// as a free variable called name (applied to any arguments it has
+// been partially applied to), and is alpha-equivalent only to
+// Callables with the same name and arguments.
+func NewCallable(name string, argType Value, fn func(Value) Value) Callable {
+	return goFunc{name: name, argType: argType, fn: fn}
+}
+
+func (goFunc) isValue() {}
+
+func (f goFunc) Call(a Value) Value {
+	result := f.fn(a)
+	if next, ok := result.(goFunc); ok && next.name == f.name {
+		next.args = append(append([]Value{}, f.args...), a)
+		return next
+	}
+	return result
+}
+
+func (f goFunc) ArgType() Value { return f.argType }
+
+// quote returns the term for f, given a function to quote its
+// arguments.
+func (f goFunc) quote(quoteArg func(Value) term.Term) term.Term {
+	var result term.Term = term.NewVar(f.name)
+	for _, arg := range f.args {
+		result = term.App{Fn: result, Arg: quoteArg(arg)}
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// upper is a Go function of type Text → Text
+var upper = NewCallable("upper", Text, func(v Value) Value {
+	if text, ok := v.(PlainTextLit); ok {
+		return PlainTextLit(strings.ToUpper(string(text)))
+	}
+	return nil
+})
+
+// add is a Go function of type Natural → Natural → Natural
+var add = NewCallable("add", Natural, func(a Value) Value {
+	return NewCallable("add", Natural, func(b Value) Value {
+		m, ok1 := a.(NaturalLit)
+		n, ok2 := b.(NaturalLit)
+		if ok1 && ok2 {
+			return m + n
+		}
+		return nil
+	})
+})
+
+var _ = Describe("NewCallable", func() {
+	It("Calls the Go function", func() {
+		Expect(upper.Call(PlainTextLit("abc"))).To(Equal(PlainTextLit("ABC")))
+		Expect(upper.ArgType()).To(Equal(Text))
+	})
+	It("Is called by Dhall functions", func() {
+		fn := Eval(term.NewLambda("f", term.NewPi("_", term.Text, term.Text),
+			term.Apply(term.NewVar("f"), term.PlainText("abc"))))
+		Expect(fn.(Callable).Call(upper)).To(Equal(PlainTextLit("ABC")))
+	})
+	It("Quotes as a free variable", func() {
+		Expect(Quote(upper)).To(Equal(term.NewVar("upper")))
+	})
+	It("Quotes partial applications", func() {
+		Expect(Quote(add.Call(NaturalLit(1)))).
+			To(Equal(term.Apply(term.NewVar("add"), term.NaturalLit(1))))
+	})
+	It("Leaves applications to free variables unevaluated", func() {
+		Expect(Quote(apply(upper, freeVar{Name: "x"}))).
+			To(Equal(term.Apply(term.NewVar("upper"), term.NewVar("x"))))
+	})
+	It("Is alpha-equivalent to Callables with the same name and arguments", func() {
+		Expect(AlphaEquivalent(upper, upper)).To(BeTrue())
+		Expect(AlphaEquivalent(add.Call(NaturalLit(1)), add.Call(NaturalLit(1)))).To(BeTrue())
+		Expect(AlphaEquivalent(add.Call(NaturalLit(1)), add.Call(NaturalLit(2)))).To(BeFalse())
+		Expect(AlphaEquivalent(upper, add)).To(BeFalse())
+	})
+})
@@ -145,6 +145,10 @@ func quoteWith(ctx quoteContext, shouldAlphaNormalize bool, v Value) term.Term {
 		return term.ListReverse
 	case freeVar:
 		return term.Var(v)
+	case goFunc:
+		return v.quote(func(arg Value) term.Term {
+			return quoteWith(ctx, shouldAlphaNormalize, arg)
+		})
 	case localVar:
 		return term.LocalVar(v)
 	case quoteVar:
@@ -278,7 +282,7 @@ func quoteWith(ctx quoteContext, shouldAlphaNormalize bool, v Value) term.Term {
 			Record:    quoteWith(ctx, shouldAlphaNormalize, v.Type),
 			FieldName: v.Alternative,
 		}
-	case unionVal:
+	case UnionVal:
 		var result term.Term = term.Field{
 			Record:    quoteWith(ctx, shouldAlphaNormalize, v.Type),
 			FieldName: v.Alternative,
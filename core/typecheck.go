@@ -1,27 +1,39 @@
 package core
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/philandstuff/dhall-golang/v6/term"
 )
 
-type context map[string][]Value
+// A typeContext holds the types of the variables in scope, and the
+// evaluation which any evaluation while typechecking is part of, if
+// any.
+type typeContext struct {
+	types map[string][]Value
+	eval  *evaluation
+}
 
-func (ctx context) extend(name string, t Value) context {
-	newctx := context{}
-	for k, v := range ctx {
-		newctx[k] = v
+func (ctx typeContext) extend(name string, t Value) typeContext {
+	newctx := typeContext{types: make(map[string][]Value, len(ctx.types)+1), eval: ctx.eval}
+	for k, v := range ctx.types {
+		newctx.types[k] = v
 	}
-	newctx[name] = append(newctx[name], t)
+	newctx.types[name] = append(newctx.types[name], t)
 	return newctx
 }
 
-func (ctx context) freshLocal(name string) term.LocalVar {
-	return term.LocalVar{Name: name, Index: len(ctx[name])}
+func (ctx typeContext) freshLocal(name string) term.LocalVar {
+	return term.LocalVar{Name: name, Index: len(ctx.types[name])}
+}
+
+// evaluate evaluates t as part of ctx's evaluation.
+func (ctx typeContext) evaluate(t term.Term) Value {
+	return evalWith(t, env{vars: map[string][]Value{}, eval: ctx.eval})
 }
 
-func assertTypeIs(ctx context, expr term.Term, expectedType Value, msg typeMessage) error {
+func assertTypeIs(ctx typeContext, expr term.Term, expectedType Value, msg typeMessage) error {
 	actualType, err := typeWith(ctx, expr)
 	if err != nil {
 		return err
@@ -57,14 +69,41 @@ func functionCheck(input Universe, output Universe) Universe {
 // TypeOf typechecks a Term, returning the type in normal form.  If
 // typechecking fails, an error is returned.
 func TypeOf(t term.Term) (Value, error) {
-	v, err := typeWith(context{}, t)
+	v, err := typeWith(typeContext{}, t)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-func typeWith(ctx context, t term.Term) (Value, error) {
+// TypeOfContext typechecks a Term, like TypeOf, but gives up and
+// returns ctx.Err() if ctx is cancelled or its deadline passes before
+// it has finished.
+func TypeOfContext(ctx context.Context, t term.Term) (Value, error) {
+	return Evaluator{}.TypeOf(ctx, t)
+}
+
+// TypeOfWith typechecks a Term in which the names in bindings are
+// free variables with the given types, returning the Term's type or
+// an error.
+//
+// Only the bindings' types are used when typechecking; their values
+// are not.  This means that a binding of type Type can't be used as a
+// type, because the typechecker can't see which type it is.
+func TypeOfWith(bindings map[string]Binding, t term.Term) (Value, error) {
+	return typeOfWith(nil, bindings, t)
+}
+
+func typeOfWith(eval *evaluation, bindings map[string]Binding, t term.Term) (Value, error) {
+	ctx := typeContext{eval: eval}
+	for name, binding := range bindings {
+		t = term.Subst(name, ctx.freshLocal(name), t)
+		ctx = ctx.extend(name, binding.Type)
+	}
+	return typeWith(ctx, t)
+}
+
+func typeWith(ctx typeContext, t term.Term) (Value, error) {
 	switch t := t.(type) {
 	case term.Universe:
 		switch t {
@@ -170,7 +209,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 	case term.Var:
 		return nil, mkTypeError(typeCheckVar(t))
 	case term.LocalVar:
-		if vals, ok := ctx[t.Name]; ok {
+		if vals, ok := ctx.types[t.Name]; ok {
 			if t.Index < len(vals) {
 				return vals[t.Index], nil
 			}
@@ -195,14 +234,14 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		if !AlphaEquivalent(expectedType, actualType) {
 			return nil, mkTypeError(typeMismatch(Quote(expectedType), Quote(actualType)))
 		}
-		bodyTypeVal := piType.Codomain(Eval(t.Arg))
+		bodyTypeVal := piType.Codomain(ctx.evaluate(t.Arg))
 		return bodyTypeVal, nil
 	case term.Lambda:
 		_, err := typeWith(ctx, t.Type)
 		if err != nil {
 			return nil, err
 		}
-		argType := Eval(t.Type)
+		argType := ctx.evaluate(t.Type)
 		pi := Pi{Label: t.Label, Domain: argType}
 		freshLocal := ctx.freshLocal(t.Label)
 		bt, err := typeWith(
@@ -214,7 +253,8 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		pi.Codomain = func(x Value) Value {
 			rebound := term.RebindLocal(freshLocal, Quote(bt))
 			return evalWith(rebound, env{
-				t.Label: []Value{x},
+				vars: map[string][]Value{t.Label: {x}},
+				eval: ctx.eval,
 			})
 		}
 		_, err = typeWith(ctx, Quote(pi))
@@ -233,7 +273,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		}
 		freshLocal := ctx.freshLocal(t.Label)
 		outUniv, err := typeWith(
-			ctx.extend(t.Label, Eval(t.Type)),
+			ctx.extend(t.Label, ctx.evaluate(t.Type)),
 			term.Subst(t.Label, freshLocal, t.Body))
 		if err != nil {
 			return nil, err
@@ -261,12 +301,12 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 				if err != nil {
 					return nil, err
 				}
-				if !AlphaEquivalent(bindingType, Eval(binding.Annotation)) {
+				if !AlphaEquivalent(bindingType, ctx.evaluate(binding.Annotation)) {
 					return nil, mkTypeError(annotMismatch(binding.Annotation, Quote(bindingType)))
 				}
 			}
 
-			value := Quote(Eval(binding.Value))
+			value := Quote(ctx.evaluate(binding.Value))
 			let = term.Subst(binding.Variable, value, let).(term.Let)
 			ctx = ctx.extend(binding.Variable, bindingType)
 		}
@@ -284,7 +324,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			return nil, err
 		}
 		// T₀ ≡ T₁
-		if !AlphaEquivalent(Eval(t.Annotation), actualType) {
+		if !AlphaEquivalent(ctx.evaluate(t.Annotation), actualType) {
 			return nil, mkTypeError(annotMismatch(t.Annotation, Quote(actualType)))
 		}
 		// ─────────────────
@@ -399,7 +439,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			if _, err = typeWith(ctx, recordType); err != nil {
 				return nil, err
 			}
-			return Eval(recordType), nil
+			return ctx.evaluate(recordType), nil
 		case term.RecordTypeMergeOp:
 			lKind, err := typeWith(ctx, t.L)
 			if err != nil {
@@ -409,11 +449,11 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			if err != nil {
 				return nil, err
 			}
-			lt, ok := Eval(t.L).(RecordType)
+			lt, ok := ctx.evaluate(t.L).(RecordType)
 			if !ok {
 				return nil, mkTypeError(combineTypesRequiresRecordType)
 			}
-			rt, ok := Eval(t.R).(RecordType)
+			rt, ok := ctx.evaluate(t.R).(RecordType)
 			if !ok {
 				return nil, mkTypeError(combineTypesRequiresRecordType)
 			}
@@ -491,7 +531,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		listType := Eval(t.Type)
+		listType := ctx.evaluate(t.Type)
 		_, ok := listElementType(listType)
 		if !ok {
 			return nil, mkTypeError(invalidListType)
@@ -572,7 +612,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			if err != nil {
 				return nil, err
 			}
-			tVal := Eval(t.Type)
+			tVal := ctx.evaluate(t.Type)
 			t, ok := listElementType(tVal)
 			if !ok {
 				return nil, mkTypeError(invalidToMapType(Quote(tVal)))
@@ -604,7 +644,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		if _, err = typeWith(ctx, t.Type); err != nil {
 			return nil, err
 		}
-		annot := Eval(t.Type)
+		annot := ctx.evaluate(t.Type)
 		if !AlphaEquivalent(inferred, annot) {
 			return nil, mkTypeError(mapTypeMismatch(Quote(inferred), t.Type))
 		}
@@ -622,7 +662,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			}
 			return fieldType, nil
 		}
-		unionTypeV := Eval(t.Record)
+		unionTypeV := ctx.evaluate(t.Record)
 		unionType, ok := unionTypeV.(UnionType)
 		if !ok {
 			return nil, mkTypeError(cantAccess)
@@ -676,7 +716,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		selectorVal := Eval(t.Selector)
+		selectorVal := ctx.evaluate(t.Selector)
 		selector, ok := selectorVal.(RecordType)
 		if !ok {
 			return nil, mkTypeError(cantProjectByExpression)
@@ -745,7 +785,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			if _, err := typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
-			return Eval(t.Annotation), nil
+			return ctx.evaluate(t.Annotation), nil
 		}
 
 		var result Value
@@ -789,7 +829,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 			if _, err := typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
-			if !AlphaEquivalent(result, Eval(t.Annotation)) {
+			if !AlphaEquivalent(result, ctx.evaluate(t.Annotation)) {
 				return nil, mkTypeError(annotMismatch(t.Annotation, Quote(result)))
 			}
 		}
@@ -799,7 +839,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		oper, ok := Eval(t.Annotation).(oper)
+		oper, ok := ctx.evaluate(t.Annotation).(oper)
 		if !ok || oper.OpCode != term.EquivOp {
 			return nil, mkTypeError(notAnEquivalence)
 		}
@@ -838,7 +878,7 @@ func typeWith(ctx context, t term.Term) (Value, error) {
 }
 
 type typeError struct {
-	ctx     context
+	ctx     typeContext
 	message typeMessage
 }
 
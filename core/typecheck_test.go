@@ -108,3 +108,30 @@ var _ = Describe("TypeOf", func() {
 			term.Equivalent(term.NaturalLit(2), term.Type), "Incomparable expression"),
 	)
 })
+
+var _ = Describe("TypeOfWith and EvalWith", func() {
+	bindings := map[string]Binding{
+		"region": {Type: Text, Value: PlainTextLit("eu")},
+		"upper":  {Type: NewFnType("_", Text, Text), Value: upper},
+	}
+	It("Typechecks and evaluates free variables from bindings", func() {
+		t := term.Apply(term.NewVar("upper"), term.NewVar("region"))
+		Ω(TypeOfWith(bindings, t)).Should(Equal(Text))
+		Expect(EvalWith(bindings, t)).To(Equal(PlainTextLit("EU")))
+	})
+	It("Respects shadowing of bindings", func() {
+		t := term.Apply(
+			term.NewLambda("region", term.Natural, term.Var{Name: "region", Index: 1}),
+			term.NaturalLit(1))
+		Ω(TypeOfWith(bindings, t)).Should(Equal(Text))
+		Expect(EvalWith(bindings, t)).To(Equal(PlainTextLit("eu")))
+	})
+	It("Rejects ill-typed uses of bindings", func() {
+		_, err := TypeOfWith(bindings, term.NaturalPlus(term.NewVar("region"), term.NaturalLit(1)))
+		Expect(err).To(HaveOccurred())
+	})
+	It("Rejects unbound variables", func() {
+		_, err := TypeOfWith(bindings, term.NewVar("zone"))
+		Expect(err).To(HaveOccurred())
+	})
+})
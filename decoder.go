@@ -0,0 +1,362 @@
+package dhall
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// A DecoderConfig holds options which control how Dhall is decoded
+// into Go.  The zero DecoderConfig gives the same behaviour as the
+// package-level Decode and Unmarshal functions.
+type DecoderConfig struct {
+	// If DisallowUnknownFields is set, it is an error for a Dhall
+	// record to have a field which doesn't correspond to any field
+	// of the struct it is decoded into.
+	DisallowUnknownFields bool
+	// If RequireAllFields is set, it is an error for a struct to
+	// have an exported field which doesn't correspond to any field
+	// of the Dhall record decoded into it.  Otherwise, such fields
+	// are left unchanged.
+	RequireAllFields bool
+	// If AllowNegativeUnsigned is set, a negative Integer can be
+	// decoded into an unsigned integer type, and is converted in the
+	// same way as a Go conversion from the signed type of the same
+	// size (so -1 becomes 255 in a uint8).  Otherwise this is an
+	// error.
+	AllowNegativeUnsigned bool
+	// FieldNamer, if not nil, gives the Dhall names of struct
+	// fields which have no name in their `dhall` tag.  For example,
+	// set it to SnakeCase to decode the record field
+	// `timeout_seconds` into the struct field TimeoutSeconds.
+	// Otherwise, such fields have the same name as the Go field.
+	FieldNamer FieldNamer
+	// If PreserveFieldOrder is set, Dhall records decoded into an
+	// interface{} become OrderedMaps rather than
+	// map[string]interface{}s.  Unmarshal, UnmarshalReader and
+	// UnmarshalFile put the fields of each record in the order they
+	// were written in the Dhall source, as parser.FieldOrder.Sort
+	// does; Decode puts them in alphabetical order.  Prelude Maps
+	// with Text keys decoded into an interface{} also become
+	// OrderedMaps, in list order.
+	PreserveFieldOrder bool
+
+	// Cache is used for saving and fetching imports protected by a
+	// hash.  If nil, the standard cache under $XDG_CACHE_HOME/dhall
+	// is used; set it to imports.NoCache{} to avoid touching the
+	// filesystem, for example in a read-only container.
+	Cache imports.DhallCache
+	// BaseDir is the directory which relative imports are resolved
+	// against by Unmarshal, and by UnmarshalReader when given a
+	// relative filename.  If empty, the current directory is used.
+	// UnmarshalFile always resolves relative imports against the
+	// directory containing the file.
+	BaseDir string
+	// LookupEnv is used to resolve `env:` imports.  If nil,
+	// os.LookupEnv is used.
+	LookupEnv func(name string) (string, bool)
+	// ImportPolicy, if not nil, is consulted before resolving each
+	// import; see imports.DisallowRemote and imports.DisallowAll.
+	ImportPolicy imports.ImportPolicy
+
+	// Limits bound the resources used to typecheck and evaluate
+	// the Dhall expression, and each of its imports.  Exceeding a
+	// limit returns a *core.LimitError.  Set them when decoding
+	// untrusted Dhall.  Dhall functions decoded into Go functions
+	// are not limited when they are called.
+	Limits core.Limits
+
+	// Bindings are made available by Unmarshal, UnmarshalReader and
+	// UnmarshalFile to the Dhall expression being decoded, as free
+	// variables named by the keys of the map.  They are not
+	// available to imported expressions.
+	Bindings map[string]BindingValue
+}
+
+// A BindingValue is a Go value which is made available to Dhall code
+// as a free variable.  Value is encoded as a Dhall value of type
+// Type, in the same way as Go function arguments are (see Decode).
+// If Type is nil, the type given by TypeFor for the Go type of Value
+// is used, with untagged struct fields named by the FieldNamer.  Value
+// may be a Go function, which is then available as a Dhall function;
+// if it returns a non-nil error, or its arguments can't be decoded or
+// its results encoded, Unmarshal returns that error.
+//
+// Bindings are opaque to the Dhall type checker, so a binding of type
+// Type can't be used as a type.
+type BindingValue struct {
+	Type  core.Value
+	Value interface{}
+}
+
+// A FieldMismatchError is returned when decoding with a strict
+// DecoderConfig finds record fields which don't match struct
+// fields.  Each mismatched field is given as a path from the top of
+// the decoded value, such as `.services[3].timeoutSecs`.
+type FieldMismatchError struct {
+	Unknown []string // record fields with no corresponding struct field
+	Missing []string // struct fields with no corresponding record field
+}
+
+func (e *FieldMismatchError) Error() string {
+	var problems []string
+	for _, path := range e.Unknown {
+		problems = append(problems, "unknown field "+path)
+	}
+	for _, path := range e.Missing {
+		problems = append(problems, "missing field "+path)
+	}
+	return fmt.Sprintf("Record fields don't match struct fields: %s", strings.Join(problems, ", "))
+}
+
+// A DecodeError is returned when a Dhall value can't be decoded into
+// a Go value.  Use errors.As to inspect it.
+type DecodeError struct {
+	// Path is the location of Value within the decoded value, such
+	// as `.services[3].ports[0].number`.  It is empty if Value is
+	// the decoded value itself.
+	Path   string
+	Value  core.Value   // the Dhall value which couldn't be decoded
+	Type   core.Value   // the Dhall type of Value
+	GoType reflect.Type // the Go type Value was being decoded into
+	Err    error        // the underlying error, if any
+}
+
+func (e *DecodeError) Error() string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "Can't decode %v", core.Quote(e.Value))
+	if e.Type != nil {
+		fmt.Fprintf(&buf, " of type %v", core.Quote(e.Type))
+	}
+	fmt.Fprintf(&buf, " into %v", e.GoType)
+	if e.Path != "" {
+		fmt.Fprintf(&buf, " at %s", e.Path)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&buf, ": %v", e.Err)
+	}
+	return buf.String()
+}
+
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+// Unmarshal takes dhall input as a byte array and parses it, resolves
+// imports, typechecks, evaluates, and unmarshals it into the given
+// variable, according to the DecoderConfig.
+func (c DecoderConfig) Unmarshal(b []byte, out interface{}) error {
+	return c.UnmarshalContext(context.Background(), b, out)
+}
+
+// UnmarshalContext is like Unmarshal, but gives up and returns
+// ctx.Err() if ctx is cancelled or its deadline passes while
+// resolving imports or evaluating.
+func (c DecoderConfig) UnmarshalContext(ctx context.Context, b []byte, out interface{}) error {
+	order, opts := c.fieldOrder()
+	term, err := parser.Parse("-", b, opts...)
+	if err != nil {
+		return err
+	}
+	return c.unmarshalTerm(ctx, term, c.origin("-"), order, out)
+}
+
+// UnmarshalReader takes dhall input from a Reader and parses it,
+// resolves imports, typechecks, evaluates, and unmarshals it into the
+// given variable, according to the DecoderConfig.
+func (c DecoderConfig) UnmarshalReader(filename string, r io.Reader, out interface{}) error {
+	return c.UnmarshalReaderContext(context.Background(), filename, r, out)
+}
+
+// UnmarshalReaderContext is like UnmarshalReader, but gives up in the
+// same way as UnmarshalContext.
+func (c DecoderConfig) UnmarshalReaderContext(ctx context.Context, filename string, r io.Reader, out interface{}) error {
+	order, opts := c.fieldOrder()
+	term, err := parser.ParseReader(filename, r, opts...)
+	if err != nil {
+		return err
+	}
+	return c.unmarshalTerm(ctx, term, c.origin(filename), order, out)
+}
+
+// UnmarshalFile takes dhall input from a file and parses it, resolves
+// imports relative to the file, typechecks, evaluates, and unmarshals
+// it into the given variable, according to the DecoderConfig.
+func (c DecoderConfig) UnmarshalFile(filename string, out interface{}) error {
+	return c.UnmarshalFileContext(context.Background(), filename, out)
+}
+
+// UnmarshalFileContext is like UnmarshalFile, but gives up in the same
+// way as UnmarshalContext.
+func (c DecoderConfig) UnmarshalFileContext(ctx context.Context, filename string, out interface{}) error {
+	order, opts := c.fieldOrder()
+	t, err := parser.ParseFile(filename, opts...)
+	if err != nil {
+		return err
+	}
+	return c.unmarshalTerm(ctx, t, term.LocalFile(filename), order, out)
+}
+
+// fieldOrder returns a FieldOrder, and the parser options to record
+// it, if c.PreserveFieldOrder is set.
+func (c DecoderConfig) fieldOrder() (*parser.FieldOrder, []parser.Option) {
+	if !c.PreserveFieldOrder {
+		return nil, nil
+	}
+	order := parser.NewFieldOrder()
+	return order, []parser.Option{parser.RecordFieldOrder(order)}
+}
+
+// origin returns the file which relative imports in Dhall source
+// read from filename should be resolved against, or nil if they
+// should be resolved against the current directory.
+func (c DecoderConfig) origin(filename string) term.Fetchable {
+	if c.BaseDir == "" || filepath.IsAbs(filename) {
+		if filename == "-" {
+			return nil
+		}
+		return term.LocalFile(filename)
+	}
+	return term.LocalFile(filepath.Join(c.BaseDir, filename))
+}
+
+func (c DecoderConfig) unmarshalTerm(ctx context.Context, t term.Term, origin term.Fetchable, order *parser.FieldOrder, out interface{}) (err error) {
+	defer catchCallbackError(&err)
+	loader := imports.Loader{
+		Cache:      c.Cache,
+		LookupEnv:  c.LookupEnv,
+		Policy:     c.ImportPolicy,
+		Limits:     c.Limits,
+		FieldOrder: order,
+	}
+	var ancestors []term.Fetchable
+	if origin != nil {
+		ancestors = append(ancestors, origin)
+	}
+	resolved, err := loader.LoadContext(ctx, t, ancestors...)
+	if err != nil {
+		return err
+	}
+	bindings, err := c.coreBindings()
+	if err != nil {
+		return err
+	}
+	evaluator := core.Evaluator{Bindings: bindings, Limits: c.Limits}
+	typ, err := evaluator.TypeOf(ctx, resolved)
+	if err != nil {
+		return err
+	}
+	err = checkType(typ, reflect.TypeOf(out).Elem(), c.FieldNamer)
+	if err != nil {
+		return err
+	}
+	val, err := evaluator.Eval(ctx, resolved)
+	if err != nil {
+		return err
+	}
+	d := &decoder{DecoderConfig: c, order: order}
+	if err := d.decode(val, reflect.ValueOf(out).Elem(), ""); err != nil {
+		return err
+	}
+	return d.mismatchError()
+}
+
+// coreBindings encodes c.Bindings as Dhall values.
+func (c DecoderConfig) coreBindings() (map[string]core.Binding, error) {
+	bindings := make(map[string]core.Binding, len(c.Bindings))
+	for name, binding := range c.Bindings {
+		typ := binding.Type
+		val := reflect.ValueOf(binding.Value)
+		if !val.IsValid() {
+			opt, ok := typ.(core.OptionalOf)
+			if !ok {
+				return nil, fmt.Errorf("Can't bind %s to nil: its type must be Optional", name)
+			}
+			bindings[name] = core.Binding{Type: typ, Value: core.NoneOf{Type: opt.Type}}
+			continue
+		}
+		if typ == nil {
+			var err error
+			typ, err = typeFor(val.Type(), c.FieldNamer)
+			if err != nil {
+				return nil, fmt.Errorf("Can't bind %s: %v", name, err)
+			}
+		}
+		// make val addressable, so that we can find MarshalDhall
+		// methods with pointer receivers
+		addressable := reflect.New(val.Type()).Elem()
+		addressable.Set(val)
+		var dhallVal core.Value
+		var err error
+		if pi, ok := typ.(core.Pi); ok && val.Kind() == reflect.Func {
+			dhallVal, err = encodeNamedFunc(name, addressable, pi, c)
+		} else {
+			dhallVal, err = encode(addressable, typ, c)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Can't bind %s: %v", name, err)
+		}
+		bindings[name] = core.Binding{Type: typ, Value: dhallVal}
+	}
+	return bindings, nil
+}
+
+// Decode takes a core.Value and unmarshals it into the given
+// variable, according to the DecoderConfig.
+func (c DecoderConfig) Decode(e core.Value, out interface{}) (err error) {
+	defer catchCallbackError(&err)
+	return c.decodeValue(e, reflect.ValueOf(out).Elem())
+}
+
+func (c DecoderConfig) decodeValue(e core.Value, v reflect.Value) error {
+	d := &decoder{DecoderConfig: c}
+	if err := d.decode(e, v, ""); err != nil {
+		return err
+	}
+	return d.mismatchError()
+}
+
+// A decoder holds the state of a single call to Decode.
+type decoder struct {
+	DecoderConfig
+	order   *parser.FieldOrder // the field order of the source, if any
+	unknown []string
+	missing []string
+}
+
+// decodeError returns a *DecodeError for the failure to decode e
+// into v, found at path.  err is the underlying cause, if any.
+func (d *decoder) decodeError(e core.Value, v reflect.Value, path string, err error) error {
+	decodeErr := &DecodeError{
+		Path:   path,
+		Value:  e,
+		GoType: v.Type(),
+		Err:    err,
+	}
+	if typ, err := core.TypeOf(core.Quote(e)); err == nil {
+		decodeErr.Type = typ
+	}
+	return decodeErr
+}
+
+// mismatchError returns a *FieldMismatchError for any mismatched
+// fields the DecoderConfig disallows, or nil if there are none.
+func (d *decoder) mismatchError() error {
+	err := &FieldMismatchError{}
+	if d.DisallowUnknownFields {
+		err.Unknown = d.unknown
+	}
+	if d.RequireAllFields {
+		err.Missing = d.missing
+	}
+	if len(err.Unknown) == 0 && len(err.Missing) == 0 {
+		return nil
+	}
+	return err
+}
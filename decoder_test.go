@@ -0,0 +1,479 @@
+package dhall_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	. "github.com/philandstuff/dhall-golang/v6"
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/imports"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type testService struct {
+	Name           string
+	TimeoutSeconds uint `dhall:"timeoutSeconds"`
+}
+
+type testServices struct {
+	Services []testService `dhall:"services"`
+}
+
+const testServicesSource = `
+{ services =
+  [ { Name = "a", timeoutSecs = 1 }
+  , { Name = "b", timeoutSecs = 2 }
+  ]
+}`
+
+var _ = Describe("DecoderConfig", func() {
+	It("Ignores mismatched fields by default", func() {
+		var actual testServices
+		err := Unmarshal([]byte(testServicesSource), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(testServices{
+			Services: []testService{
+				{Name: "a"},
+				{Name: "b"},
+			},
+		}))
+	})
+	It("Reports unknown fields with DisallowUnknownFields", func() {
+		var actual testServices
+		err := DecoderConfig{DisallowUnknownFields: true}.
+			Unmarshal([]byte(testServicesSource), &actual)
+		var mismatch *FieldMismatchError
+		Expect(errors.As(err, &mismatch)).To(BeTrue())
+		Expect(mismatch.Unknown).To(ConsistOf(
+			".services[0].timeoutSecs",
+			".services[1].timeoutSecs",
+		))
+		Expect(mismatch.Missing).To(BeEmpty())
+	})
+	It("Reports missing fields with RequireAllFields", func() {
+		var actual testServices
+		err := DecoderConfig{RequireAllFields: true}.
+			Unmarshal([]byte(testServicesSource), &actual)
+		var mismatch *FieldMismatchError
+		Expect(errors.As(err, &mismatch)).To(BeTrue())
+		Expect(mismatch.Unknown).To(BeEmpty())
+		Expect(mismatch.Missing).To(ConsistOf(
+			".services[0].timeoutSeconds",
+			".services[1].timeoutSeconds",
+		))
+	})
+	It("Reports every mismatched field", func() {
+		var actual testServices
+		err := DecoderConfig{DisallowUnknownFields: true, RequireAllFields: true}.
+			Unmarshal([]byte(`{ services = [ { name = "a", timeoutSecs = 1 } ], extra = True }`), &actual)
+		var mismatch *FieldMismatchError
+		Expect(errors.As(err, &mismatch)).To(BeTrue())
+		Expect(mismatch.Unknown).To(ConsistOf(
+			".services[0].name",
+			".services[0].timeoutSecs",
+			".extra",
+		))
+		Expect(mismatch.Missing).To(ConsistOf(
+			".services[0].Name",
+			".services[0].timeoutSeconds",
+		))
+		Expect(err.Error()).To(ContainSubstring("unknown field .services[0].timeoutSecs"))
+	})
+	It("Decodes negative Integers into unsigned types with AllowNegativeUnsigned", func() {
+		var actual struct {
+			A uint8
+			B uint
+		}
+		err := DecoderConfig{AllowNegativeUnsigned: true}.
+			Unmarshal([]byte(`{ A = -1, B = -2 }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.A).To(Equal(uint8(255)))
+		Expect(actual.B).To(Equal(^uint(1)))
+	})
+	It("Still checks the range of negative Integers with AllowNegativeUnsigned", func() {
+		var actual uint8
+		err := DecoderConfig{AllowNegativeUnsigned: true}.
+			Unmarshal([]byte(`-129`), &actual)
+		Expect(err).To(MatchError(ContainSubstring("value -129 overflows uint8")))
+	})
+	It("Decodes the arguments of Go functions with the same config", func() {
+		var actual uint
+		config := DecoderConfig{
+			AllowNegativeUnsigned: true,
+			Bindings: map[string]BindingValue{"f": {
+				Type:  core.NewFnType("_", core.Integer, core.Natural),
+				Value: func(x uint8) uint8 { return x },
+			}},
+		}
+		err := config.Unmarshal([]byte(`f -1`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(uint(255)))
+	})
+	It("Accepts matching fields in strict mode", func() {
+		var actual testStruct
+		err := DecoderConfig{DisallowUnknownFields: true, RequireAllFields: true}.
+			Unmarshal([]byte(`{ Foo = 1, Bar = "x" }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(testStruct{Foo: 1, Bar: "x"}))
+	})
+	It("Names untagged fields with FieldNamer", func() {
+		var actual struct {
+			TimeoutSeconds uint
+			HTTPPort       uint
+			Name           string `dhall:"serviceName"`
+		}
+		err := DecoderConfig{FieldNamer: SnakeCase, RequireAllFields: true}.
+			Unmarshal([]byte(`{ timeout_seconds = 1, http_port = 80, serviceName = "x" }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.TimeoutSeconds).To(Equal(uint(1)))
+		Expect(actual.HTTPPort).To(Equal(uint(80)))
+		Expect(actual.Name).To(Equal("x"))
+	})
+})
+
+var _ = DescribeTable("SnakeCase",
+	func(goName, expected string) {
+		Expect(SnakeCase(goName)).To(Equal(expected))
+	},
+	Entry("single word", "Name", "name"),
+	Entry("several words", "TimeoutSeconds", "timeout_seconds"),
+	Entry("leading acronym", "HTTPPort", "http_port"),
+	Entry("trailing acronym", "UserID", "user_id"),
+	Entry("digits", "Port2", "port2"),
+	Entry("underscores", "Max_Retries", "max_retries"),
+)
+
+type testPort struct {
+	Number string `dhall:"number"`
+}
+
+type testPortService struct {
+	Ports []testPort `dhall:"ports"`
+}
+
+var _ = Describe("DecodeError", func() {
+	It("Reports the path, types and value", func() {
+		var actual struct {
+			Services []testPortService `dhall:"services"`
+		}
+		portType := core.RecordType{"number": core.Natural}
+		err := Decode(core.RecordLit{
+			"services": core.NonEmptyList{
+				core.RecordLit{"ports": core.EmptyList{Type: core.ListOf{Type: portType}}},
+				core.RecordLit{"ports": core.NonEmptyList{
+					core.RecordLit{"number": core.NaturalLit(3)},
+				}},
+			},
+		}, &actual)
+		var decodeErr *DecodeError
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		Expect(decodeErr.Path).To(Equal(".services[1].ports[0].number"))
+		Expect(decodeErr.Value).To(Equal(core.NaturalLit(3)))
+		Expect(decodeErr.Type).To(Equal(core.Natural))
+		Expect(decodeErr.GoType).To(Equal(reflect.TypeOf("")))
+		Expect(err.Error()).To(Equal(
+			"Can't decode 3 of type Natural into string at .services[1].ports[0].number"))
+	})
+	It("Reports map keys in the path", func() {
+		var actual map[string]bool
+		err := Decode(core.NonEmptyList{
+			core.RecordLit{"mapKey": core.PlainTextLit("foo"), "mapValue": core.NaturalLit(1)},
+		}, &actual)
+		var decodeErr *DecodeError
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		Expect(decodeErr.Path).To(Equal(`["foo"]`))
+	})
+	It("Has an empty path at the top level", func() {
+		var actual bool
+		err := Decode(core.PlainTextLit("foo"), &actual)
+		var decodeErr *DecodeError
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		Expect(decodeErr.Path).To(BeEmpty())
+		Expect(err.Error()).To(Equal(`Can't decode "foo" of type Text into bool`))
+	})
+})
+
+var _ = Describe("DecoderConfig import resolution", func() {
+	var dir string
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "dhall-golang")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Mkdir(filepath.Join(dir, "sub"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "sub", "main.dhall"), []byte("./port.dhall + 1"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "sub", "port.dhall"), []byte("8080"), 0644)).To(Succeed())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+	It("Resolves relative imports in UnmarshalFile against the file", func() {
+		var port uint
+		err := DecoderConfig{Cache: imports.NoCache{}}.UnmarshalFile(filepath.Join(dir, "sub", "main.dhall"), &port)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(uint(8081)))
+	})
+	It("Resolves relative imports in Unmarshal against BaseDir", func() {
+		var port uint
+		config := DecoderConfig{Cache: imports.NoCache{}, BaseDir: dir}
+		err := config.Unmarshal([]byte("./sub/main.dhall"), &port)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(uint(8081)))
+	})
+	It("Resolves relative imports in UnmarshalReader against BaseDir and the filename", func() {
+		var port uint
+		config := DecoderConfig{Cache: imports.NoCache{}, BaseDir: dir}
+		err := config.UnmarshalReader("sub/config.dhall", strings.NewReader("./port.dhall"), &port)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(uint(8080)))
+	})
+	It("Resolves environment variables with LookupEnv", func() {
+		var name string
+		config := DecoderConfig{
+			Cache: imports.NoCache{},
+			LookupEnv: func(name string) (string, bool) {
+				return "\"from " + name + "\"", true
+			},
+		}
+		err := config.Unmarshal([]byte("env:SERVICE_NAME"), &name)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("from SERVICE_NAME"))
+	})
+	It("Rejects imports forbidden by the ImportPolicy", func() {
+		var port uint
+		config := DecoderConfig{Cache: imports.NoCache{}, ImportPolicy: imports.DisallowAll}
+		err := config.UnmarshalFile(filepath.Join(dir, "sub", "main.dhall"), &port)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("UnmarshalContext", func() {
+	It("Decodes like Unmarshal", func() {
+		var n uint
+		err := UnmarshalContext(context.Background(), []byte("1 + 2"), &n)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(uint(3)))
+	})
+	It("Gives up on slow evaluation when the context times out", func() {
+		var n uint
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := UnmarshalContext(ctx, []byte("Natural/fold 1000000000 Natural (\\(x : Natural) -> x + 1) 0"), &n)
+
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+	It("Gives up before resolving imports when the context is cancelled", func() {
+		var name string
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		config := DecoderConfig{
+			Cache: imports.NoCache{},
+			LookupEnv: func(name string) (string, bool) {
+				Fail("shouldn't look up " + name)
+				return "", false
+			},
+		}
+		err := config.UnmarshalContext(ctx, []byte("env:SERVICE_NAME as Text"), &name)
+
+		Expect(err).To(Equal(context.Canceled))
+	})
+})
+
+var _ = Describe("DecoderConfig Limits", func() {
+	config := DecoderConfig{Limits: core.Limits{MaxSteps: 10000}}
+	It("Decodes expressions within the limits", func() {
+		var n uint
+		err := config.Unmarshal([]byte("let double = λ(n : Natural) → n * 2 in double 21"), &n)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(uint(42)))
+	})
+	It("Returns a LimitError for expressions exceeding the limits", func() {
+		var n uint
+		err := config.Unmarshal([]byte("let n = Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0 in n + 1"), &n)
+
+		var limitErr *core.LimitError
+		Expect(errors.As(err, &limitErr)).To(BeTrue())
+		Expect(limitErr.Limit).To(Equal("steps"))
+	})
+})
+
+var _ = Describe("PreserveFieldOrder", func() {
+	config := DecoderConfig{PreserveFieldOrder: true}
+	It("Decodes records into OrderedMaps in source order", func() {
+		var data interface{}
+		err := config.Unmarshal([]byte(`{ kind = "Service", apiVersion = "v1", metadata = { name = "web", labels = [ { mapKey = "tier", mapValue = "front" }, { mapKey = "app", mapValue = "web" } ] } }`), &data)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(OrderedMap{
+			{Key: "kind", Value: "Service"},
+			{Key: "apiVersion", Value: "v1"},
+			{Key: "metadata", Value: OrderedMap{
+				{Key: "name", Value: "web"},
+				{Key: "labels", Value: OrderedMap{
+					{Key: "tier", Value: "front"},
+					{Key: "app", Value: "web"},
+				}},
+			}},
+		}))
+		b, err := json.Marshal(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(b)).To(Equal(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"web","labels":{"tier":"front","app":"web"}}}`))
+	})
+	It("Records the field order of imports", func() {
+		dir, err := ioutil.TempDir("", "dhall-golang")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		Expect(ioutil.WriteFile(filepath.Join(dir, "defaults.dhall"), []byte(`{ replicas = 1, image = "nginx" }`), 0644)).To(Succeed())
+
+		var data interface{}
+		config := DecoderConfig{PreserveFieldOrder: true, Cache: imports.NoCache{}, BaseDir: dir}
+		err = config.Unmarshal([]byte(`{ name = "web" } // ./defaults.dhall`), &data)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(OrderedMap{
+			{Key: "name", Value: "web"},
+			{Key: "replicas", Value: 1},
+			{Key: "image", Value: "nginx"},
+		}))
+	})
+	It("Decodes records into maps without PreserveFieldOrder", func() {
+		var data interface{}
+		err := Unmarshal([]byte(`{ b = 1, a = 2 }`), &data)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(map[string]interface{}{"a": 2, "b": 1}))
+	})
+	It("Sorts fields alphabetically in Decode", func() {
+		var data interface{}
+		err := config.Decode(core.RecordLit{"b": core.NaturalLit(1), "a": core.NaturalLit(2)}, &data)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal(OrderedMap{{Key: "a", Value: 2}, {Key: "b", Value: 1}}))
+	})
+})
+
+var _ = Describe("OrderedMap", func() {
+	m := OrderedMap{{Key: "b", Value: 1}, {Key: "a", Value: []interface{}{true}}}
+	It("Gets values by key", func() {
+		val, ok := m.Get("a")
+		Expect(ok).To(BeTrue())
+		Expect(val).To(Equal([]interface{}{true}))
+		_, ok = m.Get("c")
+		Expect(ok).To(BeFalse())
+	})
+	It("Marshals empty OrderedMaps as empty objects", func() {
+		Expect(json.Marshal(OrderedMap{})).To(Equal([]byte(`{}`)))
+	})
+})
+
+var _ = Describe("Bindings", func() {
+	type deployment struct {
+		Host     string
+		Replicas uint
+	}
+	environment := core.UnionType{"Prod": nil, "Staging": nil}
+	bindings := map[string]BindingValue{
+		"environment": {Type: environment, Value: "Staging"},
+		"region":      {Value: "eu-west-1"},
+		"slug":        {Value: strings.ToLower},
+		"zone":        {Type: core.OptionalOf{Type: core.Text}},
+	}
+	It("Makes bindings available as free variables", func() {
+		var actual deployment
+		err := UnmarshalWith(bindings, []byte(`
+{ Host = "api.${slug "EU"}.${region}.example.com"
+, Replicas = merge { Prod = 3, Staging = 1 } environment
+}`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(deployment{Host: "api.eu.eu-west-1.example.com", Replicas: 1}))
+	})
+	It("Binds nil to None", func() {
+		var actual string
+		err := UnmarshalWith(bindings, []byte(`merge { None = "none", Some = λ(z : Text) → z } zone`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal("none"))
+	})
+	It("Typechecks uses of bindings", func() {
+		var actual uint
+		err := UnmarshalWith(bindings, []byte(`region + 1`), &actual)
+		Expect(err).To(HaveOccurred())
+	})
+	It("Rejects values which don't match their binding's type", func() {
+		var actual string
+		err := UnmarshalWith(map[string]BindingValue{
+			"environment": {Type: environment, Value: "Dev"},
+		}, []byte(`"x"`), &actual)
+		Expect(err).To(HaveOccurred())
+	})
+	It("Names the fields of struct bindings with FieldNamer", func() {
+		type limits struct {
+			TimeoutSeconds uint
+		}
+		var actual uint
+		config := DecoderConfig{
+			FieldNamer: SnakeCase,
+			Bindings:   map[string]BindingValue{"limits": {Value: limits{5}}},
+		}
+		err := config.Unmarshal([]byte(`limits.timeout_seconds * 2`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(uint(10)))
+	})
+	It("Returns the errors of Go functions", func() {
+		errNotFound := errors.New("host not found")
+		var actual string
+		err := UnmarshalWith(map[string]BindingValue{
+			"lookup": {Value: func(string) (string, error) { return "", errNotFound }},
+		}, []byte(`lookup "db"`), &actual)
+		Expect(errors.Is(err, errNotFound)).To(BeTrue())
+		Expect(err).To(MatchError("Go function lookup returned an error: host not found"))
+	})
+	It("Returns errors decoding the arguments of Go functions", func() {
+		var actual uint8
+		err := UnmarshalWith(map[string]BindingValue{"f": {
+			Type:  core.NewFnType("_", core.Integer, core.Natural),
+			Value: func(x uint8) uint8 { return x },
+		}}, []byte(`f -1`), &actual)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("Can't pass -1 to Go function f: "))
+	})
+	It("Returns the errors of Go functions passed to Dhall functions", func() {
+		var apply func(func(string) (string, error)) (string, error)
+		err := Unmarshal([]byte(`λ(f : Text → Text) → f "x"`), &apply)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = apply(func(string) (string, error) { return "", errors.New("failed") })
+		Expect(err).To(MatchError(ContainSubstring("failed")))
+	})
+	It("Leaves Go functions applied to free variables unevaluated", func() {
+		var actual interface{}
+		err := UnmarshalWith(bindings, []byte(`λ(x : Text) → slug x`), &actual)
+		Expect(err).To(MatchError("Can't decode λ(x : Text) → slug x into interface {}"))
+	})
+	It("Doesn't make bindings available to imports", func() {
+		var actual string
+		config := DecoderConfig{
+			Bindings: bindings,
+			Cache:    imports.NoCache{},
+			LookupEnv: func(string) (string, bool) {
+				return "region", true
+			},
+		}
+		err := config.Unmarshal([]byte(`env:REGION`), &actual)
+		Expect(err).To(HaveOccurred())
+	})
+})
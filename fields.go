@@ -0,0 +1,197 @@
+package dhall
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// A FieldNamer gives the Dhall name for a struct field which has no
+// name in its `dhall` tag, given the field's Go name.
+type FieldNamer func(goName string) string
+
+// SnakeCase is a FieldNamer which converts Go names to snake_case, so
+// that TimeoutSeconds becomes timeout_seconds and HTTPPort becomes
+// http_port.
+func SnakeCase(goName string) string {
+	runes := []rune(goName)
+	var buf strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// start a new word after a lower case letter or digit,
+			// or at the last capital of an acronym followed by a
+			// lower case letter
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) ||
+				i+1 < len(runes) && unicode.IsLower(runes[i+1])) &&
+				runes[i-1] != '_' {
+				buf.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// A structField is a field of a struct type which corresponds to a
+// field of a Dhall record, or an alternative of a Dhall union.
+type structField struct {
+	name      string       // the Dhall name
+	index     []int        // the index sequence for FieldByIndex
+	typ       reflect.Type // the Go type
+	tagged    bool         // whether the name came from a tag
+	omitEmpty bool         // whether to leave the field out when empty
+}
+
+// structFields returns the fields of the struct type t which
+// correspond to Dhall fields, in the order they appear in t.
+//
+// Fields are named by the name in their `dhall` tag, or by names
+// applied to the Go name (or the Go name itself, if names is nil).
+// The tag `dhall:"-"` skips a field, and the option `omitempty` (as
+// in `dhall:"name,omitempty"`) encodes an empty value as None or an
+// empty list, when the field's Dhall type is Optional or List.  As in encoding/json, the fields of embedded structs without
+// a tag name are treated as fields of t; the option `inline` does the
+// same for any struct field.  If more than one field has the same
+// name, the least nested one is used, then the one with a tag name;
+// if that doesn't pick a single field, they are all ignored.
+func structFields(t reflect.Type, names FieldNamer) []structField {
+	var fields []structField
+	collectFields(t, nil, names, map[reflect.Type]bool{t: true}, &fields)
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		a, b := fields[i], fields[j]
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if len(a.index) != len(b.index) {
+			return len(a.index) < len(b.index)
+		}
+		return a.tagged && !b.tagged
+	})
+	var result []structField
+	for i := 0; i < len(fields); {
+		j := i + 1
+		for j < len(fields) && fields[j].name == fields[i].name {
+			j++
+		}
+		dominant := fields[i]
+		unique := j == i+1 ||
+			len(fields[i+1].index) > len(dominant.index) ||
+			dominant.tagged && !fields[i+1].tagged
+		if unique {
+			result = append(result, dominant)
+		}
+		i = j
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].index, result[j].index
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return result
+}
+
+// collectFields appends the fields of t, found at index, to fields.
+// visiting holds the struct types we are already inside, so that we
+// don't loop forever on recursive embedded pointers.
+func collectFields(t reflect.Type, index []int, names FieldNamer, visiting map[reflect.Type]bool, fields *[]structField) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("dhall")
+		if tag == "-" {
+			continue
+		}
+		name, options := parseTag(tag)
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr && fieldType.Name() == "" {
+			fieldType = fieldType.Elem()
+		}
+		inline := fieldType.Kind() == reflect.Struct &&
+			(options["inline"] || field.Anonymous && name == "")
+		if field.PkgPath != "" && !(field.Anonymous && inline) {
+			// unexported
+			continue
+		}
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+		if inline {
+			if !visiting[fieldType] {
+				visiting[fieldType] = true
+				collectFields(fieldType, fieldIndex, names, visiting, fields)
+				delete(visiting, fieldType)
+			}
+			continue
+		}
+		tagged := name != ""
+		if !tagged {
+			name = field.Name
+			if names != nil {
+				name = names(field.Name)
+			}
+		}
+		*fields = append(*fields, structField{
+			name:      name,
+			index:     fieldIndex,
+			typ:       field.Type,
+			tagged:    tagged,
+			omitEmpty: options["omitempty"],
+		})
+	}
+}
+
+// parseTag splits a `dhall` struct tag into its name and options.
+func parseTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+	options := make(map[string]bool, len(parts)-1)
+	for _, option := range parts[1:] {
+		options[option] = true
+	}
+	return parts[0], options
+}
+
+// fieldByIndex returns the field of the struct v at index, like
+// reflect.Value.FieldByIndex.  If alloc is set, nil pointers to
+// embedded structs on the way are allocated; otherwise, or if they
+// can't be allocated, fieldByIndex returns false on meeting one.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc || !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// isEmptyValue reports whether v is empty, for the purposes of the
+// `omitempty` tag option.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+/*
+Package gengo generates Go type definitions from Dhall types.
+
+The generated types follow the same correspondence between Dhall and
+Go types as dhall.TypeFor, so values of the generated types can be
+decoded with dhall.Decode and encoded with dhall.Marshal:
+
+ Bool               bool
+ Natural            uint
+ Integer            int
+ Double             float64
+ Text               string
+ Optional T         *T
+ List T             []T
+ Prelude.Map K V    map[K]V
+ { a : T, ... }     struct{ A T `dhall:"a"`; ... }
+ < A : T | B >      struct{ dhall.Union; A *T; B bool }
+ Prelude.JSON.Type  interface{}
+
+Records and unions nested inside the top-level type get their own
+named Go types, named after the path to them.
+*/
+package gengo
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/philandstuff/dhall-golang/v6"
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/internal"
+)
+
+// Generate returns Go source for a file in package pkg, declaring a
+// type called name which corresponds to the Dhall type typ, along
+// with any types it needs for nested records and unions.
+func Generate(pkg, name string, typ core.Value) ([]byte, error) {
+	g := &generator{names: map[string]bool{}}
+	switch typ.(type) {
+	case core.RecordType, core.UnionType:
+		if _, err := g.goType(typ, name); err != nil {
+			return nil, err
+		}
+	default:
+		// declare name ourselves, and name any records or unions
+		// inside typ after its elements
+		g.names[name] = true
+		g.decls = append(g.decls, "")
+		goType, err := g.goType(typ, name+"Elem")
+		if err != nil {
+			return nil, err
+		}
+		g.decls[0] = fmt.Sprintf("type %s %s\n", name, goType)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by dhall-golang gen-go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	if g.usesUnion {
+		fmt.Fprintf(&buf, "import \"github.com/philandstuff/dhall-golang/v6\"\n\n")
+	}
+	for _, decl := range g.decls {
+		buf.WriteString(decl)
+		buf.WriteString("\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+type generator struct {
+	decls     []string        // type declarations, in output order
+	names     map[string]bool // Go type names already used
+	usesUnion bool            // whether we need to import dhall
+}
+
+// goType returns the Go type expression for the Dhall type typ,
+// declaring a new named type called name (or similar, if name is
+// already taken) if typ is a record or union.
+func (g *generator) goType(typ core.Value, name string) (string, error) {
+	if core.AlphaEquivalent(typ, dhall.JSONType) {
+		return "interface{}", nil
+	}
+	switch typ := typ.(type) {
+	case core.Builtin:
+		switch typ {
+		case core.Bool:
+			return "bool", nil
+		case core.Natural:
+			return "uint", nil
+		case core.Integer:
+			return "int", nil
+		case core.Double:
+			return "float64", nil
+		case core.Text:
+			return "string", nil
+		}
+	case core.OptionalOf:
+		elem, err := g.goType(typ.Type, name)
+		if err != nil {
+			return "", err
+		}
+		return "*" + elem, nil
+	case core.ListOf:
+		if entry, ok := typ.Type.(core.RecordType); ok && internal.IsMapEntryType(entry) {
+			key, err := g.goType(entry["mapKey"], name+"Key")
+			if err != nil {
+				return "", err
+			}
+			value, err := g.goType(entry["mapValue"], name+"Value")
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("map[%s]%s", key, value), nil
+		}
+		elem, err := g.goType(typ.Type, name)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case core.RecordType:
+		return g.declareStruct(name, typ, nil)
+	case core.UnionType:
+		return g.declareStruct(name, typ, typ)
+	}
+	return "", fmt.Errorf("Can't generate a Go type for %v", core.Quote(typ))
+}
+
+// declareStruct declares a struct type for a record type (if union
+// is nil) or a union type, and returns its name.
+func (g *generator) declareStruct(name string, fields map[string]core.Value, union core.UnionType) (string, error) {
+	name = g.uniqueName(name)
+	// reserve our place in the output, so that we come before any
+	// types declared for our fields
+	index := len(g.decls)
+	g.decls = append(g.decls, "")
+
+	var labels []string
+	for label := range fields {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "type %s struct {\n", name)
+	if union != nil {
+		g.usesUnion = true
+		buf.WriteString("dhall.Union\n")
+	}
+	fieldNames := map[string]bool{}
+	if union != nil {
+		// taken by the embedded dhall.Union
+		fieldNames["Union"] = true
+	}
+	for _, label := range labels {
+		fieldName := uniqueIdentifier(exportedName(label), fieldNames)
+		fieldNames[fieldName] = true
+		var fieldType string
+		if union != nil && fields[label] == nil {
+			fieldType = "bool"
+		} else {
+			var err error
+			fieldType, err = g.goType(fields[label], name+fieldName)
+			if err != nil {
+				return "", err
+			}
+			if union != nil {
+				fieldType = "*" + fieldType
+			}
+		}
+		fmt.Fprintf(&buf, "%s %s `dhall:%q`\n", fieldName, fieldType, label)
+	}
+	buf.WriteString("}\n")
+	g.decls[index] = buf.String()
+	return name, nil
+}
+
+// uniqueName returns name, or name with a number appended if name is
+// already taken, and marks the result as taken.
+func (g *generator) uniqueName(name string) string {
+	name = uniqueIdentifier(name, g.names)
+	g.names[name] = true
+	return name
+}
+
+// uniqueIdentifier returns name, or name with a number appended if
+// name is in taken.
+func uniqueIdentifier(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s%d", name, i)
+		if !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
+// exportedName turns a Dhall label into an exported Go identifier, by
+// capitalising it and dropping any characters which can't appear in
+// an identifier.  Characters after dropped characters are
+// capitalised, so `max-retries` becomes `MaxRetries`.
+func exportedName(label string) string {
+	var buf strings.Builder
+	upper := true
+	for _, r := range label {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf.WriteRune(r)
+	}
+	name := buf.String()
+	if name == "" || !unicode.IsUpper([]rune(name)[0]) {
+		// eg labels which are empty or start with a digit or _
+		name = "X" + name
+	}
+	return name
+}
@@ -0,0 +1,13 @@
+package gengo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGengo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Gengo Suite")
+}
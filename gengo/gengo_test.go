@@ -0,0 +1,86 @@
+package gengo_test
+
+import (
+	"github.com/philandstuff/dhall-golang/v6/core"
+	. "github.com/philandstuff/dhall-golang/v6/gengo"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+const header = "// Code generated by dhall-golang gen-go. DO NOT EDIT.\n\npackage config\n\n"
+
+func GenerateAndCompare(typ core.Value, expected string) {
+	actual, err := Generate("config", "Config", typ)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(actual)).To(Equal(header + expected))
+}
+
+var _ = Describe("Generate", func() {
+	DescribeTable("Dhall types", GenerateAndCompare,
+		Entry("simple type", core.Natural, "type Config uint\n"),
+		Entry("record", core.RecordType{
+			"name":        core.Text,
+			"max-retries": core.OptionalOf{Type: core.Integer},
+			"ratio":       core.Double,
+			"debug":       core.Bool,
+		}, "type Config struct {\n"+
+			"\tDebug      bool    `dhall:\"debug\"`\n"+
+			"\tMaxRetries *int    `dhall:\"max-retries\"`\n"+
+			"\tName       string  `dhall:\"name\"`\n"+
+			"\tRatio      float64 `dhall:\"ratio\"`\n"+
+			"}\n"),
+		Entry("nested records", core.RecordType{
+			"services": core.ListOf{Type: core.RecordType{
+				"host": core.Text,
+				"tls":  core.OptionalOf{Type: core.RecordType{"cert": core.Text}},
+			}},
+		}, "type Config struct {\n"+
+			"\tServices []ConfigServices `dhall:\"services\"`\n"+
+			"}\n\n"+
+			"type ConfigServices struct {\n"+
+			"\tHost string             `dhall:\"host\"`\n"+
+			"\tTls  *ConfigServicesTls `dhall:\"tls\"`\n"+
+			"}\n\n"+
+			"type ConfigServicesTls struct {\n"+
+			"\tCert string `dhall:\"cert\"`\n"+
+			"}\n"),
+		Entry("map", core.ListOf{Type: core.RecordType{
+			"mapKey":   core.Text,
+			"mapValue": core.ListOf{Type: core.Natural},
+		}}, "type Config map[string][]uint\n"),
+		Entry("list of records", core.ListOf{Type: core.RecordType{"a": core.Bool}},
+			"type Config []ConfigElem\n\n"+
+				"type ConfigElem struct {\n"+
+				"\tA bool `dhall:\"a\"`\n"+
+				"}\n"),
+	)
+	It("Generates unions", func() {
+		actual, err := Generate("config", "Config", core.UnionType{
+			"Postgres": core.RecordType{"url": core.Text},
+			"Memory":   nil,
+			"Union":    core.Bool,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(actual)).To(Equal(
+			"// Code generated by dhall-golang gen-go. DO NOT EDIT.\n\n" +
+				"package config\n\n" +
+				"import \"github.com/philandstuff/dhall-golang/v6\"\n\n" +
+				"type Config struct {\n" +
+				"\tdhall.Union\n" +
+				"\tMemory   bool            `dhall:\"Memory\"`\n" +
+				"\tPostgres *ConfigPostgres `dhall:\"Postgres\"`\n" +
+				"\tUnion2   *bool           `dhall:\"Union\"`\n" +
+				"}\n\n" +
+				"type ConfigPostgres struct {\n" +
+				"\tUrl string `dhall:\"url\"`\n" +
+				"}\n"))
+	})
+	It("Fails on types with no Go equivalent", func() {
+		_, err := Generate("config", "Config", core.RecordType{
+			"f": core.Pi{Label: "_", Domain: core.Natural, Codomain: func(core.Value) core.Value { return core.Natural }},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,145 @@
+package dhall
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+)
+
+// encodeFunc wraps the Go function val as a Dhall function of type
+// typ.  Its arguments are decoded from Dhall according to c, and its
+// results encoded, as for the functions which Decode produces.  If an
+// argument can't be decoded, a result can't be encoded, or the
+// function returns a non-nil error, the evaluation which called it is
+// abandoned with a callbackError.  Applications to arguments which
+// aren't closed, such as free variables, are left unevaluated.
+func encodeFunc(val reflect.Value, typ core.Pi, c DecoderConfig) (core.Value, error) {
+	name := "go-function"
+	if !val.IsNil() {
+		if f := runtime.FuncForPC(val.Pointer()); f != nil {
+			name = funcLabel(f.Name())
+		}
+	}
+	return encodeNamedFunc(name, val, typ, c)
+}
+
+// funcLabel turns the name of a Go function, such as
+// github.com/user/pkg.Func.func1, into a simple Dhall label, such as
+// pkg_Func_func1, so that it can be quoted as a variable.
+func funcLabel(goName string) string {
+	goName = goName[strings.LastIndexByte(goName, '/')+1:]
+	label := []rune(goName)
+	for i, r := range label {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r == '_':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-'):
+		default:
+			label[i] = '_'
+		}
+	}
+	return string(label)
+}
+
+// encodeNamedFunc is like encodeFunc, but the resulting Callable is
+// quoted as the free variable name.
+func encodeNamedFunc(name string, val reflect.Value, typ core.Pi, c DecoderConfig) (core.Value, error) {
+	fnType := val.Type()
+	outs, _ := funcResults(fnType)
+	if val.IsNil() {
+		return nil, fmt.Errorf("Can't encode nil %v as %v", fnType, core.Quote(typ))
+	}
+	if fnType.NumIn() == 0 || fnType.IsVariadic() || len(outs) == 0 {
+		return nil, fmt.Errorf("Can't encode %v as a Dhall function: it must have at least one parameter and one result other than error, and not be variadic", fnType)
+	}
+	return goCallable(name, val, typ, nil, c), nil
+}
+
+// goCallable returns a Callable for the Go function fn of Dhall type
+// typ, which has already been given the arguments args.
+func goCallable(name string, fn reflect.Value, typ core.Pi, args []reflect.Value, c DecoderConfig) core.Callable {
+	fnType := fn.Type()
+	return core.NewCallable(name, typ.Domain, func(arg core.Value) core.Value {
+		goArg := reflect.New(fnType.In(len(args))).Elem()
+		if err := c.decodeValue(arg, goArg); err != nil {
+			if !isClosed(arg) {
+				return nil
+			}
+			panic(callbackError{fmt.Errorf("Can't pass %v to Go function %s: %w", core.Quote(arg), name, err)})
+		}
+		allArgs := append(append([]reflect.Value{}, args...), goArg)
+		codomain := typ.Codomain(arg)
+		if len(allArgs) < fnType.NumIn() {
+			pi, ok := codomain.(core.Pi)
+			if !ok {
+				return nil
+			}
+			return goCallable(name, fn, pi, allArgs, c)
+		}
+		results := fn.Call(allArgs)
+		outs, returnsErr := funcResults(fnType)
+		if returnsErr && !results[len(outs)].IsNil() {
+			err := results[len(outs)].Interface().(error)
+			panic(callbackError{fmt.Errorf("Go function %s returned an error: %w", name, err)})
+		}
+		result, err := encodeResults(results[:len(outs)], codomain, c)
+		if err != nil {
+			panic(callbackError{fmt.Errorf("Can't encode the result of Go function %s: %w", name, err)})
+		}
+		return result
+	})
+}
+
+// isClosed reports whether v has no free variables, so that a Go
+// function can be given it as an argument.
+func isClosed(v core.Value) bool {
+	_, err := core.TypeOf(core.Quote(v))
+	return err == nil
+}
+
+// A callbackError is panicked with by the Callable of a Go function
+// which fails, to abandon the evaluation which called it, and
+// recovered by catchCallbackError.
+type callbackError struct{ err error }
+
+// catchCallbackError recovers a callbackError, setting *err to the
+// error it carries.  It must be deferred directly.
+func catchCallbackError(err *error) {
+	if r := recover(); r != nil {
+		cbErr, ok := r.(callbackError)
+		if !ok {
+			panic(r)
+		}
+		*err = cbErr.err
+	}
+}
+
+// encodeResults encodes the results of calling a Go function as a
+// value of Dhall type typ.  This is the reverse of decodeResults: a
+// single result is encoded directly, and several results are encoded
+// as the fields `_1`, `_2` and so on of a Dhall record.
+func encodeResults(results []reflect.Value, typ core.Value, c DecoderConfig) (core.Value, error) {
+	if len(results) == 1 {
+		return encode(results[0], typ, c)
+	}
+	recordType, ok := typ.(core.RecordType)
+	if !ok || len(recordType) != len(results) {
+		return nil, fmt.Errorf("Can't encode %d results as %v", len(results), core.Quote(typ))
+	}
+	rec := core.RecordLit{}
+	for i, result := range results {
+		name := fmt.Sprintf("_%d", i+1)
+		fieldType, ok := recordType[name]
+		if !ok {
+			return nil, fmt.Errorf("Can't encode %d results as %v", len(results), core.Quote(typ))
+		}
+		var err error
+		rec[name], err = encode(result, fieldType, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
@@ -69,9 +69,13 @@ func (l LocalCache) Fetch(hash []byte) term.Term {
 	return expr
 }
 
-// Save saves the given Term to the LocalCache at the given hash.
+// Save saves the given Term to the LocalCache at the given hash,
+// creating the cache directory if necessary.
 func (l LocalCache) Save(hash []byte, e term.Term) {
 	hash16 := fmt.Sprintf("%x", hash)
+	if err := os.MkdirAll(l.path, 0755); err != nil {
+		return
+	}
 	file, err := os.Create(path.Join(l.path, hash16))
 	if err != nil {
 		return
@@ -0,0 +1,115 @@
+package imports
+
+import (
+	"context"
+
+	"github.com/philandstuff/dhall-golang/v6/binary"
+	"github.com/philandstuff/dhall-golang/v6/core"
+	. "github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// Freeze returns e with each remote import protected by its semantic
+// hash, so that the import fails if what it resolves to changes.
+// Imports are resolved as by Load, relative to the last of ancestors.
+func Freeze(e Term, ancestors ...Fetchable) (Term, error) {
+	return Freezer{}.Freeze(e, ancestors...)
+}
+
+// A Freezer protects imports with their semantic hashes.  The zero
+// Freezer behaves like Freeze.
+type Freezer struct {
+	// Loader resolves the imports being frozen.  Each resolved
+	// import is saved in its Cache.
+	Loader Loader
+	// All, if true, freezes local file and environment variable
+	// imports as well as remote ones.
+	All bool
+	// Cache, if true, freezes each import as
+	//
+	//  import sha256:... ? import
+	//
+	// so that the hash is only used to fetch the import from the
+	// cache, and the import still resolves if it changes.
+	Cache bool
+}
+
+// Freeze returns e with its imports protected by their semantic
+// hashes, according to the Freezer's settings.  Imports which are
+// already frozen are frozen again, updating their hashes if what they
+// resolve to has changed.  Imports `as Location` and `missing` are
+// left alone, as they have nothing to protect.
+func (f Freezer) Freeze(e Term, ancestors ...Fetchable) (Term, error) {
+	l := f.Loader
+	if l.Cache == nil {
+		cache, err := StandardCache()
+		if err != nil {
+			return nil, err
+		}
+		l.Cache = cache
+	}
+	return f.freeze(context.Background(), l, e, ancestors)
+}
+
+func (f Freezer) freeze(ctx context.Context, l Loader, e Term, ancestors []Fetchable) (Term, error) {
+	switch e := e.(type) {
+	case Import:
+		return f.freezeImport(ctx, l, e, ancestors)
+	case Op:
+		if e.OpCode == ImportAltOp && f.Cache && isCached(e) {
+			// already frozen with --cache; freeze the
+			// unprotected import again, rather than both sides
+			return f.freezeImport(ctx, l, e.R.(Import), ancestors)
+		}
+	}
+	return MaybeTransformSubexprs(e, func(t Term) (Term, error) {
+		return f.freeze(ctx, l, t, ancestors)
+	})
+}
+
+// isCached reports whether op is an import frozen in the form
+// `import sha256:... ? import`.
+func isCached(op Op) bool {
+	frozen, ok := op.L.(Import)
+	if !ok || frozen.Hash == nil {
+		return false
+	}
+	unprotected, ok := op.R.(Import)
+	return ok && unprotected.Hash == nil &&
+		unprotected.ImportMode == frozen.ImportMode &&
+		unprotected.Fetchable.String() == frozen.Fetchable.String()
+}
+
+func (f Freezer) freezeImport(ctx context.Context, l Loader, i Import, ancestors []Fetchable) (Term, error) {
+	if i.ImportMode == Location {
+		return i, nil
+	}
+	switch i.Fetchable.(type) {
+	case Missing:
+		return i, nil
+	case RemoteFile:
+	default:
+		if !f.All {
+			return i, nil
+		}
+	}
+	unprotected := Import{ImportHashed: ImportHashed{Fetchable: i.Fetchable}, ImportMode: i.ImportMode}
+	resolved, err := l.load(ctx, unprotected, ancestors...)
+	if err != nil {
+		return nil, err
+	}
+	value, err := core.Evaluator{Limits: l.Limits}.Eval(ctx, resolved)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := binary.SemanticHash(value)
+	if err != nil {
+		return nil, err
+	}
+	l.Cache.Save(hash, core.QuoteAlphaNormal(value))
+	frozen := unprotected
+	frozen.Hash = hash
+	if f.Cache {
+		return Op{OpCode: ImportAltOp, L: frozen, R: unprotected}, nil
+	}
+	return frozen, nil
+}
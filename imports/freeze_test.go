@@ -0,0 +1,92 @@
+package imports_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/philandstuff/dhall-golang/v6/binary"
+	"github.com/philandstuff/dhall-golang/v6/core"
+	. "github.com/philandstuff/dhall-golang/v6/imports"
+	. "github.com/philandstuff/dhall-golang/v6/internal"
+	. "github.com/philandstuff/dhall-golang/v6/term"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+// memoryCache is a DhallCache which keeps what is saved in memory.
+type memoryCache map[string]Term
+
+func (m memoryCache) Fetch(hash []byte) Term      { return m[fmt.Sprintf("%x", hash)] }
+func (m memoryCache) Save(hash []byte, term Term) { m[fmt.Sprintf("%x", hash)] = term }
+
+// hashed returns i protected by hash.
+func hashed(i Import, hash []byte) Import {
+	i.Hash = hash
+	return i
+}
+
+var _ = Describe("Freeze", func() {
+	var server *ghttp.Server
+	var cache memoryCache
+	var remote, local Import
+	var hash []byte
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AllowUnhandledRequests = true
+		server.RouteToHandler("GET", "/foo.dhall", ghttp.RespondWith(http.StatusOK, "1 + 2"))
+		cache = memoryCache{}
+		remote = NewRemoteImport(server.URL()+"/foo.dhall", Code)
+		local = NewLocalImport("./testdata/natural.dhall", Code)
+		var err error
+		hash, err = binary.SemanticHash(core.Eval(NaturalLit(3)))
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+	It("Freezes remote imports, and saves them in the cache", func() {
+		frozen, err := Freezer{Loader: Loader{Cache: cache}}.Freeze(
+			NewList(remote, local))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(NewList(hashed(remote, hash), local)))
+		Expect(cache.Fetch(hash)).To(Equal(NaturalLit(3)))
+	})
+	It("Freezes every import with All", func() {
+		frozen, err := Freezer{Loader: Loader{Cache: cache}, All: true}.Freeze(
+			NewList(remote, local))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(NewList(hashed(remote, hash), hashed(local, hash))))
+	})
+	It("Leaves imports as Location and missing alone", func() {
+		input := NewList(
+			NewRemoteImport(server.URL()+"/foo.dhall", Location),
+			Import{ImportHashed: ImportHashed{Fetchable: Missing{}}})
+		frozen, err := Freezer{Loader: Loader{Cache: cache}, All: true}.Freeze(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(input))
+	})
+	It("Updates the hashes of imports which are already frozen", func() {
+		frozen, err := Freezer{Loader: Loader{Cache: cache}}.Freeze(
+			hashed(remote, make([]byte, 34)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(hashed(remote, hash)))
+	})
+	It("Adds unprotected fallbacks with Cache", func() {
+		cached := Op{OpCode: ImportAltOp, L: hashed(remote, hash), R: remote}
+		freezer := Freezer{Loader: Loader{Cache: cache}, Cache: true}
+		frozen, err := freezer.Freeze(remote)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(cached))
+
+		frozen, err = freezer.Freeze(frozen)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(cached))
+	})
+	It("Fails if an import can't be resolved", func() {
+		_, err := Freezer{Loader: Loader{Cache: cache}}.Freeze(
+			NewRemoteImport(server.URL()+"/missing.dhall", Code))
+		Expect(err).To(HaveOccurred())
+	})
+})
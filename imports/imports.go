@@ -2,6 +2,8 @@ package imports
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/philandstuff/dhall-golang/v6/binary"
@@ -13,18 +15,117 @@ import (
 
 // Load takes a Term and resolves all imports
 func Load(e Term, ancestors ...Fetchable) (Term, error) {
-	cache, err := StandardCache()
-	if err != nil {
-		return nil, err
-	}
-	return LoadWith(cache, e, ancestors...)
+	return Loader{}.Load(e, ancestors...)
+}
+
+// LoadContext takes a Term and resolves all imports, like Load, but
+// gives up and returns ctx.Err() if ctx is cancelled or its deadline
+// passes before it has finished.
+func LoadContext(ctx context.Context, e Term, ancestors ...Fetchable) (Term, error) {
+	return Loader{}.LoadContext(ctx, e, ancestors...)
 }
 
 // LoadWith takes a Term and resolves all imports, using cache for
 // saving and fetching imports
 func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
+	return Loader{Cache: cache}.Load(e, ancestors...)
+}
+
+// An ImportPolicy decides whether an import may be resolved.  It is
+// given the import's target, after chaining onto the importing file,
+// and returns an error if the import is forbidden.
+type ImportPolicy func(Fetchable) error
+
+// DisallowRemote is an ImportPolicy which forbids remote imports.
+var DisallowRemote ImportPolicy = func(f Fetchable) error {
+	if _, ok := f.(RemoteFile); ok {
+		return fmt.Errorf("Remote import %s is not allowed", f)
+	}
+	return nil
+}
+
+// DisallowAll is an ImportPolicy which forbids all imports, except
+// for the special import `missing`.
+var DisallowAll ImportPolicy = func(f Fetchable) error {
+	if _, ok := f.(Missing); ok {
+		return nil
+	}
+	return fmt.Errorf("Import %s is not allowed", f)
+}
+
+// A Loader resolves imports.  The zero Loader behaves like Load.
+type Loader struct {
+	// Cache is used for saving and fetching imports protected by
+	// a hash.  If nil, the StandardCache is used.
+	Cache DhallCache
+	// LookupEnv is used to resolve environment variable imports.
+	// If nil, os.LookupEnv is used.
+	LookupEnv func(name string) (string, bool)
+	// Policy, if not nil, is consulted before resolving each
+	// import.
+	Policy ImportPolicy
+	// Limits bound the resources used to typecheck and evaluate
+	// each imported expression.
+	Limits core.Limits
+	// FieldOrder, if not nil, records the order of the fields of
+	// record literals in imported expressions.  Expressions fetched
+	// from the cache are not parsed, so their order isn't recorded.
+	FieldOrder *parser.FieldOrder
+	// Unevaluated, if true, substitutes each imported expression as
+	// it was parsed, with its own imports resolved, rather than
+	// normalized.  Imports are still typechecked, and their hashes
+	// checked; imports fetched from the cache are substituted in
+	// normal form, as that is all the cache holds.
+	Unevaluated bool
+}
+
+// Load takes a Term and resolves all imports, according to the
+// Loader's settings.
+func (l Loader) Load(e Term, ancestors ...Fetchable) (Term, error) {
+	return l.LoadContext(context.Background(), e, ancestors...)
+}
+
+// LoadContext is like Load, but gives up and returns ctx.Err() if ctx
+// is cancelled or its deadline passes before it has finished.  This
+// includes abandoning any HTTP requests in progress, and the
+// evaluation of imported expressions.
+func (l Loader) LoadContext(ctx context.Context, e Term, ancestors ...Fetchable) (Term, error) {
+	if l.Cache == nil {
+		cache, err := StandardCache()
+		if err != nil {
+			return nil, err
+		}
+		l.Cache = cache
+	}
+	return l.load(ctx, e, ancestors...)
+}
+
+// fetch fetches here, using l.LookupEnv for environment variables.
+func (l Loader) fetch(ctx context.Context, here Fetchable, origin string) (string, error) {
+	if remote, ok := here.(RemoteFile); ok {
+		return remote.FetchContext(ctx, origin)
+	}
+	env, ok := here.(EnvVar)
+	if !ok || l.LookupEnv == nil {
+		return here.Fetch(origin)
+	}
+	if origin != NullOrigin {
+		return "", errors.New("Can't access environment variable from remote import")
+	}
+	val, ok := l.LookupEnv(string(env))
+	if !ok {
+		return "", fmt.Errorf("Unset environment variable %s", string(env))
+	}
+	return val, nil
+}
+
+func (l Loader) load(ctx context.Context, e Term, ancestors ...Fetchable) (Term, error) {
+	cache := l.Cache
 	switch e := e.(type) {
 	case Import:
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		here := e.Fetchable
 		origin := term.NullOrigin
 		if len(ancestors) >= 1 {
@@ -39,6 +140,11 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		if e.ImportMode == Location {
 			return here.AsLocation(), nil
 		}
+		if l.Policy != nil {
+			if err := l.Policy(here); err != nil {
+				return nil, err
+			}
+		}
 
 		for _, ancestor := range ancestors {
 			if ancestor == here {
@@ -52,7 +158,7 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			}
 		}
 		imports := append(ancestors, here)
-		content, err := here.Fetch(origin)
+		content, err := l.fetch(ctx, here, origin)
 		if err != nil {
 			return nil, err
 		}
@@ -61,27 +167,40 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			expr = PlainText(content)
 		} else {
 			// dynamicExpr may contain more imports
-			dynamicExpr, err := parser.Parse(here.String(), []byte(content))
+			var opts []parser.Option
+			if l.FieldOrder != nil {
+				opts = append(opts, parser.RecordFieldOrder(l.FieldOrder))
+			}
+			dynamicExpr, err := parser.Parse(here.String(), []byte(content), opts...)
 			if err != nil {
 				return nil, err
 			}
 
 			// recursively load any more imports
-			expr, err = LoadWith(cache, dynamicExpr, imports...)
+			expr, err = l.load(ctx, dynamicExpr, imports...)
 			if err != nil {
 				return nil, err
 			}
 
 			// ensure that expr typechecks in empty context
-			_, err = core.TypeOf(expr)
+			_, err = core.Evaluator{Limits: l.Limits}.TypeOf(ctx, expr)
 			if err != nil {
 				return nil, err
 			}
 		}
 
+		if l.Unevaluated && e.Hash == nil {
+			return expr, nil
+		}
+
 		// evaluate expression
-		exprVal := core.Eval(expr)
-		expr = core.Quote(exprVal)
+		exprVal, err := core.Evaluator{Limits: l.Limits}.Eval(ctx, expr)
+		if err != nil {
+			return nil, err
+		}
+		if !l.Unevaluated {
+			expr = core.Quote(exprVal)
+		}
 
 		// check hash, if supplied
 		if e.Hash != nil {
@@ -98,21 +217,25 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		return expr, nil
 	case Op:
 		if e.OpCode == ImportAltOp {
-			resolvedL, err := LoadWith(cache, e.L, ancestors...)
+			resolvedL, err := l.load(ctx, e.L, ancestors...)
 			if err == nil {
 				return resolvedL, nil
 			}
-			resolvedR, err := LoadWith(cache, e.R, ancestors...)
+			if ctx.Err() != nil {
+				// don't fall back if we've been cancelled
+				return nil, ctx.Err()
+			}
+			resolvedR, err := l.load(ctx, e.R, ancestors...)
 			if err != nil {
 				return nil, err
 			}
 			return resolvedR, nil
 		}
-		resolvedL, err := LoadWith(cache, e.L, ancestors...)
+		resolvedL, err := l.load(ctx, e.L, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedR, err := LoadWith(cache, e.R, ancestors...)
+		resolvedR, err := l.load(ctx, e.R, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -120,7 +243,7 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 	default:
 		// Const, NaturalLit, etc
 		return term.MaybeTransformSubexprs(e, func(t Term) (Term, error) {
-			return LoadWith(cache, t, ancestors...)
+			return l.load(ctx, t, ancestors...)
 		})
 	}
 }
@@ -1,9 +1,12 @@
 package imports_test
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"os"
+	"time"
 
 	. "github.com/philandstuff/dhall-golang/v6/imports"
 	. "github.com/philandstuff/dhall-golang/v6/internal"
@@ -93,6 +96,34 @@ var _ = Describe("Import resolution", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(actual).To(Equal(NaturalLit(3)))
 		})
+		It("Gives up on slow servers when the context times out", func() {
+			server.RouteToHandler("GET", "/slow.dhall",
+				func(w http.ResponseWriter, r *http.Request) {
+					<-r.Context().Done()
+				},
+			)
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			_, err := LoadContext(ctx, NewRemoteImport(server.URL()+"/slow.dhall", Code))
+
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+		It("Doesn't fall back to an alternative when the context times out", func() {
+			server.RouteToHandler("GET", "/slow.dhall",
+				func(w http.ResponseWriter, r *http.Request) {
+					<-r.Context().Done()
+				},
+			)
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			_, err := LoadContext(ctx, Op{
+				OpCode: ImportAltOp,
+				L:      NewRemoteImport(server.URL()+"/slow.dhall", Code),
+				R:      NaturalLit(1),
+			})
+
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
 		It("Fails to resolve code with free variables", func() {
 			server.RouteToHandler("GET", "/foo.dhall",
 				ghttp.RespondWith(http.StatusOK, "x"),
@@ -212,4 +243,88 @@ var _ = Describe("Import resolution", func() {
 			Eventually(result).Should(Receive())
 		})
 	})
+	Describe("LoadContext", func() {
+		It("Fails if the context is already cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := LoadContext(ctx, NewEnvVarImport("FOO", RawText))
+
+			Expect(err).To(Equal(context.Canceled))
+		})
+		It("Resolves terms without imports regardless", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			actual, err := LoadContext(ctx, NaturalLit(1))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(NaturalLit(1)))
+		})
+	})
+	Describe("Loader", func() {
+		It("Uses LookupEnv for environment variables", func() {
+			loader := Loader{
+				Cache: NoCache{},
+				LookupEnv: func(name string) (string, bool) {
+					if name == "FOO" {
+						return "2 + 2", true
+					}
+					return "", false
+				},
+			}
+			actual, err := loader.Load(NewEnvVarImport("FOO", Code))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(NaturalLit(4)))
+
+			_, err = loader.Load(NewEnvVarImport("BAR", Code))
+			Expect(err).To(MatchError("Unset environment variable BAR"))
+		})
+		It("Rejects imports forbidden by its Policy", func() {
+			loader := Loader{Cache: NoCache{}, Policy: DisallowAll}
+			_, err := loader.Load(NewLocalImport("./testdata/natural.dhall", Code))
+
+			Expect(err).To(HaveOccurred())
+		})
+		It("Applies its Policy to chained imports", func() {
+			loader := Loader{
+				Cache: NoCache{},
+				Policy: func(f Fetchable) error {
+					if f == LocalFile("testdata/chain2.dhall") {
+						return errors.New("forbidden")
+					}
+					return nil
+				},
+			}
+			_, err := loader.Load(NewLocalImport("./testdata/chain1.dhall", Code))
+
+			Expect(err).To(MatchError("forbidden"))
+		})
+		It("Allows missing with DisallowAll", func() {
+			loader := Loader{Cache: NoCache{}, Policy: DisallowAll}
+			actual, err := loader.Load(Op{
+				OpCode: ImportAltOp,
+				L:      Import{ImportHashed: ImportHashed{Fetchable: Missing{}}},
+				R:      NaturalLit(1),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(NaturalLit(1)))
+		})
+		It("Substitutes imports unevaluated if Unevaluated is set", func() {
+			loader := Loader{Cache: NoCache{}, Unevaluated: true}
+			actual, err := loader.Load(NewLocalImport("./testdata/chain1.dhall", Code))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(Op{OpCode: PlusOp, L: NaturalLit(2), R: NaturalLit(2)}))
+
+			_, err = loader.Load(NewLocalImport("./testdata/free_variable.dhall", Code))
+			Expect(err).To(HaveOccurred())
+		})
+		It("Rejects remote imports with DisallowRemote", func() {
+			loader := Loader{Cache: NoCache{}, Policy: DisallowRemote}
+			_, err := loader.Load(NewRemoteImport("https://example.com/foo.dhall", Code))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
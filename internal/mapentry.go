@@ -0,0 +1,15 @@
+package internal
+
+import "github.com/philandstuff/dhall-golang/v6/core"
+
+// IsMapEntryType reports whether recordType, a RecordType or
+// RecordLit, has exactly the fields `mapKey` and `mapValue` of an
+// entry of a Prelude Map.
+func IsMapEntryType(recordType map[string]core.Value) bool {
+	if _, ok := recordType["mapKey"]; ok {
+		if _, ok := recordType["mapValue"]; ok {
+			return len(recordType) == 2
+		}
+	}
+	return false
+}
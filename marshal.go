@@ -0,0 +1,283 @@
+package dhall
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+)
+
+// Union is a marker type.  Embed it in a struct to mark the struct
+// as representing a Dhall union type rather than a record type, when
+// a Dhall type has to be inferred from the Go type (for example, by
+// Marshal).
+//
+// Each other field of the struct is an alternative of the union.
+// Bool fields are empty alternatives, set when true.  Pointer, slice,
+// map and func fields are alternatives with a value, set when not
+// nil.  Fields of any other type could never be set, so a Dhall type
+// can't be inferred for a union with one.  Exactly one field should
+// be set.
+type Union struct{}
+
+var unionMarker = reflect.TypeOf(Union{})
+
+// Marshaler is the interface implemented by types that can encode
+// themselves as a Dhall value.  When a Dhall type has to be inferred
+// for a Marshaler (for example, by Marshal), it is the type of the
+// value returned by MarshalDhall on the zero value.
+type Marshaler interface {
+	MarshalDhall() (core.Value, error)
+}
+
+var marshalerType = reflect.TypeOf((*Marshaler)(nil)).Elem()
+
+// marshalerFor returns the Marshaler that val should be encoded
+// with, or nil if val's type doesn't implement Marshaler.
+func marshalerFor(val reflect.Value) Marshaler {
+	if val.Kind() == reflect.Ptr && val.IsNil() {
+		return nil
+	}
+	if val.Kind() != reflect.Interface && val.Type().Implements(marshalerType) {
+		return val.Interface().(Marshaler)
+	}
+	if val.CanAddr() && reflect.PtrTo(val.Type()).Implements(marshalerType) {
+		return val.Addr().Interface().(Marshaler)
+	}
+	return nil
+}
+
+// encodeMarshaler calls m.MarshalDhall and checks that the result has
+// the Dhall type typ.
+func encodeMarshaler(m Marshaler, typ core.Value) (core.Value, error) {
+	result, err := m.MarshalDhall()
+	if err != nil {
+		return nil, err
+	}
+	resultType, err := core.TypeOf(core.Quote(result))
+	if err != nil {
+		return nil, err
+	}
+	if !core.AlphaEquivalent(resultType, typ) {
+		return nil, fmt.Errorf("Can't encode %v as %v: MarshalDhall returned a value of type %v", m, typ, resultType)
+	}
+	return result, nil
+}
+
+// Marshal returns Dhall source text representing the Go value v.
+//
+// The Dhall type of the result is inferred from the Go type of v by
+// TypeFor:
+// bools become Bool, signed integers become Integer, unsigned
+// integers become Natural, floats become Double, strings become
+// Text, slices and arrays become Lists (except slices and arrays of
+// bytes, which become Text), maps become Lists of mapKey/mapValue
+// records, pointers become Optionals, and structs become records
+// (or unions, if they embed Union).  Struct fields are named in the
+// same way as for Decode.  Fields tagged with the `omitempty` option,
+// as in `dhall:"port,omitempty"`, are encoded as None or an empty list
+// when they are empty (false, 0, "", nil or of length 0) and their
+// Dhall type is Optional or List.  Types which implement Marshaler encode
+// themselves; types which implement encoding.TextMarshaler, as well
+// as url.URL and time.Duration, become Text.
+//
+// Empty lists and None values are annotated with their type, so that
+// the output is a well-typed Dhall expression which can be read back
+// with Unmarshal.  Marshal returns an error for values which are or
+// contain non-nil Go functions, as they have no Dhall source.
+func Marshal(v interface{}) ([]byte, error) {
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return nil, errors.New("Can't marshal nil")
+	}
+	// make val addressable, so that we can find MarshalDhall
+	// methods with pointer receivers
+	addressable := reflect.New(val.Type()).Elem()
+	addressable.Set(val)
+	val = addressable
+	typ, err := TypeFor(val.Type())
+	if err != nil {
+		return nil, err
+	}
+	dhallVal, err := encode(val, typ, DecoderConfig{})
+	if err != nil {
+		return nil, err
+	}
+	if !isClosed(dhallVal) {
+		// Go functions become free variables
+		return nil, fmt.Errorf("Can't marshal %v: Go functions have no Dhall source", val.Type())
+	}
+	return []byte(fmt.Sprint(core.Quote(dhallVal))), nil
+}
+
+// TypeFor returns the Dhall type corresponding to the Go type t: the
+// type which Marshal encodes values of type t as, and which Dhall
+// values should have in order to be decoded into t.  See Marshal for
+// how Go types correspond to Dhall types.  Struct fields are named
+// by their `dhall` tags, as for Decode.  Function types become Dhall
+// function types, with several results becoming a record with fields
+// `_1`, `_2` and so on, and a final error result left out.
+//
+// TypeFor returns an error for Go types with no corresponding Dhall
+// type, such as channels, interfaces and recursive types.
+func TypeFor(t reflect.Type) (core.Value, error) {
+	return typeFor(t, nil)
+}
+
+// typeFor is TypeFor, with struct fields without a tag name named by
+// names, if it is not nil.
+func typeFor(t reflect.Type, names FieldNamer) (core.Value, error) {
+	return inferType(t, names, map[reflect.Type]bool{})
+}
+
+// inferType is typeFor, failing if t is among the types visiting,
+// whose Dhall types are being inferred further up the recursion:
+// recursive Go types have no Dhall type.
+func inferType(t reflect.Type, names FieldNamer, visiting map[reflect.Type]bool) (core.Value, error) {
+	if visiting[t] {
+		return nil, fmt.Errorf("Can't infer a Dhall type for recursive Go type %v", t)
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+	if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface &&
+		(t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType)) {
+		zero := reflect.New(t)
+		result, err := zero.Interface().(Marshaler).MarshalDhall()
+		if err != nil {
+			return nil, err
+		}
+		return core.TypeOf(core.Quote(result))
+	}
+	if isTextType(t) {
+		return core.Text, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return core.Bool, nil
+	case reflect.Int, reflect.Int8, reflect.Int16,
+		reflect.Int32, reflect.Int64:
+		return core.Integer, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return core.Natural, nil
+	case reflect.Float32, reflect.Float64:
+		return core.Double, nil
+	case reflect.String:
+		return core.Text, nil
+	case reflect.Ptr:
+		elem, err := inferType(t.Elem(), names, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return core.OptionalOf{Type: elem}, nil
+	case reflect.Slice, reflect.Array:
+		elem, err := inferType(t.Elem(), names, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return core.ListOf{Type: elem}, nil
+	case reflect.Map:
+		key, err := inferType(t.Key(), names, visiting)
+		if err != nil {
+			return nil, err
+		}
+		value, err := inferType(t.Elem(), names, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return core.ListOf{Type: core.RecordType{
+			"mapKey":   key,
+			"mapValue": value,
+		}}, nil
+	case reflect.Struct:
+		if isUnionStruct(t) {
+			return unionTypeFor(t, names, visiting)
+		}
+		record := core.RecordType{}
+		for _, field := range structFields(t, names) {
+			fieldType, err := inferType(field.typ, names, visiting)
+			if err != nil {
+				return nil, err
+			}
+			record[field.name] = fieldType
+		}
+		return record, nil
+	case reflect.Func:
+		return funcTypeFor(t, names, visiting)
+	}
+	return nil, fmt.Errorf("Can't infer a Dhall type for Go type %v", t)
+}
+
+// funcTypeFor returns the Dhall function type for the Go function
+// type t.
+func funcTypeFor(t reflect.Type, names FieldNamer, visiting map[reflect.Type]bool) (core.Value, error) {
+	outs, _ := funcResults(t)
+	if t.NumIn() == 0 || t.IsVariadic() || len(outs) == 0 {
+		return nil, fmt.Errorf("Can't infer a Dhall type for Go type %v", t)
+	}
+	var result core.Value
+	if len(outs) == 1 {
+		var err error
+		result, err = inferType(outs[0], names, visiting)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		record := core.RecordType{}
+		for i, out := range outs {
+			fieldType, err := inferType(out, names, visiting)
+			if err != nil {
+				return nil, err
+			}
+			record[fmt.Sprintf("_%d", i+1)] = fieldType
+		}
+		result = record
+	}
+	for i := t.NumIn() - 1; i >= 0; i-- {
+		argType, err := inferType(t.In(i), names, visiting)
+		if err != nil {
+			return nil, err
+		}
+		result = core.NewFnType("_", argType, result)
+	}
+	return result, nil
+}
+
+// isUnionStruct reports whether the struct type t embeds Union.
+func isUnionStruct(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type == unionMarker {
+			return true
+		}
+	}
+	return false
+}
+
+// unionTypeFor returns the Dhall union type for the struct type t,
+// which embeds Union.
+func unionTypeFor(t reflect.Type, names FieldNamer, visiting map[reflect.Type]bool) (core.Value, error) {
+	union := core.UnionType{}
+	for _, field := range structFields(t, names) {
+		switch field.typ.Kind() {
+		case reflect.Bool:
+			union[field.name] = nil
+		case reflect.Ptr:
+			altType, err := inferType(field.typ.Elem(), names, visiting)
+			if err != nil {
+				return nil, err
+			}
+			union[field.name] = altType
+		case reflect.Func, reflect.Map, reflect.Slice:
+			altType, err := inferType(field.typ, names, visiting)
+			if err != nil {
+				return nil, err
+			}
+			union[field.name] = altType
+		default:
+			return nil, fmt.Errorf("Can't infer a Dhall type for %v: alternative %s can never be set, as it isn't a bool, pointer, slice, map or func field", t, field.name)
+		}
+	}
+	return union, nil
+}
@@ -0,0 +1,283 @@
+package dhall_test
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"time"
+
+	. "github.com/philandstuff/dhall-golang/v6"
+	"github.com/philandstuff/dhall-golang/v6/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func MarshalAndCompare(input interface{}, expected string) {
+	actual, err := Marshal(input)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(actual)).To(Equal(expected))
+}
+
+// MarshalRoundTrip marshals input, unmarshals the result into a new
+// value of the same type, and checks that it is equal to input.
+func MarshalRoundTrip(input interface{}) {
+	b, err := Marshal(input)
+	Expect(err).ToNot(HaveOccurred())
+	ptr := reflect.New(reflect.TypeOf(input))
+	err = Unmarshal(b, ptr.Interface())
+	Expect(err).ToNot(HaveOccurred(), "while unmarshalling %s", b)
+	Expect(ptr.Elem().Interface()).To(Equal(input))
+}
+
+type testMarshalUnion struct {
+	Union
+	Postgres *testStruct
+	Sqlite   *string `dhall:"sqlite"`
+	Memory   bool
+}
+
+type testMarshalConfig struct {
+	Name     string
+	Port     uint16
+	Offset   int
+	Ratio    float64
+	Debug    bool
+	Tags     []string
+	Labels   map[string]string
+	Timeout  *uint
+	Database testMarshalUnion `dhall:"database"`
+	internal string
+}
+
+type testOmitEmpty struct {
+	Name    string   `dhall:"name"`
+	Port    *uint    `dhall:"port,omitempty"`
+	Tags    []string `dhall:"tags,omitempty"`
+	Comment string   `dhall:",omitempty"`
+}
+
+type testNode struct {
+	Value uint
+	Next  *testNode
+}
+
+type testStdlibTypes struct {
+	IP      net.IP
+	URL     *url.URL
+	Timeout time.Duration
+}
+
+func TypeForAndCompare(value interface{}, expected core.Value) {
+	actual, err := TypeFor(reflect.TypeOf(value))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(actual).To(Equal(expected))
+}
+
+var _ = Describe("TypeFor", func() {
+	DescribeTable("Go types", TypeForAndCompare,
+		Entry("bool", true, core.Bool),
+		Entry("uint8", uint8(0), core.Natural),
+		Entry("int", 0, core.Integer),
+		Entry("float32", float32(0), core.Double),
+		Entry("string", "", core.Text),
+		Entry("pointer", new(string), core.OptionalOf{Type: core.Text}),
+		Entry("slice", []bool{}, core.ListOf{Type: core.Bool}),
+		Entry("array", [2]bool{}, core.ListOf{Type: core.Bool}),
+		Entry("byte slice", []byte{}, core.Text),
+		Entry("byte array", [4]byte{}, core.Text),
+		Entry("map", map[string]int{}, core.ListOf{Type: core.RecordType{
+			"mapKey":   core.Text,
+			"mapValue": core.Integer,
+		}}),
+		Entry("struct with tags", testTaggedStruct{}, core.RecordType{
+			"baz": core.Natural,
+			"Bar": core.Text,
+		}),
+		Entry("struct with embedded and inline structs", testStructWithOptions{}, core.RecordType{
+			"Foo": core.Natural,
+			"bar": core.Text,
+			"Bar": core.Text,
+			"baz": core.Natural,
+		}),
+		Entry("union", testMarshalUnion{}, core.UnionType{
+			"Postgres": core.RecordType{"Foo": core.Natural, "Bar": core.Text},
+			"sqlite":   core.Text,
+			"Memory":   nil,
+		}),
+		Entry("Marshaler", testSize(0), core.RecordType{
+			"amount": core.Natural,
+			"unit":   testSizeUnit,
+		}),
+		Entry("encoding.TextMarshaler", net.IP{}, core.Text),
+		Entry("time.Duration", time.Duration(0), core.Text),
+	)
+	It("Gives function types for funcs", func() {
+		actual, err := TypeFor(reflect.TypeOf(func(string, uint) (bool, error) { return false, nil }))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(core.Quote(actual)).To(Equal(core.Quote(
+			core.NewFnType("_", core.Text, core.NewFnType("_", core.Natural, core.Bool)))))
+	})
+	It("Gives records for funcs with several results", func() {
+		actual, err := TypeFor(reflect.TypeOf(func(int) (bool, string) { return false, "" }))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(core.Quote(actual)).To(Equal(core.Quote(
+			core.NewFnType("_", core.Integer, core.RecordType{"_1": core.Bool, "_2": core.Text}))))
+	})
+	It("Fails on interfaces", func() {
+		_, err := TypeFor(reflect.TypeOf([]interface{}{}))
+		Expect(err).To(HaveOccurred())
+	})
+	It("Fails on recursive types", func() {
+		_, err := TypeFor(reflect.TypeOf(testNode{}))
+		Expect(err).To(MatchError("Can't infer a Dhall type for recursive Go type dhall_test.testNode"))
+		_, err = Marshal(testNode{Value: 1, Next: &testNode{Value: 2}})
+		Expect(err).To(HaveOccurred())
+	})
+	It("Allows a type to appear more than once", func() {
+		actual, err := TypeFor(reflect.TypeOf(struct{ A, B *uint }{}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(core.RecordType{
+			"A": core.OptionalOf{Type: core.Natural},
+			"B": core.OptionalOf{Type: core.Natural},
+		}))
+	})
+})
+
+var _ = Describe("Marshal", func() {
+	DescribeTable("Simple types", MarshalAndCompare,
+		Entry("Bool", true, `True`),
+		Entry("Natural", uint(3), `3`),
+		Entry("Integer", 3, `+3`),
+		Entry("negative Integer", -3, `-3`),
+		Entry("Double", 3.5, `3.5`),
+		Entry("whole Double", 3.0, `3.0`),
+		Entry("Text", "foo", `"foo"`),
+		Entry("Text with escapes", "a \"b\"\n${c}", `"a \"b\"\n\u0024{c}"`),
+	)
+	DescribeTable("Compound types", MarshalAndCompare,
+		Entry("List", []uint{1, 2}, `[ 1, 2 ]`),
+		Entry("array", [2]uint{1, 2}, `[ 1, 2 ]`),
+		Entry("empty List", []uint{}, `[] : List Natural`),
+		Entry("Some", &[]string{"foo"}[0], `Some "foo"`),
+		Entry("None", (*int)(nil), `None Integer`),
+		Entry("Map",
+			map[string]uint{"foo": 1, "bar": 2},
+			`[ { mapKey = "bar", mapValue = 2 }, { mapKey = "foo", mapValue = 1 } ]`),
+		Entry("empty Map",
+			map[string]uint{},
+			`[] : List { mapKey : Text, mapValue : Natural }`),
+		Entry("record with tags and keywords",
+			struct {
+				A    bool
+				Else bool   `dhall:"else"`
+				Foo  string `dhall:"foo bar"`
+			}{},
+			"{ A = False, `else` = False, `foo bar` = \"\" }"),
+		Entry("union with empty alternative",
+			testMarshalUnion{Memory: true},
+			`< Memory | Postgres : { Bar : Text, Foo : Natural } | sqlite : Text >.Memory`),
+		Entry("union with value",
+			testMarshalUnion{Sqlite: &[]string{"db"}[0]},
+			`< Memory | Postgres : { Bar : Text, Foo : Natural } | sqlite : Text >.sqlite "db"`),
+		Entry("record with empty omitempty fields",
+			testOmitEmpty{Name: "a"},
+			`{ Comment = "", name = "a", port = None Natural, tags = [] : List Text }`),
+		Entry("record with non-empty omitempty fields",
+			testOmitEmpty{Name: "a", Tags: []string{"b"}, Comment: "c"},
+			`{ Comment = "c", name = "a", port = None Natural, tags = [ "b" ] }`),
+		Entry("record with nil embedded pointer",
+			testStructWithPointer{Baz: true},
+			`{ Baz = True, Foo = 0, bar = "" }`),
+		Entry("Marshaler",
+			testSize(2048),
+			`{ amount = 2, unit = < KB | MB >.KB }`),
+		Entry("encoding.TextMarshaler", net.IPv4(10, 0, 0, 1), `"10.0.0.1"`),
+		Entry("url.URL",
+			url.URL{Scheme: "https", Host: "example.com", Path: "/a"},
+			`"https://example.com/a"`),
+		Entry("time.Duration", 90*time.Second, `"1m30s"`),
+	)
+	DescribeTable("Round trips", MarshalRoundTrip,
+		Entry("standard library types", testStdlibTypes{
+			IP:      net.IPv4(10, 0, 0, 1),
+			URL:     &url.URL{Scheme: "https", Host: "example.com", Path: "/a"},
+			Timeout: 90 * time.Second,
+		}),
+		Entry("Marshalers at every level", testSizes{
+			Max:  testSize(1 << 20),
+			Min:  &[]testSize{1 << 10}[0],
+			List: []testSize{},
+		}),
+		Entry("Natural", uint(3)),
+		Entry("Text", "a \"b\"\n${c} \\ \t\u0001"),
+		Entry("List of Optionals", []*int{nil, &[]int{-1}[0]}),
+		Entry("empty List", []string{}),
+		Entry("array", [3]int{1, -2, 3}),
+		Entry("byte slice", []byte("héllo")),
+		Entry("byte array", [4]byte{'a', 'b', 'c', 'd'}),
+		Entry("Map", map[string][]uint{"a": {}, "b": {1, 2}}),
+		Entry("union", testMarshalUnion{Postgres: &testStruct{Foo: 1, Bar: "x"}}),
+		Entry("embedded and inline structs", testStructWithOptions{
+			testEmbedded: testEmbedded{Foo: 1, Bar: "a"},
+			Nested:       testTaggedStruct{Foo: 2},
+			Bar:          "c",
+		}),
+		Entry("List of records with omitempty fields", []testOmitEmpty{
+			{Name: "a", Tags: []string{}},
+			{Name: "b", Port: new(uint), Tags: []string{"c"}},
+		}),
+		Entry("config", testMarshalConfig{
+			Name:     "server",
+			Port:     8080,
+			Offset:   -2,
+			Ratio:    0.25,
+			Tags:     []string{},
+			Labels:   map[string]string{"env": "prod"},
+			Database: testMarshalUnion{Memory: true},
+		}),
+	)
+	It("Fails on Go functions", func() {
+		_, err := Marshal(func(x uint) uint { return x })
+		Expect(err).To(MatchError("Can't marshal func(uint) uint: Go functions have no Dhall source"))
+	})
+	It("Fails on Go functions in fields", func() {
+		type handlers struct {
+			Name     string
+			OnChange func(string) bool
+		}
+		_, err := Marshal(handlers{Name: "a", OnChange: func(string) bool { return true }})
+		Expect(err).To(HaveOccurred())
+		b, err := Marshal(struct{ OnChange *func(string) bool }{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(b)).To(Equal("{ OnChange = None (Text → Bool) }"))
+	})
+	It("Fails on nil", func() {
+		_, err := Marshal(nil)
+		Expect(err).To(HaveOccurred())
+	})
+	It("Fails on types with no Dhall equivalent", func() {
+		_, err := Marshal(make(chan int))
+		Expect(err).To(HaveOccurred())
+	})
+	It("Returns errors from MarshalDhall", func() {
+		_, err := Marshal(testSize(1))
+		Expect(err).To(MatchError("1 is not a whole number of KB"))
+	})
+	It("Fails on a union with an alternative which can never be set", func() {
+		type server struct {
+			Union
+			Port int
+		}
+		_, err := TypeFor(reflect.TypeOf(server{}))
+		Expect(err).To(MatchError(ContainSubstring("alternative Port can never be set")))
+
+		_, err = Marshal(server{Port: 80})
+		Expect(err).To(HaveOccurred())
+	})
+	It("Fails on a union with more than one alternative set", func() {
+		_, err := Marshal(testMarshalUnion{Memory: true, Sqlite: new(string)})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,104 @@
+package dhall
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+)
+
+// An OrderedMap is a Dhall record, or a Prelude Map with Text keys,
+// decoded into an interface{} by a DecoderConfig with
+// PreserveFieldOrder set.  Unlike a map, it keeps its entries in
+// order, and it marshals to a JSON object with its keys in that
+// order.
+type OrderedMap []MapItem
+
+// A MapItem is an entry in an OrderedMap.
+type MapItem struct {
+	Key   string
+	Value interface{}
+}
+
+// Get returns the value for key, and whether there is one.
+func (m OrderedMap) Get(key string) (interface{}, bool) {
+	for _, item := range m {
+		if item.Key == key {
+			return item.Value, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m OrderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, item := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeOrderedRecord decodes the record e into the interface{} v as
+// an OrderedMap, with its fields in source order.
+func (d *decoder) decodeOrderedRecord(e core.RecordLit, v reflect.Value, path string) error {
+	labels := make([]string, 0, len(e))
+	for label := range e {
+		labels = append(labels, label)
+	}
+	d.order.Sort(labels)
+	m := make(OrderedMap, len(labels))
+	for i, label := range labels {
+		m[i].Key = label
+		val := reflect.New(reflect.TypeOf((*interface{})(nil)).Elem()).Elem()
+		if err := d.decode(e[label], val, path+"."+label); err != nil {
+			return err
+		}
+		m[i].Value = val.Interface()
+	}
+	v.Set(reflect.ValueOf(m))
+	return nil
+}
+
+// decodeOrderedMapEntries decodes e, a Prelude Map with Text keys,
+// into the interface{} v as an OrderedMap, with its entries in list
+// order.  As with maps, later entries replace earlier ones with the
+// same key.
+func (d *decoder) decodeOrderedMapEntries(e core.NonEmptyList, v reflect.Value, path string) error {
+	m := make(OrderedMap, 0, len(e))
+	index := make(map[string]int, len(e))
+	for _, r := range e {
+		entry := r.(core.RecordLit)
+		key := string(entry["mapKey"].(core.PlainTextLit))
+		val := reflect.New(reflect.TypeOf((*interface{})(nil)).Elem()).Elem()
+		entryPath := fmt.Sprintf("%s[%v]", path, core.Quote(entry["mapKey"]))
+		if err := d.decode(entry["mapValue"], val, entryPath); err != nil {
+			return err
+		}
+		if i, ok := index[key]; ok {
+			m[i].Value = val.Interface()
+			continue
+		}
+		index[key] = len(m)
+		m = append(m, MapItem{Key: key, Value: val.Interface()})
+	}
+	v.Set(reflect.ValueOf(m))
+	return nil
+}
@@ -0,0 +1,371 @@
+package parser
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/v6/parser/internal"
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// Comments records the comments in Dhall source, so that the source
+// can be printed again without losing them.  Record it with the
+// RecordComments Option.
+//
+// Comments before the whole expression make up its header.  Every
+// other comment is attached to the nearest let binding or record
+// field which follows it, so that it is printed just before it.
+// Comments after the last let binding or record field make up the
+// footer, printed after the whole expression.  Comments also records
+// the order of the fields of record types and literals and the
+// alternatives of union types.  Unlike a FieldOrder, a Comments
+// records a single parse.
+type Comments struct {
+	// found holds the text of each comment found, by offset
+	found map[int]string
+	// anchors holds each binding or field found, by offset
+	anchors map[int]anchor
+	// orders holds the labels of each record or union, by
+	// recordKey of the record
+	orders map[uintptr]labelOrder
+
+	all      []string
+	header   string
+	bindings map[*term.Binding][]string
+	fields   map[fieldKey][]string
+	footer   []string
+}
+
+// An anchor is a let binding, or the field of a record, which can
+// have comments attached.
+type anchor struct {
+	binding *term.Binding
+	field   fieldKey
+}
+
+type fieldKey struct {
+	record uintptr
+	label  string
+}
+
+type labelOrder struct {
+	// record keeps the record alive, so that its key can't be reused
+	record term.Term
+	labels []string
+}
+
+// NewComments returns an empty Comments.
+func NewComments() *Comments {
+	return &Comments{
+		found:    map[int]string{},
+		anchors:  map[int]anchor{},
+		orders:   map[uintptr]labelOrder{},
+		bindings: map[*term.Binding][]string{},
+		fields:   map[fieldKey][]string{},
+	}
+}
+
+// RecordComments is an Option which records the comments of the
+// parsed source.
+func RecordComments(comments *Comments) Option {
+	return Option{internal.GlobalStore("comments", commentRecorder{comments})}
+}
+
+// All returns every comment in the source, in source order.
+func (c *Comments) All() []string {
+	if c == nil {
+		return nil
+	}
+	return c.all
+}
+
+// Header returns the comments before the whole expression, as they
+// were written but without trailing whitespace, ending with a newline,
+// and with a blank line after them if there was one in the source.  It
+// returns "" if there were none.
+func (c *Comments) Header() string {
+	if c == nil {
+		return ""
+	}
+	return c.header
+}
+
+// Binding returns the comments attached to b, which must point into
+// the Bindings of a parsed Let.
+func (c *Comments) Binding(b *term.Binding) []string {
+	if c == nil {
+		return nil
+	}
+	return c.bindings[b]
+}
+
+// Field returns the comments attached to the field with the given
+// label of record, a parsed RecordType or RecordLit.
+func (c *Comments) Field(record term.Term, label string) []string {
+	if c == nil {
+		return nil
+	}
+	return c.fields[fieldKey{recordKey(record), label}]
+}
+
+// Order returns the labels of record, a parsed RecordType, RecordLit
+// or UnionType, in source order.  It returns nil for any other Term.
+func (c *Comments) Order(record term.Term) []string {
+	if c == nil {
+		return nil
+	}
+	return c.orders[recordKey(record)].labels
+}
+
+// Footer returns the comments which are neither in the header nor
+// followed by a binding or field, such as those at the end of the
+// source.
+func (c *Comments) Footer() []string {
+	if c == nil {
+		return nil
+	}
+	return c.footer
+}
+
+// Transfer attaches the comments and field order recorded for the
+// parts of from to the corresponding parts of to, which must be from
+// with some of its subexpressions replaced, such as by
+// imports.Freeze.  The replacements themselves get no comments.
+func (c *Comments) Transfer(from, to term.Term) {
+	if c == nil || from == nil || reflect.TypeOf(from) != reflect.TypeOf(to) {
+		return
+	}
+	switch from := from.(type) {
+	case term.Let:
+		to := to.(term.Let)
+		if len(from.Bindings) != len(to.Bindings) {
+			return
+		}
+		for i := range from.Bindings {
+			if comments, ok := c.bindings[&from.Bindings[i]]; ok {
+				c.bindings[&to.Bindings[i]] = comments
+			}
+			c.Transfer(from.Bindings[i].Annotation, to.Bindings[i].Annotation)
+			c.Transfer(from.Bindings[i].Value, to.Bindings[i].Value)
+		}
+		c.Transfer(from.Body, to.Body)
+	case term.RecordType:
+		c.transferFields(from, to, from, to.(term.RecordType))
+	case term.RecordLit:
+		c.transferFields(from, to, from, to.(term.RecordLit))
+	case term.UnionType:
+		c.transferFields(from, to, from, to.(term.UnionType))
+	default:
+		fromChildren, toChildren := children(from), children(to)
+		if len(fromChildren) != len(toChildren) {
+			return
+		}
+		for i := range fromChildren {
+			c.Transfer(fromChildren[i], toChildren[i])
+		}
+	}
+}
+
+func (c *Comments) transferFields(from, to term.Term, fromFields, toFields map[string]term.Term) {
+	fromKey, toKey := recordKey(from), recordKey(to)
+	if order, ok := c.orders[fromKey]; ok {
+		c.orders[toKey] = labelOrder{to, order.labels}
+	}
+	for label, value := range fromFields {
+		if comments, ok := c.fields[fieldKey{fromKey, label}]; ok {
+			c.fields[fieldKey{toKey, label}] = comments
+		}
+		c.Transfer(value, toFields[label])
+	}
+}
+
+// children returns the immediate subexpressions of t.
+func children(t term.Term) []term.Term {
+	var out []term.Term
+	term.TransformSubexprs(t, func(child term.Term) term.Term {
+		out = append(out, child)
+		return child
+	})
+	return out
+}
+
+// recordKey identifies a record or union by its underlying map.
+func recordKey(record term.Term) uintptr {
+	v := reflect.ValueOf(record)
+	if v.Kind() != reflect.Map {
+		return 0
+	}
+	return v.Pointer()
+}
+
+type span struct {
+	start, end int
+}
+
+// attach attaches the comments found to the bindings and fields which
+// they precede, once all of source has been parsed.
+func (c *Comments) attach(source []byte) {
+	offsets := make([]int, 0, len(c.found))
+	for offset := range c.found {
+		offsets = append(offsets, offset)
+	}
+	sort.Ints(offsets)
+	var spans []span
+	// starts and ends map the start and end of each comment to its
+	// index in spans
+	starts, ends := map[int]int{}, map[int]int{}
+	for _, offset := range offsets {
+		if len(spans) > 0 && offset < spans[len(spans)-1].end {
+			// nested in a block comment
+			continue
+		}
+		end := offset + len(c.found[offset])
+		starts[offset], ends[end] = len(spans), len(spans)
+		spans = append(spans, span{offset, end})
+	}
+	c.all = make([]string, len(spans))
+	for i, s := range spans {
+		c.all[i] = dedent(c.found[s.start], column(source, s.start))
+	}
+	used := make([]bool, len(spans))
+
+	headerEnd, pos := 0, 0
+	for pos < len(source) {
+		if isSpace(source[pos]) {
+			pos++
+		} else if i, ok := starts[pos]; ok {
+			used[i] = true
+			pos = spans[i].end
+			headerEnd = pos
+		} else {
+			break
+		}
+	}
+	if headerEnd > 0 {
+		lines := strings.Split(strings.TrimLeft(string(source[:headerEnd]), " \t\r\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " \t\r")
+		}
+		c.header = strings.Join(lines, "\n") + "\n"
+		if strings.Count(string(source[headerEnd:pos]), "\n") > 1 {
+			c.header += "\n"
+		}
+	}
+
+	offsets = offsets[:0]
+	for offset := range c.anchors {
+		offsets = append(offsets, offset)
+	}
+	sort.Ints(offsets)
+	// attached maps the offset of each anchor to the indexes in
+	// spans of the comments attached to it
+	attached := map[int][]int{}
+	for _, offset := range offsets {
+		// walk back over the whitespace and comments before the
+		// anchor, and the comma before a field
+		var direct []int
+		commaAllowed := c.anchors[offset].binding == nil
+		for pos := offset; pos > 0; {
+			if isSpace(source[pos-1]) {
+				pos--
+			} else if i, ok := ends[pos]; ok {
+				if !used[i] {
+					direct = append([]int{i}, direct...)
+					used[i] = true
+				}
+				pos = spans[i].start
+			} else if source[pos-1] == ',' && commaAllowed {
+				commaAllowed = false
+				pos--
+			} else {
+				break
+			}
+		}
+		attached[offset] = direct
+	}
+	// the other comments go to the nearest anchor after them, before
+	// the comments directly in front of it, or else to the footer
+	moved := map[int][]int{}
+	for i, s := range spans {
+		if used[i] {
+			continue
+		}
+		next := sort.SearchInts(offsets, s.end)
+		if next == len(offsets) {
+			c.footer = append(c.footer, c.all[i])
+			continue
+		}
+		moved[offsets[next]] = append(moved[offsets[next]], i)
+	}
+
+	for _, offset := range offsets {
+		indexes := append(moved[offset], attached[offset]...)
+		if len(indexes) == 0 {
+			continue
+		}
+		comments := make([]string, len(indexes))
+		for j, i := range indexes {
+			comments[j] = c.all[i]
+		}
+		if a := c.anchors[offset]; a.binding != nil {
+			c.bindings[a.binding] = comments
+		} else {
+			c.fields[a.field] = append(c.fields[a.field], comments...)
+		}
+	}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// column returns the column at which offset is in source.
+func column(source []byte, offset int) int {
+	return offset - (strings.LastIndexByte(string(source[:offset]), '\n') + 1)
+}
+
+// dedent removes trailing whitespace from every line of a comment
+// which started at column col, and up to col columns of indentation
+// from every line but the first, so that it can be reindented wherever
+// it is printed.
+func dedent(comment string, col int) string {
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if i > 0 {
+			j := 0
+			for j < col && j < len(line) && (line[j] == ' ' || line[j] == '\t') {
+				j++
+			}
+			line = line[j:]
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// commentRecorder records comments for a Comments while parsing.
+type commentRecorder struct {
+	comments *Comments
+}
+
+func (r commentRecorder) Comment(offset int, text string) {
+	r.comments.found[offset] = text
+}
+
+func (r commentRecorder) Binding(offset int, b *term.Binding) {
+	r.comments.anchors[offset] = anchor{binding: b}
+}
+
+func (r commentRecorder) Field(offset int, record term.Term, label string) {
+	r.comments.anchors[offset] = anchor{field: fieldKey{recordKey(record), label}}
+}
+
+func (r commentRecorder) Order(record term.Term, labels []string) {
+	r.comments.orders[recordKey(record)] = labelOrder{record, labels}
+}
+
+func (r commentRecorder) Source(source []byte) {
+	r.comments.attach(source)
+}
@@ -0,0 +1,78 @@
+package parser_test
+
+import (
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	. "github.com/philandstuff/dhall-golang/v6/term"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Comments", func() {
+	parse := func(source string) (Term, *parser.Comments) {
+		comments := parser.NewComments()
+		t, err := parser.Parse("test", []byte(source), parser.RecordComments(comments))
+		Expect(err).ToNot(HaveOccurred())
+		return t, comments
+	}
+	It("Records the header", func() {
+		_, comments := parse("-- a  \n{- b -}\n\n-- c\n\nlet x = 1 in x")
+		Expect(comments.Header()).To(Equal("-- a\n{- b -}\n\n-- c\n\n"))
+		Expect(comments.All()).To(Equal([]string{"-- a", "{- b -}", "-- c"}))
+	})
+	It("Attaches comments to let bindings", func() {
+		t, comments := parse("let x = 1\n-- about y\n{- more -}\nlet y = 2 in x")
+		let := t.(Let)
+		Expect(comments.Binding(&let.Bindings[0])).To(BeEmpty())
+		Expect(comments.Binding(&let.Bindings[1])).To(Equal([]string{"-- about y", "{- more -}"}))
+		Expect(comments.Footer()).To(BeEmpty())
+	})
+	It("Attaches comments to record fields", func() {
+		t, comments := parse("{ a = 1 -- about b\n, b = { -- about c\n c : Natural } }")
+		record := t.(RecordLit)
+		Expect(comments.Field(record, "a")).To(BeEmpty())
+		Expect(comments.Field(record, "b")).To(Equal([]string{"-- about b"}))
+		Expect(comments.Field(record["b"], "c")).To(Equal([]string{"-- about c"}))
+	})
+	It("Doesn't record comments nested in block comments", func() {
+		_, comments := parse("{- a {- b -} -- c\n-} 1")
+		Expect(comments.All()).To(Equal([]string{"{- a {- b -} -- c\n-}"}))
+	})
+	It("Dedents block comments", func() {
+		t, comments := parse("{ a = 1\n  {- x\n       y\n   z -}\n, b = 2 }")
+		Expect(comments.Field(t, "b")).To(Equal([]string{"{- x\n     y\n z -}"}))
+	})
+	It("Attaches other comments to the next binding or field", func() {
+		t, comments := parse("{ a = [ 1 -- one\n, 2 ] {- two -}, b = f -- f\n 3, c = 4 }")
+		Expect(comments.Field(t, "b")).To(Equal([]string{"-- one", "{- two -}"}))
+		Expect(comments.Field(t, "c")).To(Equal([]string{"-- f"}))
+		Expect(comments.Footer()).To(BeEmpty())
+	})
+	It("Records comments after the last binding or field as the footer", func() {
+		_, comments := parse("let x = 1 in -- the end\n x -- really\n")
+		Expect(comments.Footer()).To(Equal([]string{"-- the end", "-- really"}))
+	})
+	It("Records the source order of labels", func() {
+		t, comments := parse("{ b : < Z | Y >, a : Natural }")
+		record := t.(RecordType)
+		Expect(comments.Order(record)).To(Equal([]string{"b", "a"}))
+		Expect(comments.Order(record["b"])).To(Equal([]string{"Z", "Y"}))
+		Expect(comments.Order(NaturalLit(1))).To(BeNil())
+	})
+	It("Transfers comments to a transformed copy", func() {
+		t, comments := parse("let w = 0\n-- about x\nlet x = { -- about b\n b = ./b.dhall, a = 1 } in x")
+		var deepCopy func(Term) Term
+		deepCopy = func(t Term) Term { return TransformSubexprs(t, deepCopy) }
+		copied := deepCopy(t).(Let)
+		comments.Transfer(t, copied)
+		Expect(comments.Binding(&copied.Bindings[1])).To(Equal([]string{"-- about x"}))
+		record := copied.Bindings[1].Value
+		Expect(comments.Field(record, "b")).To(Equal([]string{"-- about b"}))
+		Expect(comments.Order(record)).To(Equal([]string{"b", "a"}))
+	})
+	It("Returns nothing when nil", func() {
+		var comments *parser.Comments
+		Expect(comments.Header()).To(Equal(""))
+		Expect(comments.Field(RecordLit{}, "a")).To(BeNil())
+	})
+})
@@ -59,27 +59,71 @@ func parseCodepoint(codepointText string) ([]byte, error) {
 	return []byte(string([]rune{r})), nil
 }
 
+// Helper for recording the order of the fields of record literals.
+// If the parser was given a func([]string) in the "fieldOrder"
+// global store, it is called with the labels of each record
+// literal, in source order.
+func recordFieldOrder(c *current, labels []string) {
+	if record, ok := c.globalStore["fieldOrder"].(func([]string)); ok {
+		record(labels)
+	}
+}
+
+// A CommentRecorder records the comments in Dhall source, and the let
+// bindings and record fields which they might document.  If the
+// parser was given one in the "comments" global store, it is called
+// as each of these is parsed.  Because the parser backtracks, the
+// same source may be recorded more than once; the last recording at
+// each offset is the one which ends up in the parsed Term.
+type CommentRecorder interface {
+	// Comment records a comment, with its offset in the source.
+	Comment(offset int, text string)
+	// Binding records a let binding, with the offset of its let
+	// keyword.
+	Binding(offset int, b *Binding)
+	// Field records the field of a record type or literal with the
+	// given label, with the offset of the label.
+	Field(offset int, record Term, label string)
+	// Order records the labels of a record type, record literal or
+	// union type, in source order.
+	Order(record Term, labels []string)
+	// Source records the whole source, once it has been parsed.
+	Source(source []byte)
+}
+
+func commentRecorder(c *current) CommentRecorder {
+	recorder, _ := c.globalStore["comments"].(CommentRecorder)
+	return recorder
+}
+
+// A positioned is the value of a let binding or record entry, with
+// the offset in the source at which it starts.
+type positioned struct {
+	value  interface{}
+	offset int
+}
+
 var g = &grammar{
 	rules: []*rule{
 		{
 			name: "DhallFile",
-			pos:  position{line: 67, col: 1, offset: 1552},
+			pos:  position{line: 111, col: 1, offset: 3307},
 			expr: &actionExpr{
-				pos: position{line: 67, col: 13, offset: 1566},
+				pos: position{line: 111, col: 13, offset: 3321},
 				run: (*parser).callonDhallFile1,
 				expr: &seqExpr{
-					pos: position{line: 67, col: 13, offset: 1566},
+					pos: position{line: 111, col: 13, offset: 3321},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 67, col: 13, offset: 1566},
+							pos:   position{line: 111, col: 13, offset: 3321},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 67, col: 15, offset: 1568},
+								pos:  position{line: 111, col: 15, offset: 3323},
 								name: "CompleteExpression",
 							},
 						},
 						&notExpr{
-							pos: position{line: 69, col: 7, offset: 1618},
+							pos: position{line: 118, col: 7, offset: 3472},
 							expr: &anyMatcher{
 								line: 69, col: 8, offset: 1619,
 							},
@@ -90,56 +134,60 @@ var g = &grammar{
 		},
 		{
 			name: "BlockComment",
-			pos:  position{line: 93, col: 1, offset: 2183},
-			expr: &seqExpr{
-				pos: position{line: 93, col: 16, offset: 2200},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 93, col: 16, offset: 2200},
-						val:        "{-",
-						ignoreCase: false,
-						want:       "\"{-\"",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 93, col: 21, offset: 2205},
-						name: "BlockCommentContinue",
+			pos:  position{line: 142, col: 1, offset: 4037},
+			expr: &actionExpr{
+				pos: position{line: 142, col: 16, offset: 4054},
+				run: (*parser).callonBlockComment1,
+				expr: &seqExpr{
+					pos: position{line: 142, col: 16, offset: 4054},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 142, col: 16, offset: 4054},
+							val:        "{-",
+							ignoreCase: false,
+							want:       "\"{-\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 142, col: 21, offset: 4059},
+							name: "BlockCommentContinue",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "BlockCommentContinue",
-			pos:  position{line: 101, col: 1, offset: 2300},
+			pos:  position{line: 155, col: 1, offset: 4299},
 			expr: &choiceExpr{
-				pos: position{line: 102, col: 7, offset: 2331},
+				pos: position{line: 156, col: 7, offset: 4330},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 102, col: 7, offset: 2331},
+						pos:        position{line: 156, col: 7, offset: 4330},
 						val:        "-}",
 						ignoreCase: false,
 						want:       "\"-}\"",
 					},
 					&seqExpr{
-						pos: position{line: 103, col: 7, offset: 2342},
+						pos: position{line: 157, col: 7, offset: 4341},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 103, col: 7, offset: 2342},
+								pos:  position{line: 157, col: 7, offset: 4341},
 								name: "BlockComment",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 103, col: 20, offset: 2355},
+								pos:  position{line: 157, col: 20, offset: 4354},
 								name: "BlockCommentContinue",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 104, col: 7, offset: 2382},
+						pos: position{line: 158, col: 7, offset: 4381},
 						exprs: []interface{}{
 							&choiceExpr{
-								pos: position{line: 96, col: 5, offset: 2252},
+								pos: position{line: 150, col: 5, offset: 4251},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 96, col: 5, offset: 2252},
+										pos:        position{line: 150, col: 5, offset: 4251},
 										val:        "[𐀀D\\t\\n -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 										chars:      []rune{'𐀀', 'D', '\t', '\n'},
 										ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -147,10 +195,10 @@ var g = &grammar{
 										inverted:   false,
 									},
 									&actionExpr{
-										pos: position{line: 71, col: 14, offset: 1637},
+										pos: position{line: 120, col: 14, offset: 3491},
 										run: (*parser).callonBlockCommentContinue9,
 										expr: &litMatcher{
-											pos:        position{line: 71, col: 14, offset: 1637},
+											pos:        position{line: 120, col: 14, offset: 3491},
 											val:        "\r\n",
 											ignoreCase: false,
 											want:       "\"\\r\\n\"",
@@ -159,7 +207,7 @@ var g = &grammar{
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 104, col: 24, offset: 2399},
+								pos:  position{line: 158, col: 24, offset: 4398},
 								name: "BlockCommentContinue",
 							},
 						},
@@ -169,49 +217,49 @@ var g = &grammar{
 		},
 		{
 			name: "WhitespaceChunk",
-			pos:  position{line: 110, col: 1, offset: 2566},
+			pos:  position{line: 169, col: 1, offset: 4696},
 			expr: &choiceExpr{
-				pos: position{line: 110, col: 19, offset: 2586},
+				pos: position{line: 169, col: 19, offset: 4716},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 110, col: 19, offset: 2586},
+						pos:        position{line: 169, col: 19, offset: 4716},
 						val:        "[ \\t\\n]",
 						chars:      []rune{' ', '\t', '\n'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&actionExpr{
-						pos: position{line: 71, col: 14, offset: 1637},
+						pos: position{line: 120, col: 14, offset: 3491},
 						run: (*parser).callonWhitespaceChunk3,
 						expr: &litMatcher{
-							pos:        position{line: 71, col: 14, offset: 1637},
+							pos:        position{line: 120, col: 14, offset: 3491},
 							val:        "\r\n",
 							ignoreCase: false,
 							want:       "\"\\r\\n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 108, col: 15, offset: 2484},
+						pos: position{line: 162, col: 15, offset: 4483},
 						run: (*parser).callonWhitespaceChunk5,
 						expr: &seqExpr{
-							pos: position{line: 108, col: 15, offset: 2484},
+							pos: position{line: 162, col: 15, offset: 4483},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 108, col: 15, offset: 2484},
+									pos:        position{line: 162, col: 15, offset: 4483},
 									val:        "--",
 									ignoreCase: false,
 									want:       "\"--\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 108, col: 20, offset: 2489},
+									pos:   position{line: 162, col: 20, offset: 4488},
 									label: "content",
 									expr: &actionExpr{
-										pos: position{line: 108, col: 29, offset: 2498},
+										pos: position{line: 162, col: 29, offset: 4497},
 										run: (*parser).callonWhitespaceChunk9,
 										expr: &zeroOrMoreExpr{
-											pos: position{line: 108, col: 29, offset: 2498},
+											pos: position{line: 162, col: 29, offset: 4497},
 											expr: &charClassMatcher{
-												pos:        position{line: 106, col: 10, offset: 2432},
+												pos:        position{line: 160, col: 10, offset: 4431},
 												val:        "[𐀀D\\t -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 												chars:      []rune{'𐀀', 'D', '\t'},
 												ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -222,19 +270,19 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 71, col: 7, offset: 1630},
+									pos: position{line: 120, col: 7, offset: 3484},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 71, col: 7, offset: 1630},
+											pos:        position{line: 120, col: 7, offset: 3484},
 											val:        "\n",
 											ignoreCase: false,
 											want:       "\"\\n\"",
 										},
 										&actionExpr{
-											pos: position{line: 71, col: 14, offset: 1637},
+											pos: position{line: 120, col: 14, offset: 3491},
 											run: (*parser).callonWhitespaceChunk14,
 											expr: &litMatcher{
-												pos:        position{line: 71, col: 14, offset: 1637},
+												pos:        position{line: 120, col: 14, offset: 3491},
 												val:        "\r\n",
 												ignoreCase: false,
 												want:       "\"\\r\\n\"",
@@ -246,7 +294,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 110, col: 52, offset: 2619},
+						pos:  position{line: 169, col: 52, offset: 4749},
 						name: "BlockComment",
 					},
 				},
@@ -254,147 +302,147 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 112, col: 1, offset: 2633},
+			pos:  position{line: 171, col: 1, offset: 4763},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 112, col: 5, offset: 2639},
+				pos: position{line: 171, col: 5, offset: 4769},
 				expr: &ruleRefExpr{
-					pos:  position{line: 112, col: 5, offset: 2639},
+					pos:  position{line: 171, col: 5, offset: 4769},
 					name: "WhitespaceChunk",
 				},
 			},
 		},
 		{
 			name: "_1",
-			pos:  position{line: 114, col: 1, offset: 2657},
+			pos:  position{line: 173, col: 1, offset: 4787},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 114, col: 6, offset: 2664},
+				pos: position{line: 173, col: 6, offset: 4794},
 				expr: &ruleRefExpr{
-					pos:  position{line: 114, col: 6, offset: 2664},
+					pos:  position{line: 173, col: 6, offset: 4794},
 					name: "WhitespaceChunk",
 				},
 			},
 		},
 		{
 			name: "DoubleQuoteChunk",
-			pos:  position{line: 142, col: 1, offset: 3452},
+			pos:  position{line: 201, col: 1, offset: 5582},
 			expr: &choiceExpr{
-				pos: position{line: 143, col: 6, offset: 3478},
+				pos: position{line: 202, col: 6, offset: 5608},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 143, col: 6, offset: 3478},
+						pos:  position{line: 202, col: 6, offset: 5608},
 						name: "Interpolation",
 					},
 					&actionExpr{
-						pos: position{line: 144, col: 6, offset: 3497},
+						pos: position{line: 203, col: 6, offset: 5627},
 						run: (*parser).callonDoubleQuoteChunk3,
 						expr: &seqExpr{
-							pos: position{line: 144, col: 6, offset: 3497},
+							pos: position{line: 203, col: 6, offset: 5627},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 144, col: 6, offset: 3497},
+									pos:        position{line: 203, col: 6, offset: 5627},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 144, col: 11, offset: 3502},
+									pos:   position{line: 203, col: 11, offset: 5632},
 									label: "e",
 									expr: &choiceExpr{
-										pos: position{line: 148, col: 8, offset: 3593},
+										pos: position{line: 207, col: 8, offset: 5723},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 148, col: 8, offset: 3593},
+												pos:        position{line: 207, col: 8, offset: 5723},
 												val:        "[\"$\\\\/]",
 												chars:      []rune{'"', '$', '\\', '/'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&actionExpr{
-												pos: position{line: 152, col: 8, offset: 3638},
+												pos: position{line: 211, col: 8, offset: 5768},
 												run: (*parser).callonDoubleQuoteChunk9,
 												expr: &litMatcher{
-													pos:        position{line: 152, col: 8, offset: 3638},
+													pos:        position{line: 211, col: 8, offset: 5768},
 													val:        "b",
 													ignoreCase: false,
 													want:       "\"b\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 153, col: 8, offset: 3678},
+												pos: position{line: 212, col: 8, offset: 5808},
 												run: (*parser).callonDoubleQuoteChunk11,
 												expr: &litMatcher{
-													pos:        position{line: 153, col: 8, offset: 3678},
+													pos:        position{line: 212, col: 8, offset: 5808},
 													val:        "f",
 													ignoreCase: false,
 													want:       "\"f\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 154, col: 8, offset: 3718},
+												pos: position{line: 213, col: 8, offset: 5848},
 												run: (*parser).callonDoubleQuoteChunk13,
 												expr: &litMatcher{
-													pos:        position{line: 154, col: 8, offset: 3718},
+													pos:        position{line: 213, col: 8, offset: 5848},
 													val:        "n",
 													ignoreCase: false,
 													want:       "\"n\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 155, col: 8, offset: 3758},
+												pos: position{line: 214, col: 8, offset: 5888},
 												run: (*parser).callonDoubleQuoteChunk15,
 												expr: &litMatcher{
-													pos:        position{line: 155, col: 8, offset: 3758},
+													pos:        position{line: 214, col: 8, offset: 5888},
 													val:        "r",
 													ignoreCase: false,
 													want:       "\"r\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 156, col: 8, offset: 3798},
+												pos: position{line: 215, col: 8, offset: 5928},
 												run: (*parser).callonDoubleQuoteChunk17,
 												expr: &litMatcher{
-													pos:        position{line: 156, col: 8, offset: 3798},
+													pos:        position{line: 215, col: 8, offset: 5928},
 													val:        "t",
 													ignoreCase: false,
 													want:       "\"t\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 157, col: 8, offset: 3838},
+												pos: position{line: 216, col: 8, offset: 5968},
 												run: (*parser).callonDoubleQuoteChunk19,
 												expr: &seqExpr{
-													pos: position{line: 157, col: 8, offset: 3838},
+													pos: position{line: 216, col: 8, offset: 5968},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 157, col: 8, offset: 3838},
+															pos:        position{line: 216, col: 8, offset: 5968},
 															val:        "u",
 															ignoreCase: false,
 															want:       "\"u\"",
 														},
 														&labeledExpr{
-															pos:   position{line: 157, col: 12, offset: 3842},
+															pos:   position{line: 216, col: 12, offset: 5972},
 															label: "u",
 															expr: &choiceExpr{
-																pos: position{line: 160, col: 9, offset: 3903},
+																pos: position{line: 219, col: 9, offset: 6033},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 160, col: 9, offset: 3903},
+																		pos: position{line: 219, col: 9, offset: 6033},
 																		run: (*parser).callonDoubleQuoteChunk24,
 																		expr: &seqExpr{
-																			pos: position{line: 160, col: 9, offset: 3903},
+																			pos: position{line: 219, col: 9, offset: 6033},
 																			exprs: []interface{}{
 																				&choiceExpr{
-																					pos: position{line: 118, col: 10, offset: 2710},
+																					pos: position{line: 177, col: 10, offset: 4840},
 																					alternatives: []interface{}{
 																						&charClassMatcher{
-																							pos:        position{line: 116, col: 9, offset: 2692},
+																							pos:        position{line: 175, col: 9, offset: 4822},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
 																							inverted:   false,
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 118, col: 18, offset: 2718},
+																							pos:        position{line: 177, col: 18, offset: 4848},
 																							val:        "[a-f]i",
 																							ranges:     []rune{'a', 'f'},
 																							ignoreCase: true,
@@ -403,17 +451,17 @@ var g = &grammar{
 																					},
 																				},
 																				&choiceExpr{
-																					pos: position{line: 118, col: 10, offset: 2710},
+																					pos: position{line: 177, col: 10, offset: 4840},
 																					alternatives: []interface{}{
 																						&charClassMatcher{
-																							pos:        position{line: 116, col: 9, offset: 2692},
+																							pos:        position{line: 175, col: 9, offset: 4822},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
 																							inverted:   false,
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 118, col: 18, offset: 2718},
+																							pos:        position{line: 177, col: 18, offset: 4848},
 																							val:        "[a-f]i",
 																							ranges:     []rune{'a', 'f'},
 																							ignoreCase: true,
@@ -422,17 +470,17 @@ var g = &grammar{
 																					},
 																				},
 																				&choiceExpr{
-																					pos: position{line: 118, col: 10, offset: 2710},
+																					pos: position{line: 177, col: 10, offset: 4840},
 																					alternatives: []interface{}{
 																						&charClassMatcher{
-																							pos:        position{line: 116, col: 9, offset: 2692},
+																							pos:        position{line: 175, col: 9, offset: 4822},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
 																							inverted:   false,
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 118, col: 18, offset: 2718},
+																							pos:        position{line: 177, col: 18, offset: 4848},
 																							val:        "[a-f]i",
 																							ranges:     []rune{'a', 'f'},
 																							ignoreCase: true,
@@ -441,17 +489,17 @@ var g = &grammar{
 																					},
 																				},
 																				&choiceExpr{
-																					pos: position{line: 118, col: 10, offset: 2710},
+																					pos: position{line: 177, col: 10, offset: 4840},
 																					alternatives: []interface{}{
 																						&charClassMatcher{
-																							pos:        position{line: 116, col: 9, offset: 2692},
+																							pos:        position{line: 175, col: 9, offset: 4822},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
 																							inverted:   false,
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 118, col: 18, offset: 2718},
+																							pos:        position{line: 177, col: 18, offset: 4848},
 																							val:        "[a-f]i",
 																							ranges:     []rune{'a', 'f'},
 																							ignoreCase: true,
@@ -463,31 +511,31 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 163, col: 9, offset: 4001},
+																		pos: position{line: 222, col: 9, offset: 6131},
 																		run: (*parser).callonDoubleQuoteChunk38,
 																		expr: &seqExpr{
-																			pos: position{line: 163, col: 9, offset: 4001},
+																			pos: position{line: 222, col: 9, offset: 6131},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 163, col: 9, offset: 4001},
+																					pos:        position{line: 222, col: 9, offset: 6131},
 																					val:        "{",
 																					ignoreCase: false,
 																					want:       "\"{\"",
 																				},
 																				&oneOrMoreExpr{
-																					pos: position{line: 163, col: 13, offset: 4005},
+																					pos: position{line: 222, col: 13, offset: 6135},
 																					expr: &choiceExpr{
-																						pos: position{line: 118, col: 10, offset: 2710},
+																						pos: position{line: 177, col: 10, offset: 4840},
 																						alternatives: []interface{}{
 																							&charClassMatcher{
-																								pos:        position{line: 116, col: 9, offset: 2692},
+																								pos:        position{line: 175, col: 9, offset: 4822},
 																								val:        "[0-9]",
 																								ranges:     []rune{'0', '9'},
 																								ignoreCase: false,
 																								inverted:   false,
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 118, col: 18, offset: 2718},
+																								pos:        position{line: 177, col: 18, offset: 4848},
 																								val:        "[a-f]i",
 																								ranges:     []rune{'a', 'f'},
 																								ignoreCase: true,
@@ -497,7 +545,7 @@ var g = &grammar{
 																					},
 																				},
 																				&litMatcher{
-																					pos:        position{line: 163, col: 21, offset: 4013},
+																					pos:        position{line: 222, col: 21, offset: 6143},
 																					val:        "}",
 																					ignoreCase: false,
 																					want:       "\"}\"",
@@ -518,7 +566,7 @@ var g = &grammar{
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 168, col: 6, offset: 4122},
+						pos:        position{line: 227, col: 6, offset: 6252},
 						val:        "[𐀀D -!#-[]-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 						chars:      []rune{'𐀀', 'D'},
 						ranges:     []rune{' ', '!', '#', '[', ']', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -530,32 +578,32 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteLiteral",
-			pos:  position{line: 173, col: 1, offset: 4188},
+			pos:  position{line: 232, col: 1, offset: 6318},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 22, offset: 4211},
+				pos: position{line: 232, col: 22, offset: 6341},
 				run: (*parser).callonDoubleQuoteLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 173, col: 22, offset: 4211},
+					pos: position{line: 232, col: 22, offset: 6341},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 173, col: 22, offset: 4211},
+							pos:        position{line: 232, col: 22, offset: 6341},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 173, col: 26, offset: 4215},
+							pos:   position{line: 232, col: 26, offset: 6345},
 							label: "chunks",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 173, col: 33, offset: 4222},
+								pos: position{line: 232, col: 33, offset: 6352},
 								expr: &ruleRefExpr{
-									pos:  position{line: 173, col: 33, offset: 4222},
+									pos:  position{line: 232, col: 33, offset: 6352},
 									name: "DoubleQuoteChunk",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 173, col: 51, offset: 4240},
+							pos:        position{line: 232, col: 51, offset: 6370},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -566,75 +614,75 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteContinue",
-			pos:  position{line: 190, col: 1, offset: 4708},
+			pos:  position{line: 249, col: 1, offset: 6838},
 			expr: &choiceExpr{
-				pos: position{line: 191, col: 7, offset: 4738},
+				pos: position{line: 250, col: 7, offset: 6868},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 191, col: 7, offset: 4738},
+						pos: position{line: 250, col: 7, offset: 6868},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 191, col: 7, offset: 4738},
+								pos:  position{line: 250, col: 7, offset: 6868},
 								name: "Interpolation",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 191, col: 21, offset: 4752},
+								pos:  position{line: 250, col: 21, offset: 6882},
 								name: "SingleQuoteContinue",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 192, col: 7, offset: 4778},
+						pos: position{line: 251, col: 7, offset: 6908},
 						exprs: []interface{}{
 							&actionExpr{
-								pos: position{line: 197, col: 20, offset: 4937},
+								pos: position{line: 256, col: 20, offset: 7067},
 								run: (*parser).callonSingleQuoteContinue6,
 								expr: &litMatcher{
-									pos:        position{line: 197, col: 20, offset: 4937},
+									pos:        position{line: 256, col: 20, offset: 7067},
 									val:        "'''",
 									ignoreCase: false,
 									want:       "\"'''\"",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 192, col: 24, offset: 4795},
+								pos:  position{line: 251, col: 24, offset: 6925},
 								name: "SingleQuoteContinue",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 193, col: 7, offset: 4821},
+						pos: position{line: 252, col: 7, offset: 6951},
 						exprs: []interface{}{
 							&actionExpr{
-								pos: position{line: 201, col: 24, offset: 5097},
+								pos: position{line: 260, col: 24, offset: 7227},
 								run: (*parser).callonSingleQuoteContinue10,
 								expr: &litMatcher{
-									pos:        position{line: 201, col: 24, offset: 5097},
+									pos:        position{line: 260, col: 24, offset: 7227},
 									val:        "''${",
 									ignoreCase: false,
 									want:       "\"''${\"",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 193, col: 28, offset: 4842},
+								pos:  position{line: 252, col: 28, offset: 6972},
 								name: "SingleQuoteContinue",
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 194, col: 7, offset: 4868},
+						pos:        position{line: 253, col: 7, offset: 6998},
 						val:        "''",
 						ignoreCase: false,
 						want:       "\"''\"",
 					},
 					&seqExpr{
-						pos: position{line: 195, col: 7, offset: 4879},
+						pos: position{line: 254, col: 7, offset: 7009},
 						exprs: []interface{}{
 							&choiceExpr{
-								pos: position{line: 204, col: 6, offset: 5164},
+								pos: position{line: 263, col: 6, offset: 7294},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 204, col: 6, offset: 5164},
+										pos:        position{line: 263, col: 6, offset: 7294},
 										val:        "[𐀀D\\t\\n -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 										chars:      []rune{'𐀀', 'D', '\t', '\n'},
 										ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -642,10 +690,10 @@ var g = &grammar{
 										inverted:   false,
 									},
 									&actionExpr{
-										pos: position{line: 71, col: 14, offset: 1637},
+										pos: position{line: 120, col: 14, offset: 3491},
 										run: (*parser).callonSingleQuoteContinue17,
 										expr: &litMatcher{
-											pos:        position{line: 71, col: 14, offset: 1637},
+											pos:        position{line: 120, col: 14, offset: 3491},
 											val:        "\r\n",
 											ignoreCase: false,
 											want:       "\"\\r\\n\"",
@@ -654,7 +702,7 @@ var g = &grammar{
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 195, col: 23, offset: 4895},
+								pos:  position{line: 254, col: 23, offset: 7025},
 								name: "SingleQuoteContinue",
 							},
 						},
@@ -664,33 +712,33 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteLiteral",
-			pos:  position{line: 209, col: 1, offset: 5215},
+			pos:  position{line: 268, col: 1, offset: 7345},
 			expr: &actionExpr{
-				pos: position{line: 209, col: 22, offset: 5238},
+				pos: position{line: 268, col: 22, offset: 7368},
 				run: (*parser).callonSingleQuoteLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 209, col: 22, offset: 5238},
+					pos: position{line: 268, col: 22, offset: 7368},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 209, col: 22, offset: 5238},
+							pos:        position{line: 268, col: 22, offset: 7368},
 							val:        "''",
 							ignoreCase: false,
 							want:       "\"''\"",
 						},
 						&choiceExpr{
-							pos: position{line: 71, col: 7, offset: 1630},
+							pos: position{line: 120, col: 7, offset: 3484},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 71, col: 7, offset: 1630},
+									pos:        position{line: 120, col: 7, offset: 3484},
 									val:        "\n",
 									ignoreCase: false,
 									want:       "\"\\n\"",
 								},
 								&actionExpr{
-									pos: position{line: 71, col: 14, offset: 1637},
+									pos: position{line: 120, col: 14, offset: 3491},
 									run: (*parser).callonSingleQuoteLiteral6,
 									expr: &litMatcher{
-										pos:        position{line: 71, col: 14, offset: 1637},
+										pos:        position{line: 120, col: 14, offset: 3491},
 										val:        "\r\n",
 										ignoreCase: false,
 										want:       "\"\\r\\n\"",
@@ -699,10 +747,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 209, col: 31, offset: 5247},
+							pos:   position{line: 268, col: 31, offset: 7377},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 209, col: 39, offset: 5255},
+								pos:  position{line: 268, col: 39, offset: 7385},
 								name: "SingleQuoteContinue",
 							},
 						},
@@ -712,29 +760,29 @@ var g = &grammar{
 		},
 		{
 			name: "Interpolation",
-			pos:  position{line: 227, col: 1, offset: 5805},
+			pos:  position{line: 286, col: 1, offset: 7935},
 			expr: &actionExpr{
-				pos: position{line: 227, col: 17, offset: 5823},
+				pos: position{line: 286, col: 17, offset: 7953},
 				run: (*parser).callonInterpolation1,
 				expr: &seqExpr{
-					pos: position{line: 227, col: 17, offset: 5823},
+					pos: position{line: 286, col: 17, offset: 7953},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 227, col: 17, offset: 5823},
+							pos:        position{line: 286, col: 17, offset: 7953},
 							val:        "${",
 							ignoreCase: false,
 							want:       "\"${\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 227, col: 22, offset: 5828},
+							pos:   position{line: 286, col: 22, offset: 7958},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 24, offset: 5830},
+								pos:  position{line: 286, col: 24, offset: 7960},
 								name: "CompleteExpression",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 227, col: 43, offset: 5849},
+							pos:        position{line: 286, col: 43, offset: 7979},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -745,16 +793,16 @@ var g = &grammar{
 		},
 		{
 			name: "TextLiteral",
-			pos:  position{line: 229, col: 1, offset: 5872},
+			pos:  position{line: 288, col: 1, offset: 8002},
 			expr: &choiceExpr{
-				pos: position{line: 229, col: 15, offset: 5888},
+				pos: position{line: 288, col: 15, offset: 8018},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 229, col: 15, offset: 5888},
+						pos:  position{line: 288, col: 15, offset: 8018},
 						name: "DoubleQuoteLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 229, col: 36, offset: 5909},
+						pos:  position{line: 288, col: 36, offset: 8039},
 						name: "SingleQuoteLiteral",
 					},
 				},
@@ -762,359 +810,359 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 335, col: 1, offset: 9059},
+			pos:  position{line: 394, col: 1, offset: 11189},
 			expr: &choiceExpr{
-				pos: position{line: 335, col: 14, offset: 9074},
+				pos: position{line: 394, col: 14, offset: 11204},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 14, offset: 9074},
+						pos:  position{line: 394, col: 14, offset: 11204},
 						name: "Variable",
 					},
 					&actionExpr{
-						pos: position{line: 259, col: 5, offset: 6416},
+						pos: position{line: 318, col: 5, offset: 8546},
 						run: (*parser).callonIdentifier3,
 						expr: &litMatcher{
-							pos:        position{line: 259, col: 5, offset: 6416},
+							pos:        position{line: 318, col: 5, offset: 8546},
 							val:        "Natural/fold",
 							ignoreCase: false,
 							want:       "\"Natural/fold\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 260, col: 5, offset: 6463},
+						pos: position{line: 319, col: 5, offset: 8593},
 						run: (*parser).callonIdentifier5,
 						expr: &litMatcher{
-							pos:        position{line: 260, col: 5, offset: 6463},
+							pos:        position{line: 319, col: 5, offset: 8593},
 							val:        "Natural/build",
 							ignoreCase: false,
 							want:       "\"Natural/build\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 261, col: 5, offset: 6512},
+						pos: position{line: 320, col: 5, offset: 8642},
 						run: (*parser).callonIdentifier7,
 						expr: &litMatcher{
-							pos:        position{line: 261, col: 5, offset: 6512},
+							pos:        position{line: 320, col: 5, offset: 8642},
 							val:        "Natural/isZero",
 							ignoreCase: false,
 							want:       "\"Natural/isZero\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 262, col: 5, offset: 6563},
+						pos: position{line: 321, col: 5, offset: 8693},
 						run: (*parser).callonIdentifier9,
 						expr: &litMatcher{
-							pos:        position{line: 262, col: 5, offset: 6563},
+							pos:        position{line: 321, col: 5, offset: 8693},
 							val:        "Natural/even",
 							ignoreCase: false,
 							want:       "\"Natural/even\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 263, col: 5, offset: 6610},
+						pos: position{line: 322, col: 5, offset: 8740},
 						run: (*parser).callonIdentifier11,
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 5, offset: 6610},
+							pos:        position{line: 322, col: 5, offset: 8740},
 							val:        "Natural/odd",
 							ignoreCase: false,
 							want:       "\"Natural/odd\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 6655},
+						pos: position{line: 323, col: 5, offset: 8785},
 						run: (*parser).callonIdentifier13,
 						expr: &litMatcher{
-							pos:        position{line: 264, col: 5, offset: 6655},
+							pos:        position{line: 323, col: 5, offset: 8785},
 							val:        "Natural/toInteger",
 							ignoreCase: false,
 							want:       "\"Natural/toInteger\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 265, col: 5, offset: 6712},
+						pos: position{line: 324, col: 5, offset: 8842},
 						run: (*parser).callonIdentifier15,
 						expr: &litMatcher{
-							pos:        position{line: 265, col: 5, offset: 6712},
+							pos:        position{line: 324, col: 5, offset: 8842},
 							val:        "Natural/show",
 							ignoreCase: false,
 							want:       "\"Natural/show\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 266, col: 5, offset: 6759},
+						pos: position{line: 325, col: 5, offset: 8889},
 						run: (*parser).callonIdentifier17,
 						expr: &litMatcher{
-							pos:        position{line: 266, col: 5, offset: 6759},
+							pos:        position{line: 325, col: 5, offset: 8889},
 							val:        "Integer/toDouble",
 							ignoreCase: false,
 							want:       "\"Integer/toDouble\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 267, col: 5, offset: 6814},
+						pos: position{line: 326, col: 5, offset: 8944},
 						run: (*parser).callonIdentifier19,
 						expr: &litMatcher{
-							pos:        position{line: 267, col: 5, offset: 6814},
+							pos:        position{line: 326, col: 5, offset: 8944},
 							val:        "Integer/show",
 							ignoreCase: false,
 							want:       "\"Integer/show\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 268, col: 5, offset: 6861},
+						pos: position{line: 327, col: 5, offset: 8991},
 						run: (*parser).callonIdentifier21,
 						expr: &litMatcher{
-							pos:        position{line: 268, col: 5, offset: 6861},
+							pos:        position{line: 327, col: 5, offset: 8991},
 							val:        "Integer/negate",
 							ignoreCase: false,
 							want:       "\"Integer/negate\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 269, col: 5, offset: 6912},
+						pos: position{line: 328, col: 5, offset: 9042},
 						run: (*parser).callonIdentifier23,
 						expr: &litMatcher{
-							pos:        position{line: 269, col: 5, offset: 6912},
+							pos:        position{line: 328, col: 5, offset: 9042},
 							val:        "Integer/clamp",
 							ignoreCase: false,
 							want:       "\"Integer/clamp\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 270, col: 5, offset: 6961},
+						pos: position{line: 329, col: 5, offset: 9091},
 						run: (*parser).callonIdentifier25,
 						expr: &litMatcher{
-							pos:        position{line: 270, col: 5, offset: 6961},
+							pos:        position{line: 329, col: 5, offset: 9091},
 							val:        "Natural/subtract",
 							ignoreCase: false,
 							want:       "\"Natural/subtract\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 271, col: 5, offset: 7016},
+						pos: position{line: 330, col: 5, offset: 9146},
 						run: (*parser).callonIdentifier27,
 						expr: &litMatcher{
-							pos:        position{line: 271, col: 5, offset: 7016},
+							pos:        position{line: 330, col: 5, offset: 9146},
 							val:        "Double/show",
 							ignoreCase: false,
 							want:       "\"Double/show\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 272, col: 5, offset: 7061},
+						pos: position{line: 331, col: 5, offset: 9191},
 						run: (*parser).callonIdentifier29,
 						expr: &litMatcher{
-							pos:        position{line: 272, col: 5, offset: 7061},
+							pos:        position{line: 331, col: 5, offset: 9191},
 							val:        "List/build",
 							ignoreCase: false,
 							want:       "\"List/build\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 273, col: 5, offset: 7104},
+						pos: position{line: 332, col: 5, offset: 9234},
 						run: (*parser).callonIdentifier31,
 						expr: &litMatcher{
-							pos:        position{line: 273, col: 5, offset: 7104},
+							pos:        position{line: 332, col: 5, offset: 9234},
 							val:        "List/fold",
 							ignoreCase: false,
 							want:       "\"List/fold\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 274, col: 5, offset: 7145},
+						pos: position{line: 333, col: 5, offset: 9275},
 						run: (*parser).callonIdentifier33,
 						expr: &litMatcher{
-							pos:        position{line: 274, col: 5, offset: 7145},
+							pos:        position{line: 333, col: 5, offset: 9275},
 							val:        "List/length",
 							ignoreCase: false,
 							want:       "\"List/length\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 275, col: 5, offset: 7190},
+						pos: position{line: 334, col: 5, offset: 9320},
 						run: (*parser).callonIdentifier35,
 						expr: &litMatcher{
-							pos:        position{line: 275, col: 5, offset: 7190},
+							pos:        position{line: 334, col: 5, offset: 9320},
 							val:        "List/head",
 							ignoreCase: false,
 							want:       "\"List/head\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 276, col: 5, offset: 7231},
+						pos: position{line: 335, col: 5, offset: 9361},
 						run: (*parser).callonIdentifier37,
 						expr: &litMatcher{
-							pos:        position{line: 276, col: 5, offset: 7231},
+							pos:        position{line: 335, col: 5, offset: 9361},
 							val:        "List/last",
 							ignoreCase: false,
 							want:       "\"List/last\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 277, col: 5, offset: 7272},
+						pos: position{line: 336, col: 5, offset: 9402},
 						run: (*parser).callonIdentifier39,
 						expr: &litMatcher{
-							pos:        position{line: 277, col: 5, offset: 7272},
+							pos:        position{line: 336, col: 5, offset: 9402},
 							val:        "List/indexed",
 							ignoreCase: false,
 							want:       "\"List/indexed\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 278, col: 5, offset: 7319},
+						pos: position{line: 337, col: 5, offset: 9449},
 						run: (*parser).callonIdentifier41,
 						expr: &litMatcher{
-							pos:        position{line: 278, col: 5, offset: 7319},
+							pos:        position{line: 337, col: 5, offset: 9449},
 							val:        "List/reverse",
 							ignoreCase: false,
 							want:       "\"List/reverse\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 279, col: 5, offset: 7366},
+						pos: position{line: 338, col: 5, offset: 9496},
 						run: (*parser).callonIdentifier43,
 						expr: &litMatcher{
-							pos:        position{line: 279, col: 5, offset: 7366},
+							pos:        position{line: 338, col: 5, offset: 9496},
 							val:        "Text/show",
 							ignoreCase: false,
 							want:       "\"Text/show\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 280, col: 5, offset: 7407},
+						pos: position{line: 339, col: 5, offset: 9537},
 						run: (*parser).callonIdentifier45,
 						expr: &litMatcher{
-							pos:        position{line: 280, col: 5, offset: 7407},
+							pos:        position{line: 339, col: 5, offset: 9537},
 							val:        "Text/replace",
 							ignoreCase: false,
 							want:       "\"Text/replace\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 281, col: 5, offset: 7454},
+						pos: position{line: 340, col: 5, offset: 9584},
 						run: (*parser).callonIdentifier47,
 						expr: &litMatcher{
-							pos:        position{line: 281, col: 5, offset: 7454},
+							pos:        position{line: 340, col: 5, offset: 9584},
 							val:        "Bool",
 							ignoreCase: false,
 							want:       "\"Bool\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 282, col: 5, offset: 7486},
+						pos: position{line: 341, col: 5, offset: 9616},
 						run: (*parser).callonIdentifier49,
 						expr: &litMatcher{
-							pos:        position{line: 282, col: 5, offset: 7486},
+							pos:        position{line: 341, col: 5, offset: 9616},
 							val:        "True",
 							ignoreCase: false,
 							want:       "\"True\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 283, col: 5, offset: 7518},
+						pos: position{line: 342, col: 5, offset: 9648},
 						run: (*parser).callonIdentifier51,
 						expr: &litMatcher{
-							pos:        position{line: 283, col: 5, offset: 7518},
+							pos:        position{line: 342, col: 5, offset: 9648},
 							val:        "False",
 							ignoreCase: false,
 							want:       "\"False\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 284, col: 5, offset: 7552},
+						pos: position{line: 343, col: 5, offset: 9682},
 						run: (*parser).callonIdentifier53,
 						expr: &litMatcher{
-							pos:        position{line: 284, col: 5, offset: 7552},
+							pos:        position{line: 343, col: 5, offset: 9682},
 							val:        "Optional",
 							ignoreCase: false,
 							want:       "\"Optional\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 285, col: 5, offset: 7592},
+						pos: position{line: 344, col: 5, offset: 9722},
 						run: (*parser).callonIdentifier55,
 						expr: &litMatcher{
-							pos:        position{line: 285, col: 5, offset: 7592},
+							pos:        position{line: 344, col: 5, offset: 9722},
 							val:        "None",
 							ignoreCase: false,
 							want:       "\"None\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 286, col: 5, offset: 7624},
+						pos: position{line: 345, col: 5, offset: 9754},
 						run: (*parser).callonIdentifier57,
 						expr: &litMatcher{
-							pos:        position{line: 286, col: 5, offset: 7624},
+							pos:        position{line: 345, col: 5, offset: 9754},
 							val:        "Natural",
 							ignoreCase: false,
 							want:       "\"Natural\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 287, col: 5, offset: 7662},
+						pos: position{line: 346, col: 5, offset: 9792},
 						run: (*parser).callonIdentifier59,
 						expr: &litMatcher{
-							pos:        position{line: 287, col: 5, offset: 7662},
+							pos:        position{line: 346, col: 5, offset: 9792},
 							val:        "Integer",
 							ignoreCase: false,
 							want:       "\"Integer\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 288, col: 5, offset: 7700},
+						pos: position{line: 347, col: 5, offset: 9830},
 						run: (*parser).callonIdentifier61,
 						expr: &litMatcher{
-							pos:        position{line: 288, col: 5, offset: 7700},
+							pos:        position{line: 347, col: 5, offset: 9830},
 							val:        "Double",
 							ignoreCase: false,
 							want:       "\"Double\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 289, col: 5, offset: 7736},
+						pos: position{line: 348, col: 5, offset: 9866},
 						run: (*parser).callonIdentifier63,
 						expr: &litMatcher{
-							pos:        position{line: 289, col: 5, offset: 7736},
+							pos:        position{line: 348, col: 5, offset: 9866},
 							val:        "Text",
 							ignoreCase: false,
 							want:       "\"Text\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 290, col: 5, offset: 7768},
+						pos: position{line: 349, col: 5, offset: 9898},
 						run: (*parser).callonIdentifier65,
 						expr: &litMatcher{
-							pos:        position{line: 290, col: 5, offset: 7768},
+							pos:        position{line: 349, col: 5, offset: 9898},
 							val:        "List",
 							ignoreCase: false,
 							want:       "\"List\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 291, col: 5, offset: 7800},
+						pos: position{line: 350, col: 5, offset: 9930},
 						run: (*parser).callonIdentifier67,
 						expr: &litMatcher{
-							pos:        position{line: 291, col: 5, offset: 7800},
+							pos:        position{line: 350, col: 5, offset: 9930},
 							val:        "Type",
 							ignoreCase: false,
 							want:       "\"Type\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 292, col: 5, offset: 7832},
+						pos: position{line: 351, col: 5, offset: 9962},
 						run: (*parser).callonIdentifier69,
 						expr: &litMatcher{
-							pos:        position{line: 292, col: 5, offset: 7832},
+							pos:        position{line: 351, col: 5, offset: 9962},
 							val:        "Kind",
 							ignoreCase: false,
 							want:       "\"Kind\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 293, col: 5, offset: 7864},
+						pos: position{line: 352, col: 5, offset: 9994},
 						run: (*parser).callonIdentifier71,
 						expr: &litMatcher{
-							pos:        position{line: 293, col: 5, offset: 7864},
+							pos:        position{line: 352, col: 5, offset: 9994},
 							val:        "Sort",
 							ignoreCase: false,
 							want:       "\"Sort\"",
@@ -1125,62 +1173,62 @@ var g = &grammar{
 		},
 		{
 			name: "DeBruijn",
-			pos:  position{line: 337, col: 1, offset: 9094},
+			pos:  position{line: 396, col: 1, offset: 11224},
 			expr: &actionExpr{
-				pos: position{line: 337, col: 12, offset: 9107},
+				pos: position{line: 396, col: 12, offset: 11237},
 				run: (*parser).callonDeBruijn1,
 				expr: &seqExpr{
-					pos: position{line: 337, col: 12, offset: 9107},
+					pos: position{line: 396, col: 12, offset: 11237},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 12, offset: 9107},
+							pos:  position{line: 396, col: 12, offset: 11237},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 14, offset: 9109},
+							pos:        position{line: 396, col: 14, offset: 11239},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 18, offset: 9113},
+							pos:  position{line: 396, col: 18, offset: 11243},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 337, col: 20, offset: 9115},
+							pos:   position{line: 396, col: 20, offset: 11245},
 							label: "index",
 							expr: &choiceExpr{
-								pos: position{line: 323, col: 3, offset: 8618},
+								pos: position{line: 382, col: 3, offset: 10748},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 323, col: 3, offset: 8618},
+										pos: position{line: 382, col: 3, offset: 10748},
 										run: (*parser).callonDeBruijn8,
 										expr: &choiceExpr{
-											pos: position{line: 323, col: 4, offset: 8619},
+											pos: position{line: 382, col: 4, offset: 10749},
 											alternatives: []interface{}{
 												&seqExpr{
-													pos: position{line: 323, col: 4, offset: 8619},
+													pos: position{line: 382, col: 4, offset: 10749},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 323, col: 4, offset: 8619},
+															pos:        position{line: 382, col: 4, offset: 10749},
 															val:        "0x",
 															ignoreCase: false,
 															want:       "\"0x\"",
 														},
 														&oneOrMoreExpr{
-															pos: position{line: 323, col: 9, offset: 8624},
+															pos: position{line: 382, col: 9, offset: 10754},
 															expr: &choiceExpr{
-																pos: position{line: 118, col: 10, offset: 2710},
+																pos: position{line: 177, col: 10, offset: 4840},
 																alternatives: []interface{}{
 																	&charClassMatcher{
-																		pos:        position{line: 116, col: 9, offset: 2692},
+																		pos:        position{line: 175, col: 9, offset: 4822},
 																		val:        "[0-9]",
 																		ranges:     []rune{'0', '9'},
 																		ignoreCase: false,
 																		inverted:   false,
 																	},
 																	&charClassMatcher{
-																		pos:        position{line: 118, col: 18, offset: 2718},
+																		pos:        position{line: 177, col: 18, offset: 4848},
 																		val:        "[a-f]i",
 																		ranges:     []rune{'a', 'f'},
 																		ignoreCase: true,
@@ -1192,19 +1240,19 @@ var g = &grammar{
 													},
 												},
 												&seqExpr{
-													pos: position{line: 323, col: 19, offset: 8634},
+													pos: position{line: 382, col: 19, offset: 10764},
 													exprs: []interface{}{
 														&charClassMatcher{
-															pos:        position{line: 323, col: 19, offset: 8634},
+															pos:        position{line: 382, col: 19, offset: 10764},
 															val:        "[1-9]",
 															ranges:     []rune{'1', '9'},
 															ignoreCase: false,
 															inverted:   false,
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 323, col: 25, offset: 8640},
+															pos: position{line: 382, col: 25, offset: 10770},
 															expr: &charClassMatcher{
-																pos:        position{line: 116, col: 9, offset: 2692},
+																pos:        position{line: 175, col: 9, offset: 4822},
 																val:        "[0-9]",
 																ranges:     []rune{'0', '9'},
 																ignoreCase: false,
@@ -1217,21 +1265,21 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 328, col: 5, offset: 8776},
+										pos: position{line: 387, col: 5, offset: 10906},
 										run: (*parser).callonDeBruijn20,
 										expr: &seqExpr{
-											pos: position{line: 328, col: 5, offset: 8776},
+											pos: position{line: 387, col: 5, offset: 10906},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 328, col: 5, offset: 8776},
+													pos:        position{line: 387, col: 5, offset: 10906},
 													val:        "0",
 													ignoreCase: false,
 													want:       "\"0\"",
 												},
 												&oneOrMoreExpr{
-													pos: position{line: 328, col: 9, offset: 8780},
+													pos: position{line: 387, col: 9, offset: 10910},
 													expr: &charClassMatcher{
-														pos:        position{line: 116, col: 9, offset: 2692},
+														pos:        position{line: 175, col: 9, offset: 4822},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -1242,10 +1290,10 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 329, col: 5, offset: 8865},
+										pos: position{line: 388, col: 5, offset: 10995},
 										run: (*parser).callonDeBruijn25,
 										expr: &litMatcher{
-											pos:        position{line: 329, col: 5, offset: 8865},
+											pos:        position{line: 388, col: 5, offset: 10995},
 											val:        "0",
 											ignoreCase: false,
 											want:       "\"0\"",
@@ -1260,378 +1308,378 @@ var g = &grammar{
 		},
 		{
 			name: "Variable",
-			pos:  position{line: 339, col: 1, offset: 9177},
+			pos:  position{line: 398, col: 1, offset: 11307},
 			expr: &actionExpr{
-				pos: position{line: 339, col: 12, offset: 9190},
+				pos: position{line: 398, col: 12, offset: 11320},
 				run: (*parser).callonVariable1,
 				expr: &seqExpr{
-					pos: position{line: 339, col: 12, offset: 9190},
+					pos: position{line: 398, col: 12, offset: 11320},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 339, col: 12, offset: 9190},
+							pos:   position{line: 398, col: 12, offset: 11320},
 							label: "name",
 							expr: &choiceExpr{
-								pos: position{line: 134, col: 20, offset: 3237},
+								pos: position{line: 193, col: 20, offset: 5367},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 134, col: 20, offset: 3237},
+										pos: position{line: 193, col: 20, offset: 5367},
 										run: (*parser).callonVariable5,
 										expr: &seqExpr{
-											pos: position{line: 134, col: 20, offset: 3237},
+											pos: position{line: 193, col: 20, offset: 5367},
 											exprs: []interface{}{
 												&andExpr{
-													pos: position{line: 134, col: 20, offset: 3237},
+													pos: position{line: 193, col: 20, offset: 5367},
 													expr: &seqExpr{
-														pos: position{line: 134, col: 22, offset: 3239},
+														pos: position{line: 193, col: 22, offset: 5369},
 														exprs: []interface{}{
 															&choiceExpr{
-																pos: position{line: 259, col: 5, offset: 6416},
+																pos: position{line: 318, col: 5, offset: 8546},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 259, col: 5, offset: 6416},
+																		pos: position{line: 318, col: 5, offset: 8546},
 																		run: (*parser).callonVariable10,
 																		expr: &litMatcher{
-																			pos:        position{line: 259, col: 5, offset: 6416},
+																			pos:        position{line: 318, col: 5, offset: 8546},
 																			val:        "Natural/fold",
 																			ignoreCase: false,
 																			want:       "\"Natural/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 260, col: 5, offset: 6463},
+																		pos: position{line: 319, col: 5, offset: 8593},
 																		run: (*parser).callonVariable12,
 																		expr: &litMatcher{
-																			pos:        position{line: 260, col: 5, offset: 6463},
+																			pos:        position{line: 319, col: 5, offset: 8593},
 																			val:        "Natural/build",
 																			ignoreCase: false,
 																			want:       "\"Natural/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 261, col: 5, offset: 6512},
+																		pos: position{line: 320, col: 5, offset: 8642},
 																		run: (*parser).callonVariable14,
 																		expr: &litMatcher{
-																			pos:        position{line: 261, col: 5, offset: 6512},
+																			pos:        position{line: 320, col: 5, offset: 8642},
 																			val:        "Natural/isZero",
 																			ignoreCase: false,
 																			want:       "\"Natural/isZero\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 262, col: 5, offset: 6563},
+																		pos: position{line: 321, col: 5, offset: 8693},
 																		run: (*parser).callonVariable16,
 																		expr: &litMatcher{
-																			pos:        position{line: 262, col: 5, offset: 6563},
+																			pos:        position{line: 321, col: 5, offset: 8693},
 																			val:        "Natural/even",
 																			ignoreCase: false,
 																			want:       "\"Natural/even\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 263, col: 5, offset: 6610},
+																		pos: position{line: 322, col: 5, offset: 8740},
 																		run: (*parser).callonVariable18,
 																		expr: &litMatcher{
-																			pos:        position{line: 263, col: 5, offset: 6610},
+																			pos:        position{line: 322, col: 5, offset: 8740},
 																			val:        "Natural/odd",
 																			ignoreCase: false,
 																			want:       "\"Natural/odd\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 264, col: 5, offset: 6655},
+																		pos: position{line: 323, col: 5, offset: 8785},
 																		run: (*parser).callonVariable20,
 																		expr: &litMatcher{
-																			pos:        position{line: 264, col: 5, offset: 6655},
+																			pos:        position{line: 323, col: 5, offset: 8785},
 																			val:        "Natural/toInteger",
 																			ignoreCase: false,
 																			want:       "\"Natural/toInteger\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 265, col: 5, offset: 6712},
+																		pos: position{line: 324, col: 5, offset: 8842},
 																		run: (*parser).callonVariable22,
 																		expr: &litMatcher{
-																			pos:        position{line: 265, col: 5, offset: 6712},
+																			pos:        position{line: 324, col: 5, offset: 8842},
 																			val:        "Natural/show",
 																			ignoreCase: false,
 																			want:       "\"Natural/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 266, col: 5, offset: 6759},
+																		pos: position{line: 325, col: 5, offset: 8889},
 																		run: (*parser).callonVariable24,
 																		expr: &litMatcher{
-																			pos:        position{line: 266, col: 5, offset: 6759},
+																			pos:        position{line: 325, col: 5, offset: 8889},
 																			val:        "Integer/toDouble",
 																			ignoreCase: false,
 																			want:       "\"Integer/toDouble\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 267, col: 5, offset: 6814},
+																		pos: position{line: 326, col: 5, offset: 8944},
 																		run: (*parser).callonVariable26,
 																		expr: &litMatcher{
-																			pos:        position{line: 267, col: 5, offset: 6814},
+																			pos:        position{line: 326, col: 5, offset: 8944},
 																			val:        "Integer/show",
 																			ignoreCase: false,
 																			want:       "\"Integer/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 268, col: 5, offset: 6861},
+																		pos: position{line: 327, col: 5, offset: 8991},
 																		run: (*parser).callonVariable28,
 																		expr: &litMatcher{
-																			pos:        position{line: 268, col: 5, offset: 6861},
+																			pos:        position{line: 327, col: 5, offset: 8991},
 																			val:        "Integer/negate",
 																			ignoreCase: false,
 																			want:       "\"Integer/negate\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 269, col: 5, offset: 6912},
+																		pos: position{line: 328, col: 5, offset: 9042},
 																		run: (*parser).callonVariable30,
 																		expr: &litMatcher{
-																			pos:        position{line: 269, col: 5, offset: 6912},
+																			pos:        position{line: 328, col: 5, offset: 9042},
 																			val:        "Integer/clamp",
 																			ignoreCase: false,
 																			want:       "\"Integer/clamp\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 270, col: 5, offset: 6961},
+																		pos: position{line: 329, col: 5, offset: 9091},
 																		run: (*parser).callonVariable32,
 																		expr: &litMatcher{
-																			pos:        position{line: 270, col: 5, offset: 6961},
+																			pos:        position{line: 329, col: 5, offset: 9091},
 																			val:        "Natural/subtract",
 																			ignoreCase: false,
 																			want:       "\"Natural/subtract\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 271, col: 5, offset: 7016},
+																		pos: position{line: 330, col: 5, offset: 9146},
 																		run: (*parser).callonVariable34,
 																		expr: &litMatcher{
-																			pos:        position{line: 271, col: 5, offset: 7016},
+																			pos:        position{line: 330, col: 5, offset: 9146},
 																			val:        "Double/show",
 																			ignoreCase: false,
 																			want:       "\"Double/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 272, col: 5, offset: 7061},
+																		pos: position{line: 331, col: 5, offset: 9191},
 																		run: (*parser).callonVariable36,
 																		expr: &litMatcher{
-																			pos:        position{line: 272, col: 5, offset: 7061},
+																			pos:        position{line: 331, col: 5, offset: 9191},
 																			val:        "List/build",
 																			ignoreCase: false,
 																			want:       "\"List/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 273, col: 5, offset: 7104},
+																		pos: position{line: 332, col: 5, offset: 9234},
 																		run: (*parser).callonVariable38,
 																		expr: &litMatcher{
-																			pos:        position{line: 273, col: 5, offset: 7104},
+																			pos:        position{line: 332, col: 5, offset: 9234},
 																			val:        "List/fold",
 																			ignoreCase: false,
 																			want:       "\"List/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 274, col: 5, offset: 7145},
+																		pos: position{line: 333, col: 5, offset: 9275},
 																		run: (*parser).callonVariable40,
 																		expr: &litMatcher{
-																			pos:        position{line: 274, col: 5, offset: 7145},
+																			pos:        position{line: 333, col: 5, offset: 9275},
 																			val:        "List/length",
 																			ignoreCase: false,
 																			want:       "\"List/length\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 275, col: 5, offset: 7190},
+																		pos: position{line: 334, col: 5, offset: 9320},
 																		run: (*parser).callonVariable42,
 																		expr: &litMatcher{
-																			pos:        position{line: 275, col: 5, offset: 7190},
+																			pos:        position{line: 334, col: 5, offset: 9320},
 																			val:        "List/head",
 																			ignoreCase: false,
 																			want:       "\"List/head\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 276, col: 5, offset: 7231},
+																		pos: position{line: 335, col: 5, offset: 9361},
 																		run: (*parser).callonVariable44,
 																		expr: &litMatcher{
-																			pos:        position{line: 276, col: 5, offset: 7231},
+																			pos:        position{line: 335, col: 5, offset: 9361},
 																			val:        "List/last",
 																			ignoreCase: false,
 																			want:       "\"List/last\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 277, col: 5, offset: 7272},
+																		pos: position{line: 336, col: 5, offset: 9402},
 																		run: (*parser).callonVariable46,
 																		expr: &litMatcher{
-																			pos:        position{line: 277, col: 5, offset: 7272},
+																			pos:        position{line: 336, col: 5, offset: 9402},
 																			val:        "List/indexed",
 																			ignoreCase: false,
 																			want:       "\"List/indexed\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 278, col: 5, offset: 7319},
+																		pos: position{line: 337, col: 5, offset: 9449},
 																		run: (*parser).callonVariable48,
 																		expr: &litMatcher{
-																			pos:        position{line: 278, col: 5, offset: 7319},
+																			pos:        position{line: 337, col: 5, offset: 9449},
 																			val:        "List/reverse",
 																			ignoreCase: false,
 																			want:       "\"List/reverse\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 279, col: 5, offset: 7366},
+																		pos: position{line: 338, col: 5, offset: 9496},
 																		run: (*parser).callonVariable50,
 																		expr: &litMatcher{
-																			pos:        position{line: 279, col: 5, offset: 7366},
+																			pos:        position{line: 338, col: 5, offset: 9496},
 																			val:        "Text/show",
 																			ignoreCase: false,
 																			want:       "\"Text/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 280, col: 5, offset: 7407},
+																		pos: position{line: 339, col: 5, offset: 9537},
 																		run: (*parser).callonVariable52,
 																		expr: &litMatcher{
-																			pos:        position{line: 280, col: 5, offset: 7407},
+																			pos:        position{line: 339, col: 5, offset: 9537},
 																			val:        "Text/replace",
 																			ignoreCase: false,
 																			want:       "\"Text/replace\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 281, col: 5, offset: 7454},
+																		pos: position{line: 340, col: 5, offset: 9584},
 																		run: (*parser).callonVariable54,
 																		expr: &litMatcher{
-																			pos:        position{line: 281, col: 5, offset: 7454},
+																			pos:        position{line: 340, col: 5, offset: 9584},
 																			val:        "Bool",
 																			ignoreCase: false,
 																			want:       "\"Bool\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 282, col: 5, offset: 7486},
+																		pos: position{line: 341, col: 5, offset: 9616},
 																		run: (*parser).callonVariable56,
 																		expr: &litMatcher{
-																			pos:        position{line: 282, col: 5, offset: 7486},
+																			pos:        position{line: 341, col: 5, offset: 9616},
 																			val:        "True",
 																			ignoreCase: false,
 																			want:       "\"True\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 283, col: 5, offset: 7518},
+																		pos: position{line: 342, col: 5, offset: 9648},
 																		run: (*parser).callonVariable58,
 																		expr: &litMatcher{
-																			pos:        position{line: 283, col: 5, offset: 7518},
+																			pos:        position{line: 342, col: 5, offset: 9648},
 																			val:        "False",
 																			ignoreCase: false,
 																			want:       "\"False\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 284, col: 5, offset: 7552},
+																		pos: position{line: 343, col: 5, offset: 9682},
 																		run: (*parser).callonVariable60,
 																		expr: &litMatcher{
-																			pos:        position{line: 284, col: 5, offset: 7552},
+																			pos:        position{line: 343, col: 5, offset: 9682},
 																			val:        "Optional",
 																			ignoreCase: false,
 																			want:       "\"Optional\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 285, col: 5, offset: 7592},
+																		pos: position{line: 344, col: 5, offset: 9722},
 																		run: (*parser).callonVariable62,
 																		expr: &litMatcher{
-																			pos:        position{line: 285, col: 5, offset: 7592},
+																			pos:        position{line: 344, col: 5, offset: 9722},
 																			val:        "None",
 																			ignoreCase: false,
 																			want:       "\"None\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 286, col: 5, offset: 7624},
+																		pos: position{line: 345, col: 5, offset: 9754},
 																		run: (*parser).callonVariable64,
 																		expr: &litMatcher{
-																			pos:        position{line: 286, col: 5, offset: 7624},
+																			pos:        position{line: 345, col: 5, offset: 9754},
 																			val:        "Natural",
 																			ignoreCase: false,
 																			want:       "\"Natural\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 287, col: 5, offset: 7662},
+																		pos: position{line: 346, col: 5, offset: 9792},
 																		run: (*parser).callonVariable66,
 																		expr: &litMatcher{
-																			pos:        position{line: 287, col: 5, offset: 7662},
+																			pos:        position{line: 346, col: 5, offset: 9792},
 																			val:        "Integer",
 																			ignoreCase: false,
 																			want:       "\"Integer\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 288, col: 5, offset: 7700},
+																		pos: position{line: 347, col: 5, offset: 9830},
 																		run: (*parser).callonVariable68,
 																		expr: &litMatcher{
-																			pos:        position{line: 288, col: 5, offset: 7700},
+																			pos:        position{line: 347, col: 5, offset: 9830},
 																			val:        "Double",
 																			ignoreCase: false,
 																			want:       "\"Double\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 289, col: 5, offset: 7736},
+																		pos: position{line: 348, col: 5, offset: 9866},
 																		run: (*parser).callonVariable70,
 																		expr: &litMatcher{
-																			pos:        position{line: 289, col: 5, offset: 7736},
+																			pos:        position{line: 348, col: 5, offset: 9866},
 																			val:        "Text",
 																			ignoreCase: false,
 																			want:       "\"Text\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 290, col: 5, offset: 7768},
+																		pos: position{line: 349, col: 5, offset: 9898},
 																		run: (*parser).callonVariable72,
 																		expr: &litMatcher{
-																			pos:        position{line: 290, col: 5, offset: 7768},
+																			pos:        position{line: 349, col: 5, offset: 9898},
 																			val:        "List",
 																			ignoreCase: false,
 																			want:       "\"List\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 291, col: 5, offset: 7800},
+																		pos: position{line: 350, col: 5, offset: 9930},
 																		run: (*parser).callonVariable74,
 																		expr: &litMatcher{
-																			pos:        position{line: 291, col: 5, offset: 7800},
+																			pos:        position{line: 350, col: 5, offset: 9930},
 																			val:        "Type",
 																			ignoreCase: false,
 																			want:       "\"Type\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 292, col: 5, offset: 7832},
+																		pos: position{line: 351, col: 5, offset: 9962},
 																		run: (*parser).callonVariable76,
 																		expr: &litMatcher{
-																			pos:        position{line: 292, col: 5, offset: 7832},
+																			pos:        position{line: 351, col: 5, offset: 9962},
 																			val:        "Kind",
 																			ignoreCase: false,
 																			want:       "\"Kind\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 293, col: 5, offset: 7864},
+																		pos: position{line: 352, col: 5, offset: 9994},
 																		run: (*parser).callonVariable78,
 																		expr: &litMatcher{
-																			pos:        position{line: 293, col: 5, offset: 7864},
+																			pos:        position{line: 352, col: 5, offset: 9994},
 																			val:        "Sort",
 																			ignoreCase: false,
 																			want:       "\"Sort\"",
@@ -1640,7 +1688,7 @@ var g = &grammar{
 																},
 															},
 															&charClassMatcher{
-																pos:        position{line: 121, col: 23, offset: 2785},
+																pos:        position{line: 180, col: 23, offset: 4915},
 																val:        "[_/-A-Za-z0-9]",
 																chars:      []rune{'_', '/', '-'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -1651,33 +1699,33 @@ var g = &grammar{
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 134, col: 51, offset: 3268},
+													pos:   position{line: 193, col: 51, offset: 5398},
 													label: "label",
 													expr: &choiceExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																run: (*parser).callonVariable83,
 																expr: &seqExpr{
-																	pos: position{line: 131, col: 9, offset: 3119},
+																	pos: position{line: 190, col: 9, offset: 5249},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 131, col: 9, offset: 3119},
+																			pos:        position{line: 190, col: 9, offset: 5249},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
-																			pos:   position{line: 131, col: 13, offset: 3123},
+																			pos:   position{line: 190, col: 13, offset: 5253},
 																			label: "label",
 																			expr: &actionExpr{
-																				pos: position{line: 129, col: 15, offset: 3060},
+																				pos: position{line: 188, col: 15, offset: 5190},
 																				run: (*parser).callonVariable87,
 																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 129, col: 15, offset: 3060},
+																					pos: position{line: 188, col: 15, offset: 5190},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 128, col: 19, offset: 3023},
+																						pos:        position{line: 187, col: 19, offset: 5153},
 																						val:        "[ -_a-~]",
 																						ranges:     []rune{' ', '_', 'a', '~'},
 																						ignoreCase: false,
@@ -1687,7 +1735,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 131, col: 31, offset: 3141},
+																			pos:        position{line: 190, col: 31, offset: 5271},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
@@ -1696,75 +1744,75 @@ var g = &grammar{
 																},
 															},
 															&actionExpr{
-																pos: position{line: 132, col: 9, offset: 3175},
+																pos: position{line: 191, col: 9, offset: 5305},
 																run: (*parser).callonVariable91,
 																expr: &labeledExpr{
-																	pos:   position{line: 132, col: 9, offset: 3175},
+																	pos:   position{line: 191, col: 9, offset: 5305},
 																	label: "label",
 																	expr: &choiceExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				run: (*parser).callonVariable94,
 																				expr: &seqExpr{
-																					pos: position{line: 122, col: 15, offset: 2816},
+																					pos: position{line: 181, col: 15, offset: 4946},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 249, col: 5, offset: 6269},
+																							pos: position{line: 308, col: 5, offset: 8399},
 																							alternatives: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 231, col: 6, offset: 5936},
+																									pos:        position{line: 290, col: 6, offset: 8066},
 																									val:        "if",
 																									ignoreCase: false,
 																									want:       "\"if\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 232, col: 8, offset: 5950},
+																									pos:        position{line: 291, col: 8, offset: 8080},
 																									val:        "then",
 																									ignoreCase: false,
 																									want:       "\"then\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 233, col: 8, offset: 5966},
+																									pos:        position{line: 292, col: 8, offset: 8096},
 																									val:        "else",
 																									ignoreCase: false,
 																									want:       "\"else\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 234, col: 7, offset: 5981},
+																									pos:        position{line: 293, col: 7, offset: 8111},
 																									val:        "let",
 																									ignoreCase: false,
 																									want:       "\"let\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 235, col: 6, offset: 5994},
+																									pos:        position{line: 294, col: 6, offset: 8124},
 																									val:        "in",
 																									ignoreCase: false,
 																									want:       "\"in\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 237, col: 9, offset: 6021},
+																									pos:        position{line: 296, col: 9, offset: 8151},
 																									val:        "using",
 																									ignoreCase: false,
 																									want:       "\"using\"",
 																								},
 																								&actionExpr{
-																									pos: position{line: 239, col: 11, offset: 6059},
+																									pos: position{line: 298, col: 11, offset: 8189},
 																									run: (*parser).callonVariable103,
 																									expr: &seqExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										exprs: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 239, col: 11, offset: 6059},
+																												pos:        position{line: 298, col: 11, offset: 8189},
 																												val:        "missing",
 																												ignoreCase: false,
 																												want:       "\"missing\"",
 																											},
 																											&notExpr{
-																												pos: position{line: 239, col: 21, offset: 6069},
+																												pos: position{line: 298, col: 21, offset: 8199},
 																												expr: &charClassMatcher{
-																													pos:        position{line: 121, col: 23, offset: 2785},
+																													pos:        position{line: 180, col: 23, offset: 4915},
 																													val:        "[_/-A-Za-z0-9]",
 																													chars:      []rune{'_', '/', '-'},
 																													ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -1776,61 +1824,61 @@ var g = &grammar{
 																									},
 																								},
 																								&litMatcher{
-																									pos:        position{line: 244, col: 10, offset: 6199},
+																									pos:        position{line: 303, col: 10, offset: 8329},
 																									val:        "assert",
 																									ignoreCase: false,
 																									want:       "\"assert\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 236, col: 6, offset: 6006},
+																									pos:        position{line: 295, col: 6, offset: 8136},
 																									val:        "as",
 																									ignoreCase: false,
 																									want:       "\"as\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 240, col: 12, offset: 6129},
+																									pos:        position{line: 299, col: 12, offset: 8259},
 																									val:        "Infinity",
 																									ignoreCase: false,
 																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 241, col: 7, offset: 6148},
+																									pos:        position{line: 300, col: 7, offset: 8278},
 																									val:        "NaN",
 																									ignoreCase: false,
 																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 238, col: 9, offset: 6039},
+																									pos:        position{line: 297, col: 9, offset: 8169},
 																									val:        "merge",
 																									ignoreCase: false,
 																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 242, col: 8, offset: 6163},
+																									pos:        position{line: 301, col: 8, offset: 8293},
 																									val:        "Some",
 																									ignoreCase: false,
 																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 243, col: 9, offset: 6180},
+																									pos:        position{line: 302, col: 9, offset: 8310},
 																									val:        "toMap",
 																									ignoreCase: false,
 																									want:       "\"toMap\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 10, offset: 6219},
+																									pos:        position{line: 304, col: 10, offset: 8349},
 																									val:        "forall",
 																									ignoreCase: false,
 																									want:       "\"forall\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 21, offset: 6230},
+																									pos:        position{line: 304, col: 21, offset: 8360},
 																									val:        "∀",
 																									ignoreCase: false,
 																									want:       "\"∀\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 246, col: 8, offset: 6245},
+																									pos:        position{line: 305, col: 8, offset: 8375},
 																									val:        "with",
 																									ignoreCase: false,
 																									want:       "\"with\"",
@@ -1838,9 +1886,9 @@ var g = &grammar{
 																							},
 																						},
 																						&oneOrMoreExpr{
-																							pos: position{line: 122, col: 23, offset: 2824},
+																							pos: position{line: 181, col: 23, offset: 4954},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -1852,68 +1900,68 @@ var g = &grammar{
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				run: (*parser).callonVariable120,
 																				expr: &seqExpr{
-																					pos: position{line: 123, col: 13, offset: 2888},
+																					pos: position{line: 182, col: 13, offset: 5018},
 																					exprs: []interface{}{
 																						&notExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							expr: &choiceExpr{
-																								pos: position{line: 249, col: 5, offset: 6269},
+																								pos: position{line: 308, col: 5, offset: 8399},
 																								alternatives: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 231, col: 6, offset: 5936},
+																										pos:        position{line: 290, col: 6, offset: 8066},
 																										val:        "if",
 																										ignoreCase: false,
 																										want:       "\"if\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 232, col: 8, offset: 5950},
+																										pos:        position{line: 291, col: 8, offset: 8080},
 																										val:        "then",
 																										ignoreCase: false,
 																										want:       "\"then\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 233, col: 8, offset: 5966},
+																										pos:        position{line: 292, col: 8, offset: 8096},
 																										val:        "else",
 																										ignoreCase: false,
 																										want:       "\"else\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 234, col: 7, offset: 5981},
+																										pos:        position{line: 293, col: 7, offset: 8111},
 																										val:        "let",
 																										ignoreCase: false,
 																										want:       "\"let\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 235, col: 6, offset: 5994},
+																										pos:        position{line: 294, col: 6, offset: 8124},
 																										val:        "in",
 																										ignoreCase: false,
 																										want:       "\"in\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 237, col: 9, offset: 6021},
+																										pos:        position{line: 296, col: 9, offset: 8151},
 																										val:        "using",
 																										ignoreCase: false,
 																										want:       "\"using\"",
 																									},
 																									&actionExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										run: (*parser).callonVariable130,
 																										expr: &seqExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											exprs: []interface{}{
 																												&litMatcher{
-																													pos:        position{line: 239, col: 11, offset: 6059},
+																													pos:        position{line: 298, col: 11, offset: 8189},
 																													val:        "missing",
 																													ignoreCase: false,
 																													want:       "\"missing\"",
 																												},
 																												&notExpr{
-																													pos: position{line: 239, col: 21, offset: 6069},
+																													pos: position{line: 298, col: 21, offset: 8199},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 121, col: 23, offset: 2785},
+																														pos:        position{line: 180, col: 23, offset: 4915},
 																														val:        "[_/-A-Za-z0-9]",
 																														chars:      []rune{'_', '/', '-'},
 																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -1925,61 +1973,61 @@ var g = &grammar{
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 244, col: 10, offset: 6199},
+																										pos:        position{line: 303, col: 10, offset: 8329},
 																										val:        "assert",
 																										ignoreCase: false,
 																										want:       "\"assert\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 236, col: 6, offset: 6006},
+																										pos:        position{line: 295, col: 6, offset: 8136},
 																										val:        "as",
 																										ignoreCase: false,
 																										want:       "\"as\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 240, col: 12, offset: 6129},
+																										pos:        position{line: 299, col: 12, offset: 8259},
 																										val:        "Infinity",
 																										ignoreCase: false,
 																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 241, col: 7, offset: 6148},
+																										pos:        position{line: 300, col: 7, offset: 8278},
 																										val:        "NaN",
 																										ignoreCase: false,
 																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 238, col: 9, offset: 6039},
+																										pos:        position{line: 297, col: 9, offset: 8169},
 																										val:        "merge",
 																										ignoreCase: false,
 																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 242, col: 8, offset: 6163},
+																										pos:        position{line: 301, col: 8, offset: 8293},
 																										val:        "Some",
 																										ignoreCase: false,
 																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 243, col: 9, offset: 6180},
+																										pos:        position{line: 302, col: 9, offset: 8310},
 																										val:        "toMap",
 																										ignoreCase: false,
 																										want:       "\"toMap\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 10, offset: 6219},
+																										pos:        position{line: 304, col: 10, offset: 8349},
 																										val:        "forall",
 																										ignoreCase: false,
 																										want:       "\"forall\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 21, offset: 6230},
+																										pos:        position{line: 304, col: 21, offset: 8360},
 																										val:        "∀",
 																										ignoreCase: false,
 																										want:       "\"∀\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 246, col: 8, offset: 6245},
+																										pos:        position{line: 305, col: 8, offset: 8375},
 																										val:        "with",
 																										ignoreCase: false,
 																										want:       "\"with\"",
@@ -1988,7 +2036,7 @@ var g = &grammar{
 																							},
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 120, col: 24, offset: 2751},
+																							pos:        position{line: 179, col: 24, offset: 4881},
 																							val:        "[_A-Za-z]",
 																							chars:      []rune{'_'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1996,9 +2044,9 @@ var g = &grammar{
 																							inverted:   false,
 																						},
 																						&zeroOrMoreExpr{
-																							pos: position{line: 123, col: 43, offset: 2918},
+																							pos: position{line: 182, col: 43, offset: 5048},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2020,361 +2068,361 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 135, col: 19, offset: 3320},
+										pos: position{line: 194, col: 19, offset: 5450},
 										run: (*parser).callonVariable148,
 										expr: &seqExpr{
-											pos: position{line: 135, col: 19, offset: 3320},
+											pos: position{line: 194, col: 19, offset: 5450},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 135, col: 19, offset: 3320},
+													pos: position{line: 194, col: 19, offset: 5450},
 													expr: &choiceExpr{
-														pos: position{line: 259, col: 5, offset: 6416},
+														pos: position{line: 318, col: 5, offset: 8546},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 259, col: 5, offset: 6416},
+																pos: position{line: 318, col: 5, offset: 8546},
 																run: (*parser).callonVariable152,
 																expr: &litMatcher{
-																	pos:        position{line: 259, col: 5, offset: 6416},
+																	pos:        position{line: 318, col: 5, offset: 8546},
 																	val:        "Natural/fold",
 																	ignoreCase: false,
 																	want:       "\"Natural/fold\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 260, col: 5, offset: 6463},
+																pos: position{line: 319, col: 5, offset: 8593},
 																run: (*parser).callonVariable154,
 																expr: &litMatcher{
-																	pos:        position{line: 260, col: 5, offset: 6463},
+																	pos:        position{line: 319, col: 5, offset: 8593},
 																	val:        "Natural/build",
 																	ignoreCase: false,
 																	want:       "\"Natural/build\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 261, col: 5, offset: 6512},
+																pos: position{line: 320, col: 5, offset: 8642},
 																run: (*parser).callonVariable156,
 																expr: &litMatcher{
-																	pos:        position{line: 261, col: 5, offset: 6512},
+																	pos:        position{line: 320, col: 5, offset: 8642},
 																	val:        "Natural/isZero",
 																	ignoreCase: false,
 																	want:       "\"Natural/isZero\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 262, col: 5, offset: 6563},
+																pos: position{line: 321, col: 5, offset: 8693},
 																run: (*parser).callonVariable158,
 																expr: &litMatcher{
-																	pos:        position{line: 262, col: 5, offset: 6563},
+																	pos:        position{line: 321, col: 5, offset: 8693},
 																	val:        "Natural/even",
 																	ignoreCase: false,
 																	want:       "\"Natural/even\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 263, col: 5, offset: 6610},
+																pos: position{line: 322, col: 5, offset: 8740},
 																run: (*parser).callonVariable160,
 																expr: &litMatcher{
-																	pos:        position{line: 263, col: 5, offset: 6610},
+																	pos:        position{line: 322, col: 5, offset: 8740},
 																	val:        "Natural/odd",
 																	ignoreCase: false,
 																	want:       "\"Natural/odd\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 264, col: 5, offset: 6655},
+																pos: position{line: 323, col: 5, offset: 8785},
 																run: (*parser).callonVariable162,
 																expr: &litMatcher{
-																	pos:        position{line: 264, col: 5, offset: 6655},
+																	pos:        position{line: 323, col: 5, offset: 8785},
 																	val:        "Natural/toInteger",
 																	ignoreCase: false,
 																	want:       "\"Natural/toInteger\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 265, col: 5, offset: 6712},
+																pos: position{line: 324, col: 5, offset: 8842},
 																run: (*parser).callonVariable164,
 																expr: &litMatcher{
-																	pos:        position{line: 265, col: 5, offset: 6712},
+																	pos:        position{line: 324, col: 5, offset: 8842},
 																	val:        "Natural/show",
 																	ignoreCase: false,
 																	want:       "\"Natural/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 266, col: 5, offset: 6759},
+																pos: position{line: 325, col: 5, offset: 8889},
 																run: (*parser).callonVariable166,
 																expr: &litMatcher{
-																	pos:        position{line: 266, col: 5, offset: 6759},
+																	pos:        position{line: 325, col: 5, offset: 8889},
 																	val:        "Integer/toDouble",
 																	ignoreCase: false,
 																	want:       "\"Integer/toDouble\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 267, col: 5, offset: 6814},
+																pos: position{line: 326, col: 5, offset: 8944},
 																run: (*parser).callonVariable168,
 																expr: &litMatcher{
-																	pos:        position{line: 267, col: 5, offset: 6814},
+																	pos:        position{line: 326, col: 5, offset: 8944},
 																	val:        "Integer/show",
 																	ignoreCase: false,
 																	want:       "\"Integer/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 268, col: 5, offset: 6861},
+																pos: position{line: 327, col: 5, offset: 8991},
 																run: (*parser).callonVariable170,
 																expr: &litMatcher{
-																	pos:        position{line: 268, col: 5, offset: 6861},
+																	pos:        position{line: 327, col: 5, offset: 8991},
 																	val:        "Integer/negate",
 																	ignoreCase: false,
 																	want:       "\"Integer/negate\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 269, col: 5, offset: 6912},
+																pos: position{line: 328, col: 5, offset: 9042},
 																run: (*parser).callonVariable172,
 																expr: &litMatcher{
-																	pos:        position{line: 269, col: 5, offset: 6912},
+																	pos:        position{line: 328, col: 5, offset: 9042},
 																	val:        "Integer/clamp",
 																	ignoreCase: false,
 																	want:       "\"Integer/clamp\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 270, col: 5, offset: 6961},
+																pos: position{line: 329, col: 5, offset: 9091},
 																run: (*parser).callonVariable174,
 																expr: &litMatcher{
-																	pos:        position{line: 270, col: 5, offset: 6961},
+																	pos:        position{line: 329, col: 5, offset: 9091},
 																	val:        "Natural/subtract",
 																	ignoreCase: false,
 																	want:       "\"Natural/subtract\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 271, col: 5, offset: 7016},
+																pos: position{line: 330, col: 5, offset: 9146},
 																run: (*parser).callonVariable176,
 																expr: &litMatcher{
-																	pos:        position{line: 271, col: 5, offset: 7016},
+																	pos:        position{line: 330, col: 5, offset: 9146},
 																	val:        "Double/show",
 																	ignoreCase: false,
 																	want:       "\"Double/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 272, col: 5, offset: 7061},
+																pos: position{line: 331, col: 5, offset: 9191},
 																run: (*parser).callonVariable178,
 																expr: &litMatcher{
-																	pos:        position{line: 272, col: 5, offset: 7061},
+																	pos:        position{line: 331, col: 5, offset: 9191},
 																	val:        "List/build",
 																	ignoreCase: false,
 																	want:       "\"List/build\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 273, col: 5, offset: 7104},
+																pos: position{line: 332, col: 5, offset: 9234},
 																run: (*parser).callonVariable180,
 																expr: &litMatcher{
-																	pos:        position{line: 273, col: 5, offset: 7104},
+																	pos:        position{line: 332, col: 5, offset: 9234},
 																	val:        "List/fold",
 																	ignoreCase: false,
 																	want:       "\"List/fold\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 274, col: 5, offset: 7145},
+																pos: position{line: 333, col: 5, offset: 9275},
 																run: (*parser).callonVariable182,
 																expr: &litMatcher{
-																	pos:        position{line: 274, col: 5, offset: 7145},
+																	pos:        position{line: 333, col: 5, offset: 9275},
 																	val:        "List/length",
 																	ignoreCase: false,
 																	want:       "\"List/length\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 275, col: 5, offset: 7190},
+																pos: position{line: 334, col: 5, offset: 9320},
 																run: (*parser).callonVariable184,
 																expr: &litMatcher{
-																	pos:        position{line: 275, col: 5, offset: 7190},
+																	pos:        position{line: 334, col: 5, offset: 9320},
 																	val:        "List/head",
 																	ignoreCase: false,
 																	want:       "\"List/head\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 276, col: 5, offset: 7231},
+																pos: position{line: 335, col: 5, offset: 9361},
 																run: (*parser).callonVariable186,
 																expr: &litMatcher{
-																	pos:        position{line: 276, col: 5, offset: 7231},
+																	pos:        position{line: 335, col: 5, offset: 9361},
 																	val:        "List/last",
 																	ignoreCase: false,
 																	want:       "\"List/last\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 277, col: 5, offset: 7272},
+																pos: position{line: 336, col: 5, offset: 9402},
 																run: (*parser).callonVariable188,
 																expr: &litMatcher{
-																	pos:        position{line: 277, col: 5, offset: 7272},
+																	pos:        position{line: 336, col: 5, offset: 9402},
 																	val:        "List/indexed",
 																	ignoreCase: false,
 																	want:       "\"List/indexed\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 278, col: 5, offset: 7319},
+																pos: position{line: 337, col: 5, offset: 9449},
 																run: (*parser).callonVariable190,
 																expr: &litMatcher{
-																	pos:        position{line: 278, col: 5, offset: 7319},
+																	pos:        position{line: 337, col: 5, offset: 9449},
 																	val:        "List/reverse",
 																	ignoreCase: false,
 																	want:       "\"List/reverse\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 279, col: 5, offset: 7366},
+																pos: position{line: 338, col: 5, offset: 9496},
 																run: (*parser).callonVariable192,
 																expr: &litMatcher{
-																	pos:        position{line: 279, col: 5, offset: 7366},
+																	pos:        position{line: 338, col: 5, offset: 9496},
 																	val:        "Text/show",
 																	ignoreCase: false,
 																	want:       "\"Text/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 280, col: 5, offset: 7407},
+																pos: position{line: 339, col: 5, offset: 9537},
 																run: (*parser).callonVariable194,
 																expr: &litMatcher{
-																	pos:        position{line: 280, col: 5, offset: 7407},
+																	pos:        position{line: 339, col: 5, offset: 9537},
 																	val:        "Text/replace",
 																	ignoreCase: false,
 																	want:       "\"Text/replace\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 281, col: 5, offset: 7454},
+																pos: position{line: 340, col: 5, offset: 9584},
 																run: (*parser).callonVariable196,
 																expr: &litMatcher{
-																	pos:        position{line: 281, col: 5, offset: 7454},
+																	pos:        position{line: 340, col: 5, offset: 9584},
 																	val:        "Bool",
 																	ignoreCase: false,
 																	want:       "\"Bool\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 282, col: 5, offset: 7486},
+																pos: position{line: 341, col: 5, offset: 9616},
 																run: (*parser).callonVariable198,
 																expr: &litMatcher{
-																	pos:        position{line: 282, col: 5, offset: 7486},
+																	pos:        position{line: 341, col: 5, offset: 9616},
 																	val:        "True",
 																	ignoreCase: false,
 																	want:       "\"True\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 283, col: 5, offset: 7518},
+																pos: position{line: 342, col: 5, offset: 9648},
 																run: (*parser).callonVariable200,
 																expr: &litMatcher{
-																	pos:        position{line: 283, col: 5, offset: 7518},
+																	pos:        position{line: 342, col: 5, offset: 9648},
 																	val:        "False",
 																	ignoreCase: false,
 																	want:       "\"False\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 284, col: 5, offset: 7552},
+																pos: position{line: 343, col: 5, offset: 9682},
 																run: (*parser).callonVariable202,
 																expr: &litMatcher{
-																	pos:        position{line: 284, col: 5, offset: 7552},
+																	pos:        position{line: 343, col: 5, offset: 9682},
 																	val:        "Optional",
 																	ignoreCase: false,
 																	want:       "\"Optional\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 285, col: 5, offset: 7592},
+																pos: position{line: 344, col: 5, offset: 9722},
 																run: (*parser).callonVariable204,
 																expr: &litMatcher{
-																	pos:        position{line: 285, col: 5, offset: 7592},
+																	pos:        position{line: 344, col: 5, offset: 9722},
 																	val:        "None",
 																	ignoreCase: false,
 																	want:       "\"None\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 286, col: 5, offset: 7624},
+																pos: position{line: 345, col: 5, offset: 9754},
 																run: (*parser).callonVariable206,
 																expr: &litMatcher{
-																	pos:        position{line: 286, col: 5, offset: 7624},
+																	pos:        position{line: 345, col: 5, offset: 9754},
 																	val:        "Natural",
 																	ignoreCase: false,
 																	want:       "\"Natural\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 287, col: 5, offset: 7662},
+																pos: position{line: 346, col: 5, offset: 9792},
 																run: (*parser).callonVariable208,
 																expr: &litMatcher{
-																	pos:        position{line: 287, col: 5, offset: 7662},
+																	pos:        position{line: 346, col: 5, offset: 9792},
 																	val:        "Integer",
 																	ignoreCase: false,
 																	want:       "\"Integer\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 288, col: 5, offset: 7700},
+																pos: position{line: 347, col: 5, offset: 9830},
 																run: (*parser).callonVariable210,
 																expr: &litMatcher{
-																	pos:        position{line: 288, col: 5, offset: 7700},
+																	pos:        position{line: 347, col: 5, offset: 9830},
 																	val:        "Double",
 																	ignoreCase: false,
 																	want:       "\"Double\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 289, col: 5, offset: 7736},
+																pos: position{line: 348, col: 5, offset: 9866},
 																run: (*parser).callonVariable212,
 																expr: &litMatcher{
-																	pos:        position{line: 289, col: 5, offset: 7736},
+																	pos:        position{line: 348, col: 5, offset: 9866},
 																	val:        "Text",
 																	ignoreCase: false,
 																	want:       "\"Text\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 290, col: 5, offset: 7768},
+																pos: position{line: 349, col: 5, offset: 9898},
 																run: (*parser).callonVariable214,
 																expr: &litMatcher{
-																	pos:        position{line: 290, col: 5, offset: 7768},
+																	pos:        position{line: 349, col: 5, offset: 9898},
 																	val:        "List",
 																	ignoreCase: false,
 																	want:       "\"List\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 291, col: 5, offset: 7800},
+																pos: position{line: 350, col: 5, offset: 9930},
 																run: (*parser).callonVariable216,
 																expr: &litMatcher{
-																	pos:        position{line: 291, col: 5, offset: 7800},
+																	pos:        position{line: 350, col: 5, offset: 9930},
 																	val:        "Type",
 																	ignoreCase: false,
 																	want:       "\"Type\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 292, col: 5, offset: 7832},
+																pos: position{line: 351, col: 5, offset: 9962},
 																run: (*parser).callonVariable218,
 																expr: &litMatcher{
-																	pos:        position{line: 292, col: 5, offset: 7832},
+																	pos:        position{line: 351, col: 5, offset: 9962},
 																	val:        "Kind",
 																	ignoreCase: false,
 																	want:       "\"Kind\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 293, col: 5, offset: 7864},
+																pos: position{line: 352, col: 5, offset: 9994},
 																run: (*parser).callonVariable220,
 																expr: &litMatcher{
-																	pos:        position{line: 293, col: 5, offset: 7864},
+																	pos:        position{line: 352, col: 5, offset: 9994},
 																	val:        "Sort",
 																	ignoreCase: false,
 																	want:       "\"Sort\"",
@@ -2384,33 +2432,33 @@ var g = &grammar{
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 135, col: 28, offset: 3329},
+													pos:   position{line: 194, col: 28, offset: 5459},
 													label: "label",
 													expr: &choiceExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																run: (*parser).callonVariable224,
 																expr: &seqExpr{
-																	pos: position{line: 131, col: 9, offset: 3119},
+																	pos: position{line: 190, col: 9, offset: 5249},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 131, col: 9, offset: 3119},
+																			pos:        position{line: 190, col: 9, offset: 5249},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
-																			pos:   position{line: 131, col: 13, offset: 3123},
+																			pos:   position{line: 190, col: 13, offset: 5253},
 																			label: "label",
 																			expr: &actionExpr{
-																				pos: position{line: 129, col: 15, offset: 3060},
+																				pos: position{line: 188, col: 15, offset: 5190},
 																				run: (*parser).callonVariable228,
 																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 129, col: 15, offset: 3060},
+																					pos: position{line: 188, col: 15, offset: 5190},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 128, col: 19, offset: 3023},
+																						pos:        position{line: 187, col: 19, offset: 5153},
 																						val:        "[ -_a-~]",
 																						ranges:     []rune{' ', '_', 'a', '~'},
 																						ignoreCase: false,
@@ -2420,7 +2468,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 131, col: 31, offset: 3141},
+																			pos:        position{line: 190, col: 31, offset: 5271},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
@@ -2429,75 +2477,75 @@ var g = &grammar{
 																},
 															},
 															&actionExpr{
-																pos: position{line: 132, col: 9, offset: 3175},
+																pos: position{line: 191, col: 9, offset: 5305},
 																run: (*parser).callonVariable232,
 																expr: &labeledExpr{
-																	pos:   position{line: 132, col: 9, offset: 3175},
+																	pos:   position{line: 191, col: 9, offset: 5305},
 																	label: "label",
 																	expr: &choiceExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				run: (*parser).callonVariable235,
 																				expr: &seqExpr{
-																					pos: position{line: 122, col: 15, offset: 2816},
+																					pos: position{line: 181, col: 15, offset: 4946},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 249, col: 5, offset: 6269},
+																							pos: position{line: 308, col: 5, offset: 8399},
 																							alternatives: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 231, col: 6, offset: 5936},
+																									pos:        position{line: 290, col: 6, offset: 8066},
 																									val:        "if",
 																									ignoreCase: false,
 																									want:       "\"if\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 232, col: 8, offset: 5950},
+																									pos:        position{line: 291, col: 8, offset: 8080},
 																									val:        "then",
 																									ignoreCase: false,
 																									want:       "\"then\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 233, col: 8, offset: 5966},
+																									pos:        position{line: 292, col: 8, offset: 8096},
 																									val:        "else",
 																									ignoreCase: false,
 																									want:       "\"else\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 234, col: 7, offset: 5981},
+																									pos:        position{line: 293, col: 7, offset: 8111},
 																									val:        "let",
 																									ignoreCase: false,
 																									want:       "\"let\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 235, col: 6, offset: 5994},
+																									pos:        position{line: 294, col: 6, offset: 8124},
 																									val:        "in",
 																									ignoreCase: false,
 																									want:       "\"in\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 237, col: 9, offset: 6021},
+																									pos:        position{line: 296, col: 9, offset: 8151},
 																									val:        "using",
 																									ignoreCase: false,
 																									want:       "\"using\"",
 																								},
 																								&actionExpr{
-																									pos: position{line: 239, col: 11, offset: 6059},
+																									pos: position{line: 298, col: 11, offset: 8189},
 																									run: (*parser).callonVariable244,
 																									expr: &seqExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										exprs: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 239, col: 11, offset: 6059},
+																												pos:        position{line: 298, col: 11, offset: 8189},
 																												val:        "missing",
 																												ignoreCase: false,
 																												want:       "\"missing\"",
 																											},
 																											&notExpr{
-																												pos: position{line: 239, col: 21, offset: 6069},
+																												pos: position{line: 298, col: 21, offset: 8199},
 																												expr: &charClassMatcher{
-																													pos:        position{line: 121, col: 23, offset: 2785},
+																													pos:        position{line: 180, col: 23, offset: 4915},
 																													val:        "[_/-A-Za-z0-9]",
 																													chars:      []rune{'_', '/', '-'},
 																													ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2509,61 +2557,61 @@ var g = &grammar{
 																									},
 																								},
 																								&litMatcher{
-																									pos:        position{line: 244, col: 10, offset: 6199},
+																									pos:        position{line: 303, col: 10, offset: 8329},
 																									val:        "assert",
 																									ignoreCase: false,
 																									want:       "\"assert\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 236, col: 6, offset: 6006},
+																									pos:        position{line: 295, col: 6, offset: 8136},
 																									val:        "as",
 																									ignoreCase: false,
 																									want:       "\"as\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 240, col: 12, offset: 6129},
+																									pos:        position{line: 299, col: 12, offset: 8259},
 																									val:        "Infinity",
 																									ignoreCase: false,
 																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 241, col: 7, offset: 6148},
+																									pos:        position{line: 300, col: 7, offset: 8278},
 																									val:        "NaN",
 																									ignoreCase: false,
 																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 238, col: 9, offset: 6039},
+																									pos:        position{line: 297, col: 9, offset: 8169},
 																									val:        "merge",
 																									ignoreCase: false,
 																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 242, col: 8, offset: 6163},
+																									pos:        position{line: 301, col: 8, offset: 8293},
 																									val:        "Some",
 																									ignoreCase: false,
 																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 243, col: 9, offset: 6180},
+																									pos:        position{line: 302, col: 9, offset: 8310},
 																									val:        "toMap",
 																									ignoreCase: false,
 																									want:       "\"toMap\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 10, offset: 6219},
+																									pos:        position{line: 304, col: 10, offset: 8349},
 																									val:        "forall",
 																									ignoreCase: false,
 																									want:       "\"forall\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 21, offset: 6230},
+																									pos:        position{line: 304, col: 21, offset: 8360},
 																									val:        "∀",
 																									ignoreCase: false,
 																									want:       "\"∀\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 246, col: 8, offset: 6245},
+																									pos:        position{line: 305, col: 8, offset: 8375},
 																									val:        "with",
 																									ignoreCase: false,
 																									want:       "\"with\"",
@@ -2571,9 +2619,9 @@ var g = &grammar{
 																							},
 																						},
 																						&oneOrMoreExpr{
-																							pos: position{line: 122, col: 23, offset: 2824},
+																							pos: position{line: 181, col: 23, offset: 4954},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2585,68 +2633,68 @@ var g = &grammar{
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				run: (*parser).callonVariable261,
 																				expr: &seqExpr{
-																					pos: position{line: 123, col: 13, offset: 2888},
+																					pos: position{line: 182, col: 13, offset: 5018},
 																					exprs: []interface{}{
 																						&notExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							expr: &choiceExpr{
-																								pos: position{line: 249, col: 5, offset: 6269},
+																								pos: position{line: 308, col: 5, offset: 8399},
 																								alternatives: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 231, col: 6, offset: 5936},
+																										pos:        position{line: 290, col: 6, offset: 8066},
 																										val:        "if",
 																										ignoreCase: false,
 																										want:       "\"if\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 232, col: 8, offset: 5950},
+																										pos:        position{line: 291, col: 8, offset: 8080},
 																										val:        "then",
 																										ignoreCase: false,
 																										want:       "\"then\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 233, col: 8, offset: 5966},
+																										pos:        position{line: 292, col: 8, offset: 8096},
 																										val:        "else",
 																										ignoreCase: false,
 																										want:       "\"else\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 234, col: 7, offset: 5981},
+																										pos:        position{line: 293, col: 7, offset: 8111},
 																										val:        "let",
 																										ignoreCase: false,
 																										want:       "\"let\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 235, col: 6, offset: 5994},
+																										pos:        position{line: 294, col: 6, offset: 8124},
 																										val:        "in",
 																										ignoreCase: false,
 																										want:       "\"in\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 237, col: 9, offset: 6021},
+																										pos:        position{line: 296, col: 9, offset: 8151},
 																										val:        "using",
 																										ignoreCase: false,
 																										want:       "\"using\"",
 																									},
 																									&actionExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										run: (*parser).callonVariable271,
 																										expr: &seqExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											exprs: []interface{}{
 																												&litMatcher{
-																													pos:        position{line: 239, col: 11, offset: 6059},
+																													pos:        position{line: 298, col: 11, offset: 8189},
 																													val:        "missing",
 																													ignoreCase: false,
 																													want:       "\"missing\"",
 																												},
 																												&notExpr{
-																													pos: position{line: 239, col: 21, offset: 6069},
+																													pos: position{line: 298, col: 21, offset: 8199},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 121, col: 23, offset: 2785},
+																														pos:        position{line: 180, col: 23, offset: 4915},
 																														val:        "[_/-A-Za-z0-9]",
 																														chars:      []rune{'_', '/', '-'},
 																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2658,61 +2706,61 @@ var g = &grammar{
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 244, col: 10, offset: 6199},
+																										pos:        position{line: 303, col: 10, offset: 8329},
 																										val:        "assert",
 																										ignoreCase: false,
 																										want:       "\"assert\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 236, col: 6, offset: 6006},
+																										pos:        position{line: 295, col: 6, offset: 8136},
 																										val:        "as",
 																										ignoreCase: false,
 																										want:       "\"as\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 240, col: 12, offset: 6129},
+																										pos:        position{line: 299, col: 12, offset: 8259},
 																										val:        "Infinity",
 																										ignoreCase: false,
 																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 241, col: 7, offset: 6148},
+																										pos:        position{line: 300, col: 7, offset: 8278},
 																										val:        "NaN",
 																										ignoreCase: false,
 																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 238, col: 9, offset: 6039},
+																										pos:        position{line: 297, col: 9, offset: 8169},
 																										val:        "merge",
 																										ignoreCase: false,
 																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 242, col: 8, offset: 6163},
+																										pos:        position{line: 301, col: 8, offset: 8293},
 																										val:        "Some",
 																										ignoreCase: false,
 																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 243, col: 9, offset: 6180},
+																										pos:        position{line: 302, col: 9, offset: 8310},
 																										val:        "toMap",
 																										ignoreCase: false,
 																										want:       "\"toMap\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 10, offset: 6219},
+																										pos:        position{line: 304, col: 10, offset: 8349},
 																										val:        "forall",
 																										ignoreCase: false,
 																										want:       "\"forall\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 21, offset: 6230},
+																										pos:        position{line: 304, col: 21, offset: 8360},
 																										val:        "∀",
 																										ignoreCase: false,
 																										want:       "\"∀\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 246, col: 8, offset: 6245},
+																										pos:        position{line: 305, col: 8, offset: 8375},
 																										val:        "with",
 																										ignoreCase: false,
 																										want:       "\"with\"",
@@ -2721,7 +2769,7 @@ var g = &grammar{
 																							},
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 120, col: 24, offset: 2751},
+																							pos:        position{line: 179, col: 24, offset: 4881},
 																							val:        "[_A-Za-z]",
 																							chars:      []rune{'_'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -2729,9 +2777,9 @@ var g = &grammar{
 																							inverted:   false,
 																						},
 																						&zeroOrMoreExpr{
-																							pos: position{line: 123, col: 43, offset: 2918},
+																							pos: position{line: 182, col: 43, offset: 5048},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2756,12 +2804,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 339, col: 34, offset: 9212},
+							pos:   position{line: 398, col: 34, offset: 11342},
 							label: "index",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 339, col: 40, offset: 9218},
+								pos: position{line: 398, col: 40, offset: 11348},
 								expr: &ruleRefExpr{
-									pos:  position{line: 339, col: 40, offset: 9218},
+									pos:  position{line: 398, col: 40, offset: 11348},
 									name: "DeBruijn",
 								},
 							},
@@ -2772,55 +2820,55 @@ var g = &grammar{
 		},
 		{
 			name: "Http",
-			pos:  position{line: 423, col: 1, offset: 11410},
+			pos:  position{line: 482, col: 1, offset: 13540},
 			expr: &actionExpr{
-				pos: position{line: 423, col: 8, offset: 11419},
+				pos: position{line: 482, col: 8, offset: 13549},
 				run: (*parser).callonHttp1,
 				expr: &seqExpr{
-					pos: position{line: 423, col: 8, offset: 11419},
+					pos: position{line: 482, col: 8, offset: 13549},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 423, col: 8, offset: 11419},
+							pos:   position{line: 482, col: 8, offset: 13549},
 							label: "u",
 							expr: &actionExpr{
-								pos: position{line: 389, col: 11, offset: 10609},
+								pos: position{line: 448, col: 11, offset: 12739},
 								run: (*parser).callonHttp4,
 								expr: &seqExpr{
-									pos: position{line: 389, col: 11, offset: 10609},
+									pos: position{line: 448, col: 11, offset: 12739},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 387, col: 10, offset: 10584},
+											pos:        position{line: 446, col: 10, offset: 12714},
 											val:        "http",
 											ignoreCase: false,
 											want:       "\"http\"",
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 387, col: 17, offset: 10591},
+											pos: position{line: 446, col: 17, offset: 12721},
 											expr: &litMatcher{
-												pos:        position{line: 387, col: 17, offset: 10591},
+												pos:        position{line: 446, col: 17, offset: 12721},
 												val:        "s",
 												ignoreCase: false,
 												want:       "\"s\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 389, col: 18, offset: 10616},
+											pos:        position{line: 448, col: 18, offset: 12746},
 											val:        "://",
 											ignoreCase: false,
 											want:       "\"://\"",
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 393, col: 13, offset: 10753},
+											pos: position{line: 452, col: 13, offset: 12883},
 											expr: &seqExpr{
-												pos: position{line: 393, col: 14, offset: 10754},
+												pos: position{line: 452, col: 14, offset: 12884},
 												exprs: []interface{}{
 													&zeroOrMoreExpr{
-														pos: position{line: 395, col: 12, offset: 10800},
+														pos: position{line: 454, col: 12, offset: 12930},
 														expr: &choiceExpr{
-															pos: position{line: 395, col: 14, offset: 10802},
+															pos: position{line: 454, col: 14, offset: 12932},
 															alternatives: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 419, col: 14, offset: 11332},
+																	pos:        position{line: 478, col: 14, offset: 13462},
 																	val:        "[._~-A-Za-z0-9]",
 																	chars:      []rune{'.', '_', '~', '-'},
 																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2828,26 +2876,26 @@ var g = &grammar{
 																	inverted:   false,
 																},
 																&seqExpr{
-																	pos: position{line: 417, col: 14, offset: 11298},
+																	pos: position{line: 476, col: 14, offset: 13428},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 417, col: 14, offset: 11298},
+																			pos:        position{line: 476, col: 14, offset: 13428},
 																			val:        "%",
 																			ignoreCase: false,
 																			want:       "\"%\"",
 																		},
 																		&choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -2856,17 +2904,17 @@ var g = &grammar{
 																			},
 																		},
 																		&choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -2877,7 +2925,7 @@ var g = &grammar{
 																	},
 																},
 																&charClassMatcher{
-																	pos:        position{line: 421, col: 13, offset: 11363},
+																	pos:        position{line: 480, col: 13, offset: 13493},
 																	val:        "[!$&\\*+;=:]",
 																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':'},
 																	ignoreCase: false,
@@ -2887,7 +2935,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 393, col: 23, offset: 10763},
+														pos:        position{line: 452, col: 23, offset: 12893},
 														val:        "@",
 														ignoreCase: false,
 														want:       "\"@\"",
@@ -2896,37 +2944,37 @@ var g = &grammar{
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 397, col: 8, offset: 10857},
+											pos: position{line: 456, col: 8, offset: 12987},
 											alternatives: []interface{}{
 												&seqExpr{
-													pos: position{line: 401, col: 13, offset: 10909},
+													pos: position{line: 460, col: 13, offset: 13039},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 401, col: 13, offset: 10909},
+															pos:        position{line: 460, col: 13, offset: 13039},
 															val:        "[",
 															ignoreCase: false,
 															want:       "\"[\"",
 														},
 														&actionExpr{
-															pos: position{line: 403, col: 15, offset: 10946},
+															pos: position{line: 462, col: 15, offset: 13076},
 															run: (*parser).callonHttp28,
 															expr: &seqExpr{
-																pos: position{line: 403, col: 15, offset: 10946},
+																pos: position{line: 462, col: 15, offset: 13076},
 																exprs: []interface{}{
 																	&zeroOrMoreExpr{
-																		pos: position{line: 403, col: 15, offset: 10946},
+																		pos: position{line: 462, col: 15, offset: 13076},
 																		expr: &choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -2936,32 +2984,32 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 403, col: 25, offset: 10956},
+																		pos:        position{line: 462, col: 25, offset: 13086},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&zeroOrMoreExpr{
-																		pos: position{line: 403, col: 29, offset: 10960},
+																		pos: position{line: 462, col: 29, offset: 13090},
 																		expr: &choiceExpr{
-																			pos: position{line: 403, col: 30, offset: 10961},
+																			pos: position{line: 462, col: 30, offset: 13091},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 403, col: 39, offset: 10970},
+																					pos:        position{line: 462, col: 39, offset: 13100},
 																					val:        "[:.]",
 																					chars:      []rune{':', '.'},
 																					ignoreCase: false,
@@ -2974,7 +3022,7 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 401, col: 29, offset: 10925},
+															pos:        position{line: 460, col: 29, offset: 13055},
 															val:        "]",
 															ignoreCase: false,
 															want:       "\"]\"",
@@ -2982,12 +3030,12 @@ var g = &grammar{
 													},
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 409, col: 11, offset: 11142},
+													pos: position{line: 468, col: 11, offset: 13272},
 													expr: &choiceExpr{
-														pos: position{line: 409, col: 12, offset: 11143},
+														pos: position{line: 468, col: 12, offset: 13273},
 														alternatives: []interface{}{
 															&charClassMatcher{
-																pos:        position{line: 419, col: 14, offset: 11332},
+																pos:        position{line: 478, col: 14, offset: 13462},
 																val:        "[._~-A-Za-z0-9]",
 																chars:      []rune{'.', '_', '~', '-'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2995,26 +3043,26 @@ var g = &grammar{
 																inverted:   false,
 															},
 															&seqExpr{
-																pos: position{line: 417, col: 14, offset: 11298},
+																pos: position{line: 476, col: 14, offset: 13428},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 417, col: 14, offset: 11298},
+																		pos:        position{line: 476, col: 14, offset: 13428},
 																		val:        "%",
 																		ignoreCase: false,
 																		want:       "\"%\"",
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -3023,17 +3071,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -3044,7 +3092,7 @@ var g = &grammar{
 																},
 															},
 															&charClassMatcher{
-																pos:        position{line: 421, col: 13, offset: 11363},
+																pos:        position{line: 480, col: 13, offset: 13493},
 																val:        "[!$&\\*+;=]",
 																chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '='},
 																ignoreCase: false,
@@ -3056,20 +3104,20 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 393, col: 34, offset: 10774},
+											pos: position{line: 452, col: 34, offset: 12904},
 											expr: &seqExpr{
-												pos: position{line: 393, col: 35, offset: 10775},
+												pos: position{line: 452, col: 35, offset: 12905},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 393, col: 35, offset: 10775},
+														pos:        position{line: 452, col: 35, offset: 12905},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 399, col: 8, offset: 10887},
+														pos: position{line: 458, col: 8, offset: 13017},
 														expr: &charClassMatcher{
-															pos:        position{line: 116, col: 9, offset: 2692},
+															pos:        position{line: 175, col: 9, offset: 4822},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -3080,23 +3128,23 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 391, col: 15, offset: 10723},
+											pos: position{line: 450, col: 15, offset: 12853},
 											expr: &seqExpr{
-												pos: position{line: 391, col: 16, offset: 10724},
+												pos: position{line: 450, col: 16, offset: 12854},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 391, col: 16, offset: 10724},
+														pos:        position{line: 450, col: 16, offset: 12854},
 														val:        "/",
 														ignoreCase: false,
 														want:       "\"/\"",
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 411, col: 11, offset: 11194},
+														pos: position{line: 470, col: 11, offset: 13324},
 														expr: &choiceExpr{
-															pos: position{line: 413, col: 9, offset: 11212},
+															pos: position{line: 472, col: 9, offset: 13342},
 															alternatives: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 419, col: 14, offset: 11332},
+																	pos:        position{line: 478, col: 14, offset: 13462},
 																	val:        "[._~-A-Za-z0-9]",
 																	chars:      []rune{'.', '_', '~', '-'},
 																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -3104,26 +3152,26 @@ var g = &grammar{
 																	inverted:   false,
 																},
 																&seqExpr{
-																	pos: position{line: 417, col: 14, offset: 11298},
+																	pos: position{line: 476, col: 14, offset: 13428},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 417, col: 14, offset: 11298},
+																			pos:        position{line: 476, col: 14, offset: 13428},
 																			val:        "%",
 																			ignoreCase: false,
 																			want:       "\"%\"",
 																		},
 																		&choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -3132,17 +3180,17 @@ var g = &grammar{
 																			},
 																		},
 																		&choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -3153,7 +3201,7 @@ var g = &grammar{
 																	},
 																},
 																&charClassMatcher{
-																	pos:        position{line: 421, col: 13, offset: 11363},
+																	pos:        position{line: 480, col: 13, offset: 13493},
 																	val:        "[!$&\\*+;=:@]",
 																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@'},
 																	ignoreCase: false,
@@ -3166,23 +3214,23 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 389, col: 46, offset: 10644},
+											pos: position{line: 448, col: 46, offset: 12774},
 											expr: &seqExpr{
-												pos: position{line: 389, col: 48, offset: 10646},
+												pos: position{line: 448, col: 48, offset: 12776},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 389, col: 48, offset: 10646},
+														pos:        position{line: 448, col: 48, offset: 12776},
 														val:        "?",
 														ignoreCase: false,
 														want:       "\"?\"",
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 415, col: 9, offset: 11266},
+														pos: position{line: 474, col: 9, offset: 13396},
 														expr: &choiceExpr{
-															pos: position{line: 415, col: 10, offset: 11267},
+															pos: position{line: 474, col: 10, offset: 13397},
 															alternatives: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 419, col: 14, offset: 11332},
+																	pos:        position{line: 478, col: 14, offset: 13462},
 																	val:        "[._~-A-Za-z0-9]",
 																	chars:      []rune{'.', '_', '~', '-'},
 																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -3190,26 +3238,26 @@ var g = &grammar{
 																	inverted:   false,
 																},
 																&seqExpr{
-																	pos: position{line: 417, col: 14, offset: 11298},
+																	pos: position{line: 476, col: 14, offset: 13428},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 417, col: 14, offset: 11298},
+																			pos:        position{line: 476, col: 14, offset: 13428},
 																			val:        "%",
 																			ignoreCase: false,
 																			want:       "\"%\"",
 																		},
 																		&choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -3218,17 +3266,17 @@ var g = &grammar{
 																			},
 																		},
 																		&choiceExpr{
-																			pos: position{line: 118, col: 10, offset: 2710},
+																			pos: position{line: 177, col: 10, offset: 4840},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 116, col: 9, offset: 2692},
+																					pos:        position{line: 175, col: 9, offset: 4822},
 																					val:        "[0-9]",
 																					ranges:     []rune{'0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 118, col: 18, offset: 2718},
+																					pos:        position{line: 177, col: 18, offset: 4848},
 																					val:        "[a-f]i",
 																					ranges:     []rune{'a', 'f'},
 																					ignoreCase: true,
@@ -3239,7 +3287,7 @@ var g = &grammar{
 																	},
 																},
 																&charClassMatcher{
-																	pos:        position{line: 421, col: 13, offset: 11363},
+																	pos:        position{line: 480, col: 13, offset: 13493},
 																	val:        "[!$&\\*+;=:@/?]",
 																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@', '/', '?'},
 																	ignoreCase: false,
@@ -3256,29 +3304,29 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 423, col: 18, offset: 11429},
+							pos:   position{line: 482, col: 18, offset: 13559},
 							label: "usingClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 423, col: 30, offset: 11441},
+								pos: position{line: 482, col: 30, offset: 13571},
 								expr: &seqExpr{
-									pos: position{line: 423, col: 32, offset: 11443},
+									pos: position{line: 482, col: 32, offset: 13573},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 423, col: 32, offset: 11443},
+											pos:  position{line: 482, col: 32, offset: 13573},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 237, col: 9, offset: 6021},
+											pos:        position{line: 296, col: 9, offset: 8151},
 											val:        "using",
 											ignoreCase: false,
 											want:       "\"using\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 423, col: 40, offset: 11451},
+											pos:  position{line: 482, col: 40, offset: 13581},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 423, col: 43, offset: 11454},
+											pos:  position{line: 482, col: 43, offset: 13584},
 											name: "ImportExpression",
 										},
 									},
@@ -3291,26 +3339,26 @@ var g = &grammar{
 		},
 		{
 			name: "ImportType",
-			pos:  position{line: 464, col: 1, offset: 12650},
+			pos:  position{line: 523, col: 1, offset: 14780},
 			expr: &choiceExpr{
-				pos: position{line: 464, col: 14, offset: 12665},
+				pos: position{line: 523, col: 14, offset: 14795},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 239, col: 11, offset: 6059},
+						pos: position{line: 298, col: 11, offset: 8189},
 						run: (*parser).callonImportType2,
 						expr: &seqExpr{
-							pos: position{line: 239, col: 11, offset: 6059},
+							pos: position{line: 298, col: 11, offset: 8189},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 239, col: 11, offset: 6059},
+									pos:        position{line: 298, col: 11, offset: 8189},
 									val:        "missing",
 									ignoreCase: false,
 									want:       "\"missing\"",
 								},
 								&notExpr{
-									pos: position{line: 239, col: 21, offset: 6069},
+									pos: position{line: 298, col: 21, offset: 8199},
 									expr: &charClassMatcher{
-										pos:        position{line: 121, col: 23, offset: 2785},
+										pos:        position{line: 180, col: 23, offset: 4915},
 										val:        "[_/-A-Za-z0-9]",
 										chars:      []rune{'_', '/', '-'},
 										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -3322,53 +3370,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 382, col: 14, offset: 10287},
+						pos: position{line: 441, col: 14, offset: 12417},
 						run: (*parser).callonImportType7,
 						expr: &seqExpr{
-							pos: position{line: 382, col: 14, offset: 10287},
+							pos: position{line: 441, col: 14, offset: 12417},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 382, col: 14, offset: 10287},
+									pos:        position{line: 441, col: 14, offset: 12417},
 									val:        "..",
 									ignoreCase: false,
 									want:       "\"..\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 382, col: 19, offset: 10292},
+									pos:   position{line: 441, col: 19, offset: 12422},
 									label: "p",
 									expr: &actionExpr{
-										pos: position{line: 371, col: 8, offset: 9936},
+										pos: position{line: 430, col: 8, offset: 12066},
 										run: (*parser).callonImportType11,
 										expr: &labeledExpr{
-											pos:   position{line: 371, col: 8, offset: 9936},
+											pos:   position{line: 430, col: 8, offset: 12066},
 											label: "cs",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 371, col: 11, offset: 9939},
+												pos: position{line: 430, col: 11, offset: 12069},
 												expr: &choiceExpr{
-													pos: position{line: 368, col: 17, offset: 9812},
+													pos: position{line: 427, col: 17, offset: 11942},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 368, col: 17, offset: 9812},
+															pos: position{line: 427, col: 17, offset: 11942},
 															run: (*parser).callonImportType15,
 															expr: &seqExpr{
-																pos: position{line: 368, col: 17, offset: 9812},
+																pos: position{line: 427, col: 17, offset: 11942},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 368, col: 17, offset: 9812},
+																		pos:        position{line: 427, col: 17, offset: 11942},
 																		val:        "/",
 																		ignoreCase: false,
 																		want:       "\"/\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 368, col: 21, offset: 9816},
+																		pos:   position{line: 427, col: 21, offset: 11946},
 																		label: "u",
 																		expr: &actionExpr{
-																			pos: position{line: 365, col: 25, offset: 9671},
+																			pos: position{line: 424, col: 25, offset: 11801},
 																			run: (*parser).callonImportType19,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 365, col: 25, offset: 9671},
+																				pos: position{line: 424, col: 25, offset: 11801},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9416},
+																					pos:        position{line: 408, col: 6, offset: 11546},
 																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																					chars:      []rune{'!', '=', '|', '~'},
 																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3382,27 +3430,27 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 369, col: 17, offset: 9874},
+															pos: position{line: 428, col: 17, offset: 12004},
 															run: (*parser).callonImportType22,
 															expr: &seqExpr{
-																pos: position{line: 369, col: 17, offset: 9874},
+																pos: position{line: 428, col: 17, offset: 12004},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 369, col: 17, offset: 9874},
+																		pos:        position{line: 428, col: 17, offset: 12004},
 																		val:        "/\"",
 																		ignoreCase: false,
 																		want:       "\"/\\\"\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 369, col: 25, offset: 9882},
+																		pos:   position{line: 428, col: 25, offset: 12012},
 																		label: "q",
 																		expr: &actionExpr{
-																			pos: position{line: 366, col: 23, offset: 9741},
+																			pos: position{line: 425, col: 23, offset: 11871},
 																			run: (*parser).callonImportType26,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 366, col: 23, offset: 9741},
+																				pos: position{line: 425, col: 23, offset: 11871},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 360, col: 6, offset: 9579},
+																					pos:        position{line: 419, col: 6, offset: 11709},
 																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																					chars:      []rune{'𐀀', 'D'},
 																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -3413,7 +3461,7 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 369, col: 47, offset: 9904},
+																		pos:        position{line: 428, col: 47, offset: 12034},
 																		val:        "\"",
 																		ignoreCase: false,
 																		want:       "\"\\\"\"",
@@ -3431,53 +3479,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 383, col: 12, offset: 10367},
+						pos: position{line: 442, col: 12, offset: 12497},
 						run: (*parser).callonImportType30,
 						expr: &seqExpr{
-							pos: position{line: 383, col: 12, offset: 10367},
+							pos: position{line: 442, col: 12, offset: 12497},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 383, col: 12, offset: 10367},
+									pos:        position{line: 442, col: 12, offset: 12497},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 383, col: 16, offset: 10371},
+									pos:   position{line: 442, col: 16, offset: 12501},
 									label: "p",
 									expr: &actionExpr{
-										pos: position{line: 371, col: 8, offset: 9936},
+										pos: position{line: 430, col: 8, offset: 12066},
 										run: (*parser).callonImportType34,
 										expr: &labeledExpr{
-											pos:   position{line: 371, col: 8, offset: 9936},
+											pos:   position{line: 430, col: 8, offset: 12066},
 											label: "cs",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 371, col: 11, offset: 9939},
+												pos: position{line: 430, col: 11, offset: 12069},
 												expr: &choiceExpr{
-													pos: position{line: 368, col: 17, offset: 9812},
+													pos: position{line: 427, col: 17, offset: 11942},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 368, col: 17, offset: 9812},
+															pos: position{line: 427, col: 17, offset: 11942},
 															run: (*parser).callonImportType38,
 															expr: &seqExpr{
-																pos: position{line: 368, col: 17, offset: 9812},
+																pos: position{line: 427, col: 17, offset: 11942},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 368, col: 17, offset: 9812},
+																		pos:        position{line: 427, col: 17, offset: 11942},
 																		val:        "/",
 																		ignoreCase: false,
 																		want:       "\"/\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 368, col: 21, offset: 9816},
+																		pos:   position{line: 427, col: 21, offset: 11946},
 																		label: "u",
 																		expr: &actionExpr{
-																			pos: position{line: 365, col: 25, offset: 9671},
+																			pos: position{line: 424, col: 25, offset: 11801},
 																			run: (*parser).callonImportType42,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 365, col: 25, offset: 9671},
+																				pos: position{line: 424, col: 25, offset: 11801},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9416},
+																					pos:        position{line: 408, col: 6, offset: 11546},
 																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																					chars:      []rune{'!', '=', '|', '~'},
 																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3491,27 +3539,27 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 369, col: 17, offset: 9874},
+															pos: position{line: 428, col: 17, offset: 12004},
 															run: (*parser).callonImportType45,
 															expr: &seqExpr{
-																pos: position{line: 369, col: 17, offset: 9874},
+																pos: position{line: 428, col: 17, offset: 12004},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 369, col: 17, offset: 9874},
+																		pos:        position{line: 428, col: 17, offset: 12004},
 																		val:        "/\"",
 																		ignoreCase: false,
 																		want:       "\"/\\\"\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 369, col: 25, offset: 9882},
+																		pos:   position{line: 428, col: 25, offset: 12012},
 																		label: "q",
 																		expr: &actionExpr{
-																			pos: position{line: 366, col: 23, offset: 9741},
+																			pos: position{line: 425, col: 23, offset: 11871},
 																			run: (*parser).callonImportType49,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 366, col: 23, offset: 9741},
+																				pos: position{line: 425, col: 23, offset: 11871},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 360, col: 6, offset: 9579},
+																					pos:        position{line: 419, col: 6, offset: 11709},
 																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																					chars:      []rune{'𐀀', 'D'},
 																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -3522,7 +3570,7 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 369, col: 47, offset: 9904},
+																		pos:        position{line: 428, col: 47, offset: 12034},
 																		val:        "\"",
 																		ignoreCase: false,
 																		want:       "\"\\\"\"",
@@ -3540,53 +3588,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 384, col: 12, offset: 10429},
+						pos: position{line: 443, col: 12, offset: 12559},
 						run: (*parser).callonImportType53,
 						expr: &seqExpr{
-							pos: position{line: 384, col: 12, offset: 10429},
+							pos: position{line: 443, col: 12, offset: 12559},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 384, col: 12, offset: 10429},
+									pos:        position{line: 443, col: 12, offset: 12559},
 									val:        "~",
 									ignoreCase: false,
 									want:       "\"~\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 384, col: 16, offset: 10433},
+									pos:   position{line: 443, col: 16, offset: 12563},
 									label: "p",
 									expr: &actionExpr{
-										pos: position{line: 371, col: 8, offset: 9936},
+										pos: position{line: 430, col: 8, offset: 12066},
 										run: (*parser).callonImportType57,
 										expr: &labeledExpr{
-											pos:   position{line: 371, col: 8, offset: 9936},
+											pos:   position{line: 430, col: 8, offset: 12066},
 											label: "cs",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 371, col: 11, offset: 9939},
+												pos: position{line: 430, col: 11, offset: 12069},
 												expr: &choiceExpr{
-													pos: position{line: 368, col: 17, offset: 9812},
+													pos: position{line: 427, col: 17, offset: 11942},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 368, col: 17, offset: 9812},
+															pos: position{line: 427, col: 17, offset: 11942},
 															run: (*parser).callonImportType61,
 															expr: &seqExpr{
-																pos: position{line: 368, col: 17, offset: 9812},
+																pos: position{line: 427, col: 17, offset: 11942},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 368, col: 17, offset: 9812},
+																		pos:        position{line: 427, col: 17, offset: 11942},
 																		val:        "/",
 																		ignoreCase: false,
 																		want:       "\"/\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 368, col: 21, offset: 9816},
+																		pos:   position{line: 427, col: 21, offset: 11946},
 																		label: "u",
 																		expr: &actionExpr{
-																			pos: position{line: 365, col: 25, offset: 9671},
+																			pos: position{line: 424, col: 25, offset: 11801},
 																			run: (*parser).callonImportType65,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 365, col: 25, offset: 9671},
+																				pos: position{line: 424, col: 25, offset: 11801},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9416},
+																					pos:        position{line: 408, col: 6, offset: 11546},
 																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																					chars:      []rune{'!', '=', '|', '~'},
 																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3600,27 +3648,27 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 369, col: 17, offset: 9874},
+															pos: position{line: 428, col: 17, offset: 12004},
 															run: (*parser).callonImportType68,
 															expr: &seqExpr{
-																pos: position{line: 369, col: 17, offset: 9874},
+																pos: position{line: 428, col: 17, offset: 12004},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 369, col: 17, offset: 9874},
+																		pos:        position{line: 428, col: 17, offset: 12004},
 																		val:        "/\"",
 																		ignoreCase: false,
 																		want:       "\"/\\\"\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 369, col: 25, offset: 9882},
+																		pos:   position{line: 428, col: 25, offset: 12012},
 																		label: "q",
 																		expr: &actionExpr{
-																			pos: position{line: 366, col: 23, offset: 9741},
+																			pos: position{line: 425, col: 23, offset: 11871},
 																			run: (*parser).callonImportType72,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 366, col: 23, offset: 9741},
+																				pos: position{line: 425, col: 23, offset: 11871},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 360, col: 6, offset: 9579},
+																					pos:        position{line: 419, col: 6, offset: 11709},
 																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																					chars:      []rune{'𐀀', 'D'},
 																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -3631,7 +3679,7 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 369, col: 47, offset: 9904},
+																		pos:        position{line: 428, col: 47, offset: 12034},
 																		val:        "\"",
 																		ignoreCase: false,
 																		want:       "\"\\\"\"",
@@ -3649,44 +3697,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 385, col: 16, offset: 10511},
+						pos: position{line: 444, col: 16, offset: 12641},
 						run: (*parser).callonImportType76,
 						expr: &labeledExpr{
-							pos:   position{line: 385, col: 16, offset: 10511},
+							pos:   position{line: 444, col: 16, offset: 12641},
 							label: "p",
 							expr: &actionExpr{
-								pos: position{line: 371, col: 8, offset: 9936},
+								pos: position{line: 430, col: 8, offset: 12066},
 								run: (*parser).callonImportType78,
 								expr: &labeledExpr{
-									pos:   position{line: 371, col: 8, offset: 9936},
+									pos:   position{line: 430, col: 8, offset: 12066},
 									label: "cs",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 371, col: 11, offset: 9939},
+										pos: position{line: 430, col: 11, offset: 12069},
 										expr: &choiceExpr{
-											pos: position{line: 368, col: 17, offset: 9812},
+											pos: position{line: 427, col: 17, offset: 11942},
 											alternatives: []interface{}{
 												&actionExpr{
-													pos: position{line: 368, col: 17, offset: 9812},
+													pos: position{line: 427, col: 17, offset: 11942},
 													run: (*parser).callonImportType82,
 													expr: &seqExpr{
-														pos: position{line: 368, col: 17, offset: 9812},
+														pos: position{line: 427, col: 17, offset: 11942},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 368, col: 17, offset: 9812},
+																pos:        position{line: 427, col: 17, offset: 11942},
 																val:        "/",
 																ignoreCase: false,
 																want:       "\"/\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 368, col: 21, offset: 9816},
+																pos:   position{line: 427, col: 21, offset: 11946},
 																label: "u",
 																expr: &actionExpr{
-																	pos: position{line: 365, col: 25, offset: 9671},
+																	pos: position{line: 424, col: 25, offset: 11801},
 																	run: (*parser).callonImportType86,
 																	expr: &oneOrMoreExpr{
-																		pos: position{line: 365, col: 25, offset: 9671},
+																		pos: position{line: 424, col: 25, offset: 11801},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 349, col: 6, offset: 9416},
+																			pos:        position{line: 408, col: 6, offset: 11546},
 																			val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																			chars:      []rune{'!', '=', '|', '~'},
 																			ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3700,27 +3748,27 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 369, col: 17, offset: 9874},
+													pos: position{line: 428, col: 17, offset: 12004},
 													run: (*parser).callonImportType89,
 													expr: &seqExpr{
-														pos: position{line: 369, col: 17, offset: 9874},
+														pos: position{line: 428, col: 17, offset: 12004},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 369, col: 17, offset: 9874},
+																pos:        position{line: 428, col: 17, offset: 12004},
 																val:        "/\"",
 																ignoreCase: false,
 																want:       "\"/\\\"\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 369, col: 25, offset: 9882},
+																pos:   position{line: 428, col: 25, offset: 12012},
 																label: "q",
 																expr: &actionExpr{
-																	pos: position{line: 366, col: 23, offset: 9741},
+																	pos: position{line: 425, col: 23, offset: 11871},
 																	run: (*parser).callonImportType93,
 																	expr: &oneOrMoreExpr{
-																		pos: position{line: 366, col: 23, offset: 9741},
+																		pos: position{line: 425, col: 23, offset: 11871},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 360, col: 6, offset: 9579},
+																			pos:        position{line: 419, col: 6, offset: 11709},
 																			val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																			chars:      []rune{'𐀀', 'D'},
 																			ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
@@ -3731,7 +3779,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 369, col: 47, offset: 9904},
+																pos:        position{line: 428, col: 47, offset: 12034},
 																val:        "\"",
 																ignoreCase: false,
 																want:       "\"\\\"\"",
@@ -3747,35 +3795,35 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 464, col: 32, offset: 12683},
+						pos:  position{line: 523, col: 32, offset: 14813},
 						name: "Http",
 					},
 					&actionExpr{
-						pos: position{line: 430, col: 7, offset: 11662},
+						pos: position{line: 489, col: 7, offset: 13792},
 						run: (*parser).callonImportType98,
 						expr: &seqExpr{
-							pos: position{line: 430, col: 7, offset: 11662},
+							pos: position{line: 489, col: 7, offset: 13792},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 430, col: 7, offset: 11662},
+									pos:        position{line: 489, col: 7, offset: 13792},
 									val:        "env:",
 									ignoreCase: false,
 									want:       "\"env:\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 430, col: 14, offset: 11669},
+									pos:   position{line: 489, col: 14, offset: 13799},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 430, col: 17, offset: 11672},
+										pos: position{line: 489, col: 17, offset: 13802},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 432, col: 27, offset: 11771},
+												pos: position{line: 491, col: 27, offset: 13901},
 												run: (*parser).callonImportType103,
 												expr: &seqExpr{
-													pos: position{line: 432, col: 27, offset: 11771},
+													pos: position{line: 491, col: 27, offset: 13901},
 													exprs: []interface{}{
 														&charClassMatcher{
-															pos:        position{line: 432, col: 27, offset: 11771},
+															pos:        position{line: 491, col: 27, offset: 13901},
 															val:        "[_A-Za-z]",
 															chars:      []rune{'_'},
 															ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -3783,9 +3831,9 @@ var g = &grammar{
 															inverted:   false,
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 432, col: 36, offset: 11780},
+															pos: position{line: 491, col: 36, offset: 13910},
 															expr: &charClassMatcher{
-																pos:        position{line: 432, col: 36, offset: 11780},
+																pos:        position{line: 491, col: 36, offset: 13910},
 																val:        "[_A-Za-z0-9]",
 																chars:      []rune{'_'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -3797,123 +3845,123 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 436, col: 28, offset: 11865},
+												pos: position{line: 495, col: 28, offset: 13995},
 												run: (*parser).callonImportType108,
 												expr: &seqExpr{
-													pos: position{line: 436, col: 28, offset: 11865},
+													pos: position{line: 495, col: 28, offset: 13995},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 436, col: 28, offset: 11865},
+															pos:        position{line: 495, col: 28, offset: 13995},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
 														},
 														&labeledExpr{
-															pos:   position{line: 436, col: 32, offset: 11869},
+															pos:   position{line: 495, col: 32, offset: 13999},
 															label: "v",
 															expr: &actionExpr{
-																pos: position{line: 440, col: 35, offset: 11964},
+																pos: position{line: 499, col: 35, offset: 14094},
 																run: (*parser).callonImportType112,
 																expr: &labeledExpr{
-																	pos:   position{line: 440, col: 35, offset: 11964},
+																	pos:   position{line: 499, col: 35, offset: 14094},
 																	label: "v",
 																	expr: &oneOrMoreExpr{
-																		pos: position{line: 440, col: 37, offset: 11966},
+																		pos: position{line: 499, col: 37, offset: 14096},
 																		expr: &choiceExpr{
-																			pos: position{line: 450, col: 7, offset: 12223},
+																			pos: position{line: 509, col: 7, offset: 14353},
 																			alternatives: []interface{}{
 																				&actionExpr{
-																					pos: position{line: 450, col: 7, offset: 12223},
+																					pos: position{line: 509, col: 7, offset: 14353},
 																					run: (*parser).callonImportType116,
 																					expr: &litMatcher{
-																						pos:        position{line: 450, col: 7, offset: 12223},
+																						pos:        position{line: 509, col: 7, offset: 14353},
 																						val:        "\\\"",
 																						ignoreCase: false,
 																						want:       "\"\\\\\\\"\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 451, col: 7, offset: 12263},
+																					pos: position{line: 510, col: 7, offset: 14393},
 																					run: (*parser).callonImportType118,
 																					expr: &litMatcher{
-																						pos:        position{line: 451, col: 7, offset: 12263},
+																						pos:        position{line: 510, col: 7, offset: 14393},
 																						val:        "\\\\",
 																						ignoreCase: false,
 																						want:       "\"\\\\\\\\\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 452, col: 7, offset: 12303},
+																					pos: position{line: 511, col: 7, offset: 14433},
 																					run: (*parser).callonImportType120,
 																					expr: &litMatcher{
-																						pos:        position{line: 452, col: 7, offset: 12303},
+																						pos:        position{line: 511, col: 7, offset: 14433},
 																						val:        "\\a",
 																						ignoreCase: false,
 																						want:       "\"\\\\a\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 453, col: 7, offset: 12343},
+																					pos: position{line: 512, col: 7, offset: 14473},
 																					run: (*parser).callonImportType122,
 																					expr: &litMatcher{
-																						pos:        position{line: 453, col: 7, offset: 12343},
+																						pos:        position{line: 512, col: 7, offset: 14473},
 																						val:        "\\b",
 																						ignoreCase: false,
 																						want:       "\"\\\\b\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 454, col: 7, offset: 12383},
+																					pos: position{line: 513, col: 7, offset: 14513},
 																					run: (*parser).callonImportType124,
 																					expr: &litMatcher{
-																						pos:        position{line: 454, col: 7, offset: 12383},
+																						pos:        position{line: 513, col: 7, offset: 14513},
 																						val:        "\\f",
 																						ignoreCase: false,
 																						want:       "\"\\\\f\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 455, col: 7, offset: 12423},
+																					pos: position{line: 514, col: 7, offset: 14553},
 																					run: (*parser).callonImportType126,
 																					expr: &litMatcher{
-																						pos:        position{line: 455, col: 7, offset: 12423},
+																						pos:        position{line: 514, col: 7, offset: 14553},
 																						val:        "\\n",
 																						ignoreCase: false,
 																						want:       "\"\\\\n\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 456, col: 7, offset: 12463},
+																					pos: position{line: 515, col: 7, offset: 14593},
 																					run: (*parser).callonImportType128,
 																					expr: &litMatcher{
-																						pos:        position{line: 456, col: 7, offset: 12463},
+																						pos:        position{line: 515, col: 7, offset: 14593},
 																						val:        "\\r",
 																						ignoreCase: false,
 																						want:       "\"\\\\r\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 457, col: 7, offset: 12503},
+																					pos: position{line: 516, col: 7, offset: 14633},
 																					run: (*parser).callonImportType130,
 																					expr: &litMatcher{
-																						pos:        position{line: 457, col: 7, offset: 12503},
+																						pos:        position{line: 516, col: 7, offset: 14633},
 																						val:        "\\t",
 																						ignoreCase: false,
 																						want:       "\"\\\\t\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 458, col: 7, offset: 12543},
+																					pos: position{line: 517, col: 7, offset: 14673},
 																					run: (*parser).callonImportType132,
 																					expr: &litMatcher{
-																						pos:        position{line: 458, col: 7, offset: 12543},
+																						pos:        position{line: 517, col: 7, offset: 14673},
 																						val:        "\\v",
 																						ignoreCase: false,
 																						want:       "\"\\\\v\"",
 																					},
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 459, col: 7, offset: 12583},
+																					pos:        position{line: 518, col: 7, offset: 14713},
 																					val:        "[ -!#-<>-[]-~]",
 																					ranges:     []rune{' ', '!', '#', '<', '>', '[', ']', '~'},
 																					ignoreCase: false,
@@ -3926,7 +3974,7 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 436, col: 66, offset: 11903},
+															pos:        position{line: 495, col: 66, offset: 14033},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
@@ -3945,66 +3993,66 @@ var g = &grammar{
 		},
 		{
 			name: "ImportHashed",
-			pos:  position{line: 482, col: 1, offset: 13535},
+			pos:  position{line: 541, col: 1, offset: 15665},
 			expr: &actionExpr{
-				pos: position{line: 482, col: 16, offset: 13552},
+				pos: position{line: 541, col: 16, offset: 15682},
 				run: (*parser).callonImportHashed1,
 				expr: &seqExpr{
-					pos: position{line: 482, col: 16, offset: 13552},
+					pos: position{line: 541, col: 16, offset: 15682},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 482, col: 16, offset: 13552},
+							pos:   position{line: 541, col: 16, offset: 15682},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 18, offset: 13554},
+								pos:  position{line: 541, col: 18, offset: 15684},
 								name: "ImportType",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 29, offset: 13565},
+							pos:   position{line: 541, col: 29, offset: 15695},
 							label: "h",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 482, col: 31, offset: 13567},
+								pos: position{line: 541, col: 31, offset: 15697},
 								expr: &seqExpr{
-									pos: position{line: 482, col: 32, offset: 13568},
+									pos: position{line: 541, col: 32, offset: 15698},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 482, col: 32, offset: 13568},
+											pos:  position{line: 541, col: 32, offset: 15698},
 											name: "_1",
 										},
 										&actionExpr{
-											pos: position{line: 480, col: 8, offset: 13451},
+											pos: position{line: 539, col: 8, offset: 15581},
 											run: (*parser).callonImportHashed9,
 											expr: &seqExpr{
-												pos: position{line: 480, col: 8, offset: 13451},
+												pos: position{line: 539, col: 8, offset: 15581},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 480, col: 8, offset: 13451},
+														pos:        position{line: 539, col: 8, offset: 15581},
 														val:        "sha256:",
 														ignoreCase: false,
 														want:       "\"sha256:\"",
 													},
 													&labeledExpr{
-														pos:   position{line: 480, col: 18, offset: 13461},
+														pos:   position{line: 539, col: 18, offset: 15591},
 														label: "val",
 														expr: &actionExpr{
-															pos: position{line: 467, col: 13, offset: 12775},
+															pos: position{line: 526, col: 13, offset: 14905},
 															run: (*parser).callonImportHashed13,
 															expr: &seqExpr{
-																pos: position{line: 467, col: 13, offset: 12775},
+																pos: position{line: 526, col: 13, offset: 14905},
 																exprs: []interface{}{
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4013,17 +4061,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4032,17 +4080,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4051,17 +4099,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4070,17 +4118,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4089,17 +4137,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4108,17 +4156,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4127,17 +4175,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4146,17 +4194,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4165,17 +4213,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4184,17 +4232,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4203,17 +4251,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4222,17 +4270,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4241,17 +4289,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4260,17 +4308,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4279,17 +4327,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4298,17 +4346,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4317,17 +4365,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4336,17 +4384,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4355,17 +4403,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4374,17 +4422,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4393,17 +4441,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4412,17 +4460,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4431,17 +4479,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4450,17 +4498,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4469,17 +4517,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4488,17 +4536,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4507,17 +4555,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4526,17 +4574,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4545,17 +4593,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4564,17 +4612,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4583,17 +4631,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4602,17 +4650,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4621,17 +4669,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4640,17 +4688,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4659,17 +4707,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4678,17 +4726,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4697,17 +4745,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4716,17 +4764,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4735,17 +4783,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4754,17 +4802,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4773,17 +4821,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4792,17 +4840,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4811,17 +4859,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4830,17 +4878,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4849,17 +4897,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4868,17 +4916,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4887,17 +4935,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4906,17 +4954,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4925,17 +4973,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4944,17 +4992,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4963,17 +5011,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -4982,17 +5030,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5001,17 +5049,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5020,17 +5068,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5039,17 +5087,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5058,17 +5106,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5077,17 +5125,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5096,17 +5144,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5115,17 +5163,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5134,17 +5182,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5153,17 +5201,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5172,17 +5220,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5191,17 +5239,17 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -5226,40 +5274,40 @@ var g = &grammar{
 		},
 		{
 			name: "Import",
-			pos:  position{line: 490, col: 1, offset: 13726},
+			pos:  position{line: 549, col: 1, offset: 15856},
 			expr: &choiceExpr{
-				pos: position{line: 490, col: 10, offset: 13737},
+				pos: position{line: 549, col: 10, offset: 15867},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 490, col: 10, offset: 13737},
+						pos: position{line: 549, col: 10, offset: 15867},
 						run: (*parser).callonImport2,
 						expr: &seqExpr{
-							pos: position{line: 490, col: 10, offset: 13737},
+							pos: position{line: 549, col: 10, offset: 15867},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 490, col: 10, offset: 13737},
+									pos:   position{line: 549, col: 10, offset: 15867},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 490, col: 12, offset: 13739},
+										pos:  position{line: 549, col: 12, offset: 15869},
 										name: "ImportHashed",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 490, col: 25, offset: 13752},
+									pos:  position{line: 549, col: 25, offset: 15882},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 236, col: 6, offset: 6006},
+									pos:        position{line: 295, col: 6, offset: 8136},
 									val:        "as",
 									ignoreCase: false,
 									want:       "\"as\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 490, col: 30, offset: 13757},
+									pos:  position{line: 549, col: 30, offset: 15887},
 									name: "_1",
 								},
 								&litMatcher{
-									pos:        position{line: 295, col: 8, offset: 7902},
+									pos:        position{line: 354, col: 8, offset: 10032},
 									val:        "Text",
 									ignoreCase: false,
 									want:       "\"Text\"",
@@ -5268,35 +5316,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 491, col: 10, offset: 13850},
+						pos: position{line: 550, col: 10, offset: 15980},
 						run: (*parser).callonImport10,
 						expr: &seqExpr{
-							pos: position{line: 491, col: 10, offset: 13850},
+							pos: position{line: 550, col: 10, offset: 15980},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 491, col: 10, offset: 13850},
+									pos:   position{line: 550, col: 10, offset: 15980},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 491, col: 12, offset: 13852},
+										pos:  position{line: 550, col: 12, offset: 15982},
 										name: "ImportHashed",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 491, col: 25, offset: 13865},
+									pos:  position{line: 550, col: 25, offset: 15995},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 236, col: 6, offset: 6006},
+									pos:        position{line: 295, col: 6, offset: 8136},
 									val:        "as",
 									ignoreCase: false,
 									want:       "\"as\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 491, col: 30, offset: 13870},
+									pos:  position{line: 550, col: 30, offset: 16000},
 									name: "_1",
 								},
 								&litMatcher{
-									pos:        position{line: 296, col: 12, offset: 7922},
+									pos:        position{line: 355, col: 12, offset: 10052},
 									val:        "Location",
 									ignoreCase: false,
 									want:       "\"Location\"",
@@ -5305,13 +5353,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 492, col: 10, offset: 13968},
+						pos: position{line: 551, col: 10, offset: 16098},
 						run: (*parser).callonImport18,
 						expr: &labeledExpr{
-							pos:   position{line: 492, col: 10, offset: 13968},
+							pos:   position{line: 551, col: 10, offset: 16098},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 492, col: 12, offset: 13970},
+								pos:  position{line: 551, col: 12, offset: 16100},
 								name: "ImportHashed",
 							},
 						},
@@ -5321,388 +5369,388 @@ var g = &grammar{
 		},
 		{
 			name: "LetBinding",
-			pos:  position{line: 495, col: 1, offset: 14058},
+			pos:  position{line: 554, col: 1, offset: 16188},
 			expr: &actionExpr{
-				pos: position{line: 495, col: 14, offset: 14073},
+				pos: position{line: 554, col: 14, offset: 16203},
 				run: (*parser).callonLetBinding1,
 				expr: &seqExpr{
-					pos: position{line: 495, col: 14, offset: 14073},
+					pos: position{line: 554, col: 14, offset: 16203},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 234, col: 7, offset: 5981},
+							pos:        position{line: 293, col: 7, offset: 8111},
 							val:        "let",
 							ignoreCase: false,
 							want:       "\"let\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 495, col: 18, offset: 14077},
+							pos:  position{line: 554, col: 18, offset: 16207},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 495, col: 21, offset: 14080},
+							pos:   position{line: 554, col: 21, offset: 16210},
 							label: "label",
 							expr: &choiceExpr{
-								pos: position{line: 134, col: 20, offset: 3237},
+								pos: position{line: 193, col: 20, offset: 5367},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 134, col: 20, offset: 3237},
+										pos: position{line: 193, col: 20, offset: 5367},
 										run: (*parser).callonLetBinding7,
 										expr: &seqExpr{
-											pos: position{line: 134, col: 20, offset: 3237},
+											pos: position{line: 193, col: 20, offset: 5367},
 											exprs: []interface{}{
 												&andExpr{
-													pos: position{line: 134, col: 20, offset: 3237},
+													pos: position{line: 193, col: 20, offset: 5367},
 													expr: &seqExpr{
-														pos: position{line: 134, col: 22, offset: 3239},
+														pos: position{line: 193, col: 22, offset: 5369},
 														exprs: []interface{}{
 															&choiceExpr{
-																pos: position{line: 259, col: 5, offset: 6416},
+																pos: position{line: 318, col: 5, offset: 8546},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 259, col: 5, offset: 6416},
+																		pos: position{line: 318, col: 5, offset: 8546},
 																		run: (*parser).callonLetBinding12,
 																		expr: &litMatcher{
-																			pos:        position{line: 259, col: 5, offset: 6416},
+																			pos:        position{line: 318, col: 5, offset: 8546},
 																			val:        "Natural/fold",
 																			ignoreCase: false,
 																			want:       "\"Natural/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 260, col: 5, offset: 6463},
+																		pos: position{line: 319, col: 5, offset: 8593},
 																		run: (*parser).callonLetBinding14,
 																		expr: &litMatcher{
-																			pos:        position{line: 260, col: 5, offset: 6463},
+																			pos:        position{line: 319, col: 5, offset: 8593},
 																			val:        "Natural/build",
 																			ignoreCase: false,
 																			want:       "\"Natural/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 261, col: 5, offset: 6512},
+																		pos: position{line: 320, col: 5, offset: 8642},
 																		run: (*parser).callonLetBinding16,
 																		expr: &litMatcher{
-																			pos:        position{line: 261, col: 5, offset: 6512},
+																			pos:        position{line: 320, col: 5, offset: 8642},
 																			val:        "Natural/isZero",
 																			ignoreCase: false,
 																			want:       "\"Natural/isZero\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 262, col: 5, offset: 6563},
+																		pos: position{line: 321, col: 5, offset: 8693},
 																		run: (*parser).callonLetBinding18,
 																		expr: &litMatcher{
-																			pos:        position{line: 262, col: 5, offset: 6563},
+																			pos:        position{line: 321, col: 5, offset: 8693},
 																			val:        "Natural/even",
 																			ignoreCase: false,
 																			want:       "\"Natural/even\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 263, col: 5, offset: 6610},
+																		pos: position{line: 322, col: 5, offset: 8740},
 																		run: (*parser).callonLetBinding20,
 																		expr: &litMatcher{
-																			pos:        position{line: 263, col: 5, offset: 6610},
+																			pos:        position{line: 322, col: 5, offset: 8740},
 																			val:        "Natural/odd",
 																			ignoreCase: false,
 																			want:       "\"Natural/odd\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 264, col: 5, offset: 6655},
+																		pos: position{line: 323, col: 5, offset: 8785},
 																		run: (*parser).callonLetBinding22,
 																		expr: &litMatcher{
-																			pos:        position{line: 264, col: 5, offset: 6655},
+																			pos:        position{line: 323, col: 5, offset: 8785},
 																			val:        "Natural/toInteger",
 																			ignoreCase: false,
 																			want:       "\"Natural/toInteger\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 265, col: 5, offset: 6712},
+																		pos: position{line: 324, col: 5, offset: 8842},
 																		run: (*parser).callonLetBinding24,
 																		expr: &litMatcher{
-																			pos:        position{line: 265, col: 5, offset: 6712},
+																			pos:        position{line: 324, col: 5, offset: 8842},
 																			val:        "Natural/show",
 																			ignoreCase: false,
 																			want:       "\"Natural/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 266, col: 5, offset: 6759},
+																		pos: position{line: 325, col: 5, offset: 8889},
 																		run: (*parser).callonLetBinding26,
 																		expr: &litMatcher{
-																			pos:        position{line: 266, col: 5, offset: 6759},
+																			pos:        position{line: 325, col: 5, offset: 8889},
 																			val:        "Integer/toDouble",
 																			ignoreCase: false,
 																			want:       "\"Integer/toDouble\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 267, col: 5, offset: 6814},
+																		pos: position{line: 326, col: 5, offset: 8944},
 																		run: (*parser).callonLetBinding28,
 																		expr: &litMatcher{
-																			pos:        position{line: 267, col: 5, offset: 6814},
+																			pos:        position{line: 326, col: 5, offset: 8944},
 																			val:        "Integer/show",
 																			ignoreCase: false,
 																			want:       "\"Integer/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 268, col: 5, offset: 6861},
+																		pos: position{line: 327, col: 5, offset: 8991},
 																		run: (*parser).callonLetBinding30,
 																		expr: &litMatcher{
-																			pos:        position{line: 268, col: 5, offset: 6861},
+																			pos:        position{line: 327, col: 5, offset: 8991},
 																			val:        "Integer/negate",
 																			ignoreCase: false,
 																			want:       "\"Integer/negate\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 269, col: 5, offset: 6912},
+																		pos: position{line: 328, col: 5, offset: 9042},
 																		run: (*parser).callonLetBinding32,
 																		expr: &litMatcher{
-																			pos:        position{line: 269, col: 5, offset: 6912},
+																			pos:        position{line: 328, col: 5, offset: 9042},
 																			val:        "Integer/clamp",
 																			ignoreCase: false,
 																			want:       "\"Integer/clamp\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 270, col: 5, offset: 6961},
+																		pos: position{line: 329, col: 5, offset: 9091},
 																		run: (*parser).callonLetBinding34,
 																		expr: &litMatcher{
-																			pos:        position{line: 270, col: 5, offset: 6961},
+																			pos:        position{line: 329, col: 5, offset: 9091},
 																			val:        "Natural/subtract",
 																			ignoreCase: false,
 																			want:       "\"Natural/subtract\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 271, col: 5, offset: 7016},
+																		pos: position{line: 330, col: 5, offset: 9146},
 																		run: (*parser).callonLetBinding36,
 																		expr: &litMatcher{
-																			pos:        position{line: 271, col: 5, offset: 7016},
+																			pos:        position{line: 330, col: 5, offset: 9146},
 																			val:        "Double/show",
 																			ignoreCase: false,
 																			want:       "\"Double/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 272, col: 5, offset: 7061},
+																		pos: position{line: 331, col: 5, offset: 9191},
 																		run: (*parser).callonLetBinding38,
 																		expr: &litMatcher{
-																			pos:        position{line: 272, col: 5, offset: 7061},
+																			pos:        position{line: 331, col: 5, offset: 9191},
 																			val:        "List/build",
 																			ignoreCase: false,
 																			want:       "\"List/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 273, col: 5, offset: 7104},
+																		pos: position{line: 332, col: 5, offset: 9234},
 																		run: (*parser).callonLetBinding40,
 																		expr: &litMatcher{
-																			pos:        position{line: 273, col: 5, offset: 7104},
+																			pos:        position{line: 332, col: 5, offset: 9234},
 																			val:        "List/fold",
 																			ignoreCase: false,
 																			want:       "\"List/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 274, col: 5, offset: 7145},
+																		pos: position{line: 333, col: 5, offset: 9275},
 																		run: (*parser).callonLetBinding42,
 																		expr: &litMatcher{
-																			pos:        position{line: 274, col: 5, offset: 7145},
+																			pos:        position{line: 333, col: 5, offset: 9275},
 																			val:        "List/length",
 																			ignoreCase: false,
 																			want:       "\"List/length\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 275, col: 5, offset: 7190},
+																		pos: position{line: 334, col: 5, offset: 9320},
 																		run: (*parser).callonLetBinding44,
 																		expr: &litMatcher{
-																			pos:        position{line: 275, col: 5, offset: 7190},
+																			pos:        position{line: 334, col: 5, offset: 9320},
 																			val:        "List/head",
 																			ignoreCase: false,
 																			want:       "\"List/head\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 276, col: 5, offset: 7231},
+																		pos: position{line: 335, col: 5, offset: 9361},
 																		run: (*parser).callonLetBinding46,
 																		expr: &litMatcher{
-																			pos:        position{line: 276, col: 5, offset: 7231},
+																			pos:        position{line: 335, col: 5, offset: 9361},
 																			val:        "List/last",
 																			ignoreCase: false,
 																			want:       "\"List/last\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 277, col: 5, offset: 7272},
+																		pos: position{line: 336, col: 5, offset: 9402},
 																		run: (*parser).callonLetBinding48,
 																		expr: &litMatcher{
-																			pos:        position{line: 277, col: 5, offset: 7272},
+																			pos:        position{line: 336, col: 5, offset: 9402},
 																			val:        "List/indexed",
 																			ignoreCase: false,
 																			want:       "\"List/indexed\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 278, col: 5, offset: 7319},
+																		pos: position{line: 337, col: 5, offset: 9449},
 																		run: (*parser).callonLetBinding50,
 																		expr: &litMatcher{
-																			pos:        position{line: 278, col: 5, offset: 7319},
+																			pos:        position{line: 337, col: 5, offset: 9449},
 																			val:        "List/reverse",
 																			ignoreCase: false,
 																			want:       "\"List/reverse\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 279, col: 5, offset: 7366},
+																		pos: position{line: 338, col: 5, offset: 9496},
 																		run: (*parser).callonLetBinding52,
 																		expr: &litMatcher{
-																			pos:        position{line: 279, col: 5, offset: 7366},
+																			pos:        position{line: 338, col: 5, offset: 9496},
 																			val:        "Text/show",
 																			ignoreCase: false,
 																			want:       "\"Text/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 280, col: 5, offset: 7407},
+																		pos: position{line: 339, col: 5, offset: 9537},
 																		run: (*parser).callonLetBinding54,
 																		expr: &litMatcher{
-																			pos:        position{line: 280, col: 5, offset: 7407},
+																			pos:        position{line: 339, col: 5, offset: 9537},
 																			val:        "Text/replace",
 																			ignoreCase: false,
 																			want:       "\"Text/replace\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 281, col: 5, offset: 7454},
+																		pos: position{line: 340, col: 5, offset: 9584},
 																		run: (*parser).callonLetBinding56,
 																		expr: &litMatcher{
-																			pos:        position{line: 281, col: 5, offset: 7454},
+																			pos:        position{line: 340, col: 5, offset: 9584},
 																			val:        "Bool",
 																			ignoreCase: false,
 																			want:       "\"Bool\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 282, col: 5, offset: 7486},
+																		pos: position{line: 341, col: 5, offset: 9616},
 																		run: (*parser).callonLetBinding58,
 																		expr: &litMatcher{
-																			pos:        position{line: 282, col: 5, offset: 7486},
+																			pos:        position{line: 341, col: 5, offset: 9616},
 																			val:        "True",
 																			ignoreCase: false,
 																			want:       "\"True\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 283, col: 5, offset: 7518},
+																		pos: position{line: 342, col: 5, offset: 9648},
 																		run: (*parser).callonLetBinding60,
 																		expr: &litMatcher{
-																			pos:        position{line: 283, col: 5, offset: 7518},
+																			pos:        position{line: 342, col: 5, offset: 9648},
 																			val:        "False",
 																			ignoreCase: false,
 																			want:       "\"False\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 284, col: 5, offset: 7552},
+																		pos: position{line: 343, col: 5, offset: 9682},
 																		run: (*parser).callonLetBinding62,
 																		expr: &litMatcher{
-																			pos:        position{line: 284, col: 5, offset: 7552},
+																			pos:        position{line: 343, col: 5, offset: 9682},
 																			val:        "Optional",
 																			ignoreCase: false,
 																			want:       "\"Optional\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 285, col: 5, offset: 7592},
+																		pos: position{line: 344, col: 5, offset: 9722},
 																		run: (*parser).callonLetBinding64,
 																		expr: &litMatcher{
-																			pos:        position{line: 285, col: 5, offset: 7592},
+																			pos:        position{line: 344, col: 5, offset: 9722},
 																			val:        "None",
 																			ignoreCase: false,
 																			want:       "\"None\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 286, col: 5, offset: 7624},
+																		pos: position{line: 345, col: 5, offset: 9754},
 																		run: (*parser).callonLetBinding66,
 																		expr: &litMatcher{
-																			pos:        position{line: 286, col: 5, offset: 7624},
+																			pos:        position{line: 345, col: 5, offset: 9754},
 																			val:        "Natural",
 																			ignoreCase: false,
 																			want:       "\"Natural\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 287, col: 5, offset: 7662},
+																		pos: position{line: 346, col: 5, offset: 9792},
 																		run: (*parser).callonLetBinding68,
 																		expr: &litMatcher{
-																			pos:        position{line: 287, col: 5, offset: 7662},
+																			pos:        position{line: 346, col: 5, offset: 9792},
 																			val:        "Integer",
 																			ignoreCase: false,
 																			want:       "\"Integer\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 288, col: 5, offset: 7700},
+																		pos: position{line: 347, col: 5, offset: 9830},
 																		run: (*parser).callonLetBinding70,
 																		expr: &litMatcher{
-																			pos:        position{line: 288, col: 5, offset: 7700},
+																			pos:        position{line: 347, col: 5, offset: 9830},
 																			val:        "Double",
 																			ignoreCase: false,
 																			want:       "\"Double\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 289, col: 5, offset: 7736},
+																		pos: position{line: 348, col: 5, offset: 9866},
 																		run: (*parser).callonLetBinding72,
 																		expr: &litMatcher{
-																			pos:        position{line: 289, col: 5, offset: 7736},
+																			pos:        position{line: 348, col: 5, offset: 9866},
 																			val:        "Text",
 																			ignoreCase: false,
 																			want:       "\"Text\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 290, col: 5, offset: 7768},
+																		pos: position{line: 349, col: 5, offset: 9898},
 																		run: (*parser).callonLetBinding74,
 																		expr: &litMatcher{
-																			pos:        position{line: 290, col: 5, offset: 7768},
+																			pos:        position{line: 349, col: 5, offset: 9898},
 																			val:        "List",
 																			ignoreCase: false,
 																			want:       "\"List\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 291, col: 5, offset: 7800},
+																		pos: position{line: 350, col: 5, offset: 9930},
 																		run: (*parser).callonLetBinding76,
 																		expr: &litMatcher{
-																			pos:        position{line: 291, col: 5, offset: 7800},
+																			pos:        position{line: 350, col: 5, offset: 9930},
 																			val:        "Type",
 																			ignoreCase: false,
 																			want:       "\"Type\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 292, col: 5, offset: 7832},
+																		pos: position{line: 351, col: 5, offset: 9962},
 																		run: (*parser).callonLetBinding78,
 																		expr: &litMatcher{
-																			pos:        position{line: 292, col: 5, offset: 7832},
+																			pos:        position{line: 351, col: 5, offset: 9962},
 																			val:        "Kind",
 																			ignoreCase: false,
 																			want:       "\"Kind\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 293, col: 5, offset: 7864},
+																		pos: position{line: 352, col: 5, offset: 9994},
 																		run: (*parser).callonLetBinding80,
 																		expr: &litMatcher{
-																			pos:        position{line: 293, col: 5, offset: 7864},
+																			pos:        position{line: 352, col: 5, offset: 9994},
 																			val:        "Sort",
 																			ignoreCase: false,
 																			want:       "\"Sort\"",
@@ -5711,7 +5759,7 @@ var g = &grammar{
 																},
 															},
 															&charClassMatcher{
-																pos:        position{line: 121, col: 23, offset: 2785},
+																pos:        position{line: 180, col: 23, offset: 4915},
 																val:        "[_/-A-Za-z0-9]",
 																chars:      []rune{'_', '/', '-'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -5722,33 +5770,33 @@ var g = &grammar{
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 134, col: 51, offset: 3268},
+													pos:   position{line: 193, col: 51, offset: 5398},
 													label: "label",
 													expr: &choiceExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																run: (*parser).callonLetBinding85,
 																expr: &seqExpr{
-																	pos: position{line: 131, col: 9, offset: 3119},
+																	pos: position{line: 190, col: 9, offset: 5249},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 131, col: 9, offset: 3119},
+																			pos:        position{line: 190, col: 9, offset: 5249},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
-																			pos:   position{line: 131, col: 13, offset: 3123},
+																			pos:   position{line: 190, col: 13, offset: 5253},
 																			label: "label",
 																			expr: &actionExpr{
-																				pos: position{line: 129, col: 15, offset: 3060},
+																				pos: position{line: 188, col: 15, offset: 5190},
 																				run: (*parser).callonLetBinding89,
 																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 129, col: 15, offset: 3060},
+																					pos: position{line: 188, col: 15, offset: 5190},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 128, col: 19, offset: 3023},
+																						pos:        position{line: 187, col: 19, offset: 5153},
 																						val:        "[ -_a-~]",
 																						ranges:     []rune{' ', '_', 'a', '~'},
 																						ignoreCase: false,
@@ -5758,7 +5806,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 131, col: 31, offset: 3141},
+																			pos:        position{line: 190, col: 31, offset: 5271},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
@@ -5767,75 +5815,75 @@ var g = &grammar{
 																},
 															},
 															&actionExpr{
-																pos: position{line: 132, col: 9, offset: 3175},
+																pos: position{line: 191, col: 9, offset: 5305},
 																run: (*parser).callonLetBinding93,
 																expr: &labeledExpr{
-																	pos:   position{line: 132, col: 9, offset: 3175},
+																	pos:   position{line: 191, col: 9, offset: 5305},
 																	label: "label",
 																	expr: &choiceExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				run: (*parser).callonLetBinding96,
 																				expr: &seqExpr{
-																					pos: position{line: 122, col: 15, offset: 2816},
+																					pos: position{line: 181, col: 15, offset: 4946},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 249, col: 5, offset: 6269},
+																							pos: position{line: 308, col: 5, offset: 8399},
 																							alternatives: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 231, col: 6, offset: 5936},
+																									pos:        position{line: 290, col: 6, offset: 8066},
 																									val:        "if",
 																									ignoreCase: false,
 																									want:       "\"if\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 232, col: 8, offset: 5950},
+																									pos:        position{line: 291, col: 8, offset: 8080},
 																									val:        "then",
 																									ignoreCase: false,
 																									want:       "\"then\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 233, col: 8, offset: 5966},
+																									pos:        position{line: 292, col: 8, offset: 8096},
 																									val:        "else",
 																									ignoreCase: false,
 																									want:       "\"else\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 234, col: 7, offset: 5981},
+																									pos:        position{line: 293, col: 7, offset: 8111},
 																									val:        "let",
 																									ignoreCase: false,
 																									want:       "\"let\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 235, col: 6, offset: 5994},
+																									pos:        position{line: 294, col: 6, offset: 8124},
 																									val:        "in",
 																									ignoreCase: false,
 																									want:       "\"in\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 237, col: 9, offset: 6021},
+																									pos:        position{line: 296, col: 9, offset: 8151},
 																									val:        "using",
 																									ignoreCase: false,
 																									want:       "\"using\"",
 																								},
 																								&actionExpr{
-																									pos: position{line: 239, col: 11, offset: 6059},
+																									pos: position{line: 298, col: 11, offset: 8189},
 																									run: (*parser).callonLetBinding105,
 																									expr: &seqExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										exprs: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 239, col: 11, offset: 6059},
+																												pos:        position{line: 298, col: 11, offset: 8189},
 																												val:        "missing",
 																												ignoreCase: false,
 																												want:       "\"missing\"",
 																											},
 																											&notExpr{
-																												pos: position{line: 239, col: 21, offset: 6069},
+																												pos: position{line: 298, col: 21, offset: 8199},
 																												expr: &charClassMatcher{
-																													pos:        position{line: 121, col: 23, offset: 2785},
+																													pos:        position{line: 180, col: 23, offset: 4915},
 																													val:        "[_/-A-Za-z0-9]",
 																													chars:      []rune{'_', '/', '-'},
 																													ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -5847,61 +5895,61 @@ var g = &grammar{
 																									},
 																								},
 																								&litMatcher{
-																									pos:        position{line: 244, col: 10, offset: 6199},
+																									pos:        position{line: 303, col: 10, offset: 8329},
 																									val:        "assert",
 																									ignoreCase: false,
 																									want:       "\"assert\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 236, col: 6, offset: 6006},
+																									pos:        position{line: 295, col: 6, offset: 8136},
 																									val:        "as",
 																									ignoreCase: false,
 																									want:       "\"as\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 240, col: 12, offset: 6129},
+																									pos:        position{line: 299, col: 12, offset: 8259},
 																									val:        "Infinity",
 																									ignoreCase: false,
 																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 241, col: 7, offset: 6148},
+																									pos:        position{line: 300, col: 7, offset: 8278},
 																									val:        "NaN",
 																									ignoreCase: false,
 																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 238, col: 9, offset: 6039},
+																									pos:        position{line: 297, col: 9, offset: 8169},
 																									val:        "merge",
 																									ignoreCase: false,
 																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 242, col: 8, offset: 6163},
+																									pos:        position{line: 301, col: 8, offset: 8293},
 																									val:        "Some",
 																									ignoreCase: false,
 																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 243, col: 9, offset: 6180},
+																									pos:        position{line: 302, col: 9, offset: 8310},
 																									val:        "toMap",
 																									ignoreCase: false,
 																									want:       "\"toMap\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 10, offset: 6219},
+																									pos:        position{line: 304, col: 10, offset: 8349},
 																									val:        "forall",
 																									ignoreCase: false,
 																									want:       "\"forall\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 21, offset: 6230},
+																									pos:        position{line: 304, col: 21, offset: 8360},
 																									val:        "∀",
 																									ignoreCase: false,
 																									want:       "\"∀\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 246, col: 8, offset: 6245},
+																									pos:        position{line: 305, col: 8, offset: 8375},
 																									val:        "with",
 																									ignoreCase: false,
 																									want:       "\"with\"",
@@ -5909,9 +5957,9 @@ var g = &grammar{
 																							},
 																						},
 																						&oneOrMoreExpr{
-																							pos: position{line: 122, col: 23, offset: 2824},
+																							pos: position{line: 181, col: 23, offset: 4954},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -5923,68 +5971,68 @@ var g = &grammar{
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				run: (*parser).callonLetBinding122,
 																				expr: &seqExpr{
-																					pos: position{line: 123, col: 13, offset: 2888},
+																					pos: position{line: 182, col: 13, offset: 5018},
 																					exprs: []interface{}{
 																						&notExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							expr: &choiceExpr{
-																								pos: position{line: 249, col: 5, offset: 6269},
+																								pos: position{line: 308, col: 5, offset: 8399},
 																								alternatives: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 231, col: 6, offset: 5936},
+																										pos:        position{line: 290, col: 6, offset: 8066},
 																										val:        "if",
 																										ignoreCase: false,
 																										want:       "\"if\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 232, col: 8, offset: 5950},
+																										pos:        position{line: 291, col: 8, offset: 8080},
 																										val:        "then",
 																										ignoreCase: false,
 																										want:       "\"then\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 233, col: 8, offset: 5966},
+																										pos:        position{line: 292, col: 8, offset: 8096},
 																										val:        "else",
 																										ignoreCase: false,
 																										want:       "\"else\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 234, col: 7, offset: 5981},
+																										pos:        position{line: 293, col: 7, offset: 8111},
 																										val:        "let",
 																										ignoreCase: false,
 																										want:       "\"let\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 235, col: 6, offset: 5994},
+																										pos:        position{line: 294, col: 6, offset: 8124},
 																										val:        "in",
 																										ignoreCase: false,
 																										want:       "\"in\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 237, col: 9, offset: 6021},
+																										pos:        position{line: 296, col: 9, offset: 8151},
 																										val:        "using",
 																										ignoreCase: false,
 																										want:       "\"using\"",
 																									},
 																									&actionExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										run: (*parser).callonLetBinding132,
 																										expr: &seqExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											exprs: []interface{}{
 																												&litMatcher{
-																													pos:        position{line: 239, col: 11, offset: 6059},
+																													pos:        position{line: 298, col: 11, offset: 8189},
 																													val:        "missing",
 																													ignoreCase: false,
 																													want:       "\"missing\"",
 																												},
 																												&notExpr{
-																													pos: position{line: 239, col: 21, offset: 6069},
+																													pos: position{line: 298, col: 21, offset: 8199},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 121, col: 23, offset: 2785},
+																														pos:        position{line: 180, col: 23, offset: 4915},
 																														val:        "[_/-A-Za-z0-9]",
 																														chars:      []rune{'_', '/', '-'},
 																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -5996,61 +6044,61 @@ var g = &grammar{
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 244, col: 10, offset: 6199},
+																										pos:        position{line: 303, col: 10, offset: 8329},
 																										val:        "assert",
 																										ignoreCase: false,
 																										want:       "\"assert\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 236, col: 6, offset: 6006},
+																										pos:        position{line: 295, col: 6, offset: 8136},
 																										val:        "as",
 																										ignoreCase: false,
 																										want:       "\"as\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 240, col: 12, offset: 6129},
+																										pos:        position{line: 299, col: 12, offset: 8259},
 																										val:        "Infinity",
 																										ignoreCase: false,
 																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 241, col: 7, offset: 6148},
+																										pos:        position{line: 300, col: 7, offset: 8278},
 																										val:        "NaN",
 																										ignoreCase: false,
 																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 238, col: 9, offset: 6039},
+																										pos:        position{line: 297, col: 9, offset: 8169},
 																										val:        "merge",
 																										ignoreCase: false,
 																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 242, col: 8, offset: 6163},
+																										pos:        position{line: 301, col: 8, offset: 8293},
 																										val:        "Some",
 																										ignoreCase: false,
 																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 243, col: 9, offset: 6180},
+																										pos:        position{line: 302, col: 9, offset: 8310},
 																										val:        "toMap",
 																										ignoreCase: false,
 																										want:       "\"toMap\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 10, offset: 6219},
+																										pos:        position{line: 304, col: 10, offset: 8349},
 																										val:        "forall",
 																										ignoreCase: false,
 																										want:       "\"forall\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 21, offset: 6230},
+																										pos:        position{line: 304, col: 21, offset: 8360},
 																										val:        "∀",
 																										ignoreCase: false,
 																										want:       "\"∀\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 246, col: 8, offset: 6245},
+																										pos:        position{line: 305, col: 8, offset: 8375},
 																										val:        "with",
 																										ignoreCase: false,
 																										want:       "\"with\"",
@@ -6059,7 +6107,7 @@ var g = &grammar{
 																							},
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 120, col: 24, offset: 2751},
+																							pos:        position{line: 179, col: 24, offset: 4881},
 																							val:        "[_A-Za-z]",
 																							chars:      []rune{'_'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -6067,9 +6115,9 @@ var g = &grammar{
 																							inverted:   false,
 																						},
 																						&zeroOrMoreExpr{
-																							pos: position{line: 123, col: 43, offset: 2918},
+																							pos: position{line: 182, col: 43, offset: 5048},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -6091,361 +6139,361 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 135, col: 19, offset: 3320},
+										pos: position{line: 194, col: 19, offset: 5450},
 										run: (*parser).callonLetBinding150,
 										expr: &seqExpr{
-											pos: position{line: 135, col: 19, offset: 3320},
+											pos: position{line: 194, col: 19, offset: 5450},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 135, col: 19, offset: 3320},
+													pos: position{line: 194, col: 19, offset: 5450},
 													expr: &choiceExpr{
-														pos: position{line: 259, col: 5, offset: 6416},
+														pos: position{line: 318, col: 5, offset: 8546},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 259, col: 5, offset: 6416},
+																pos: position{line: 318, col: 5, offset: 8546},
 																run: (*parser).callonLetBinding154,
 																expr: &litMatcher{
-																	pos:        position{line: 259, col: 5, offset: 6416},
+																	pos:        position{line: 318, col: 5, offset: 8546},
 																	val:        "Natural/fold",
 																	ignoreCase: false,
 																	want:       "\"Natural/fold\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 260, col: 5, offset: 6463},
+																pos: position{line: 319, col: 5, offset: 8593},
 																run: (*parser).callonLetBinding156,
 																expr: &litMatcher{
-																	pos:        position{line: 260, col: 5, offset: 6463},
+																	pos:        position{line: 319, col: 5, offset: 8593},
 																	val:        "Natural/build",
 																	ignoreCase: false,
 																	want:       "\"Natural/build\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 261, col: 5, offset: 6512},
+																pos: position{line: 320, col: 5, offset: 8642},
 																run: (*parser).callonLetBinding158,
 																expr: &litMatcher{
-																	pos:        position{line: 261, col: 5, offset: 6512},
+																	pos:        position{line: 320, col: 5, offset: 8642},
 																	val:        "Natural/isZero",
 																	ignoreCase: false,
 																	want:       "\"Natural/isZero\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 262, col: 5, offset: 6563},
+																pos: position{line: 321, col: 5, offset: 8693},
 																run: (*parser).callonLetBinding160,
 																expr: &litMatcher{
-																	pos:        position{line: 262, col: 5, offset: 6563},
+																	pos:        position{line: 321, col: 5, offset: 8693},
 																	val:        "Natural/even",
 																	ignoreCase: false,
 																	want:       "\"Natural/even\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 263, col: 5, offset: 6610},
+																pos: position{line: 322, col: 5, offset: 8740},
 																run: (*parser).callonLetBinding162,
 																expr: &litMatcher{
-																	pos:        position{line: 263, col: 5, offset: 6610},
+																	pos:        position{line: 322, col: 5, offset: 8740},
 																	val:        "Natural/odd",
 																	ignoreCase: false,
 																	want:       "\"Natural/odd\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 264, col: 5, offset: 6655},
+																pos: position{line: 323, col: 5, offset: 8785},
 																run: (*parser).callonLetBinding164,
 																expr: &litMatcher{
-																	pos:        position{line: 264, col: 5, offset: 6655},
+																	pos:        position{line: 323, col: 5, offset: 8785},
 																	val:        "Natural/toInteger",
 																	ignoreCase: false,
 																	want:       "\"Natural/toInteger\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 265, col: 5, offset: 6712},
+																pos: position{line: 324, col: 5, offset: 8842},
 																run: (*parser).callonLetBinding166,
 																expr: &litMatcher{
-																	pos:        position{line: 265, col: 5, offset: 6712},
+																	pos:        position{line: 324, col: 5, offset: 8842},
 																	val:        "Natural/show",
 																	ignoreCase: false,
 																	want:       "\"Natural/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 266, col: 5, offset: 6759},
+																pos: position{line: 325, col: 5, offset: 8889},
 																run: (*parser).callonLetBinding168,
 																expr: &litMatcher{
-																	pos:        position{line: 266, col: 5, offset: 6759},
+																	pos:        position{line: 325, col: 5, offset: 8889},
 																	val:        "Integer/toDouble",
 																	ignoreCase: false,
 																	want:       "\"Integer/toDouble\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 267, col: 5, offset: 6814},
+																pos: position{line: 326, col: 5, offset: 8944},
 																run: (*parser).callonLetBinding170,
 																expr: &litMatcher{
-																	pos:        position{line: 267, col: 5, offset: 6814},
+																	pos:        position{line: 326, col: 5, offset: 8944},
 																	val:        "Integer/show",
 																	ignoreCase: false,
 																	want:       "\"Integer/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 268, col: 5, offset: 6861},
+																pos: position{line: 327, col: 5, offset: 8991},
 																run: (*parser).callonLetBinding172,
 																expr: &litMatcher{
-																	pos:        position{line: 268, col: 5, offset: 6861},
+																	pos:        position{line: 327, col: 5, offset: 8991},
 																	val:        "Integer/negate",
 																	ignoreCase: false,
 																	want:       "\"Integer/negate\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 269, col: 5, offset: 6912},
+																pos: position{line: 328, col: 5, offset: 9042},
 																run: (*parser).callonLetBinding174,
 																expr: &litMatcher{
-																	pos:        position{line: 269, col: 5, offset: 6912},
+																	pos:        position{line: 328, col: 5, offset: 9042},
 																	val:        "Integer/clamp",
 																	ignoreCase: false,
 																	want:       "\"Integer/clamp\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 270, col: 5, offset: 6961},
+																pos: position{line: 329, col: 5, offset: 9091},
 																run: (*parser).callonLetBinding176,
 																expr: &litMatcher{
-																	pos:        position{line: 270, col: 5, offset: 6961},
+																	pos:        position{line: 329, col: 5, offset: 9091},
 																	val:        "Natural/subtract",
 																	ignoreCase: false,
 																	want:       "\"Natural/subtract\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 271, col: 5, offset: 7016},
+																pos: position{line: 330, col: 5, offset: 9146},
 																run: (*parser).callonLetBinding178,
 																expr: &litMatcher{
-																	pos:        position{line: 271, col: 5, offset: 7016},
+																	pos:        position{line: 330, col: 5, offset: 9146},
 																	val:        "Double/show",
 																	ignoreCase: false,
 																	want:       "\"Double/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 272, col: 5, offset: 7061},
+																pos: position{line: 331, col: 5, offset: 9191},
 																run: (*parser).callonLetBinding180,
 																expr: &litMatcher{
-																	pos:        position{line: 272, col: 5, offset: 7061},
+																	pos:        position{line: 331, col: 5, offset: 9191},
 																	val:        "List/build",
 																	ignoreCase: false,
 																	want:       "\"List/build\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 273, col: 5, offset: 7104},
+																pos: position{line: 332, col: 5, offset: 9234},
 																run: (*parser).callonLetBinding182,
 																expr: &litMatcher{
-																	pos:        position{line: 273, col: 5, offset: 7104},
+																	pos:        position{line: 332, col: 5, offset: 9234},
 																	val:        "List/fold",
 																	ignoreCase: false,
 																	want:       "\"List/fold\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 274, col: 5, offset: 7145},
+																pos: position{line: 333, col: 5, offset: 9275},
 																run: (*parser).callonLetBinding184,
 																expr: &litMatcher{
-																	pos:        position{line: 274, col: 5, offset: 7145},
+																	pos:        position{line: 333, col: 5, offset: 9275},
 																	val:        "List/length",
 																	ignoreCase: false,
 																	want:       "\"List/length\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 275, col: 5, offset: 7190},
+																pos: position{line: 334, col: 5, offset: 9320},
 																run: (*parser).callonLetBinding186,
 																expr: &litMatcher{
-																	pos:        position{line: 275, col: 5, offset: 7190},
+																	pos:        position{line: 334, col: 5, offset: 9320},
 																	val:        "List/head",
 																	ignoreCase: false,
 																	want:       "\"List/head\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 276, col: 5, offset: 7231},
+																pos: position{line: 335, col: 5, offset: 9361},
 																run: (*parser).callonLetBinding188,
 																expr: &litMatcher{
-																	pos:        position{line: 276, col: 5, offset: 7231},
+																	pos:        position{line: 335, col: 5, offset: 9361},
 																	val:        "List/last",
 																	ignoreCase: false,
 																	want:       "\"List/last\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 277, col: 5, offset: 7272},
+																pos: position{line: 336, col: 5, offset: 9402},
 																run: (*parser).callonLetBinding190,
 																expr: &litMatcher{
-																	pos:        position{line: 277, col: 5, offset: 7272},
+																	pos:        position{line: 336, col: 5, offset: 9402},
 																	val:        "List/indexed",
 																	ignoreCase: false,
 																	want:       "\"List/indexed\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 278, col: 5, offset: 7319},
+																pos: position{line: 337, col: 5, offset: 9449},
 																run: (*parser).callonLetBinding192,
 																expr: &litMatcher{
-																	pos:        position{line: 278, col: 5, offset: 7319},
+																	pos:        position{line: 337, col: 5, offset: 9449},
 																	val:        "List/reverse",
 																	ignoreCase: false,
 																	want:       "\"List/reverse\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 279, col: 5, offset: 7366},
+																pos: position{line: 338, col: 5, offset: 9496},
 																run: (*parser).callonLetBinding194,
 																expr: &litMatcher{
-																	pos:        position{line: 279, col: 5, offset: 7366},
+																	pos:        position{line: 338, col: 5, offset: 9496},
 																	val:        "Text/show",
 																	ignoreCase: false,
 																	want:       "\"Text/show\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 280, col: 5, offset: 7407},
+																pos: position{line: 339, col: 5, offset: 9537},
 																run: (*parser).callonLetBinding196,
 																expr: &litMatcher{
-																	pos:        position{line: 280, col: 5, offset: 7407},
+																	pos:        position{line: 339, col: 5, offset: 9537},
 																	val:        "Text/replace",
 																	ignoreCase: false,
 																	want:       "\"Text/replace\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 281, col: 5, offset: 7454},
+																pos: position{line: 340, col: 5, offset: 9584},
 																run: (*parser).callonLetBinding198,
 																expr: &litMatcher{
-																	pos:        position{line: 281, col: 5, offset: 7454},
+																	pos:        position{line: 340, col: 5, offset: 9584},
 																	val:        "Bool",
 																	ignoreCase: false,
 																	want:       "\"Bool\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 282, col: 5, offset: 7486},
+																pos: position{line: 341, col: 5, offset: 9616},
 																run: (*parser).callonLetBinding200,
 																expr: &litMatcher{
-																	pos:        position{line: 282, col: 5, offset: 7486},
+																	pos:        position{line: 341, col: 5, offset: 9616},
 																	val:        "True",
 																	ignoreCase: false,
 																	want:       "\"True\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 283, col: 5, offset: 7518},
+																pos: position{line: 342, col: 5, offset: 9648},
 																run: (*parser).callonLetBinding202,
 																expr: &litMatcher{
-																	pos:        position{line: 283, col: 5, offset: 7518},
+																	pos:        position{line: 342, col: 5, offset: 9648},
 																	val:        "False",
 																	ignoreCase: false,
 																	want:       "\"False\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 284, col: 5, offset: 7552},
+																pos: position{line: 343, col: 5, offset: 9682},
 																run: (*parser).callonLetBinding204,
 																expr: &litMatcher{
-																	pos:        position{line: 284, col: 5, offset: 7552},
+																	pos:        position{line: 343, col: 5, offset: 9682},
 																	val:        "Optional",
 																	ignoreCase: false,
 																	want:       "\"Optional\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 285, col: 5, offset: 7592},
+																pos: position{line: 344, col: 5, offset: 9722},
 																run: (*parser).callonLetBinding206,
 																expr: &litMatcher{
-																	pos:        position{line: 285, col: 5, offset: 7592},
+																	pos:        position{line: 344, col: 5, offset: 9722},
 																	val:        "None",
 																	ignoreCase: false,
 																	want:       "\"None\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 286, col: 5, offset: 7624},
+																pos: position{line: 345, col: 5, offset: 9754},
 																run: (*parser).callonLetBinding208,
 																expr: &litMatcher{
-																	pos:        position{line: 286, col: 5, offset: 7624},
+																	pos:        position{line: 345, col: 5, offset: 9754},
 																	val:        "Natural",
 																	ignoreCase: false,
 																	want:       "\"Natural\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 287, col: 5, offset: 7662},
+																pos: position{line: 346, col: 5, offset: 9792},
 																run: (*parser).callonLetBinding210,
 																expr: &litMatcher{
-																	pos:        position{line: 287, col: 5, offset: 7662},
+																	pos:        position{line: 346, col: 5, offset: 9792},
 																	val:        "Integer",
 																	ignoreCase: false,
 																	want:       "\"Integer\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 288, col: 5, offset: 7700},
+																pos: position{line: 347, col: 5, offset: 9830},
 																run: (*parser).callonLetBinding212,
 																expr: &litMatcher{
-																	pos:        position{line: 288, col: 5, offset: 7700},
+																	pos:        position{line: 347, col: 5, offset: 9830},
 																	val:        "Double",
 																	ignoreCase: false,
 																	want:       "\"Double\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 289, col: 5, offset: 7736},
+																pos: position{line: 348, col: 5, offset: 9866},
 																run: (*parser).callonLetBinding214,
 																expr: &litMatcher{
-																	pos:        position{line: 289, col: 5, offset: 7736},
+																	pos:        position{line: 348, col: 5, offset: 9866},
 																	val:        "Text",
 																	ignoreCase: false,
 																	want:       "\"Text\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 290, col: 5, offset: 7768},
+																pos: position{line: 349, col: 5, offset: 9898},
 																run: (*parser).callonLetBinding216,
 																expr: &litMatcher{
-																	pos:        position{line: 290, col: 5, offset: 7768},
+																	pos:        position{line: 349, col: 5, offset: 9898},
 																	val:        "List",
 																	ignoreCase: false,
 																	want:       "\"List\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 291, col: 5, offset: 7800},
+																pos: position{line: 350, col: 5, offset: 9930},
 																run: (*parser).callonLetBinding218,
 																expr: &litMatcher{
-																	pos:        position{line: 291, col: 5, offset: 7800},
+																	pos:        position{line: 350, col: 5, offset: 9930},
 																	val:        "Type",
 																	ignoreCase: false,
 																	want:       "\"Type\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 292, col: 5, offset: 7832},
+																pos: position{line: 351, col: 5, offset: 9962},
 																run: (*parser).callonLetBinding220,
 																expr: &litMatcher{
-																	pos:        position{line: 292, col: 5, offset: 7832},
+																	pos:        position{line: 351, col: 5, offset: 9962},
 																	val:        "Kind",
 																	ignoreCase: false,
 																	want:       "\"Kind\"",
 																},
 															},
 															&actionExpr{
-																pos: position{line: 293, col: 5, offset: 7864},
+																pos: position{line: 352, col: 5, offset: 9994},
 																run: (*parser).callonLetBinding222,
 																expr: &litMatcher{
-																	pos:        position{line: 293, col: 5, offset: 7864},
+																	pos:        position{line: 352, col: 5, offset: 9994},
 																	val:        "Sort",
 																	ignoreCase: false,
 																	want:       "\"Sort\"",
@@ -6455,33 +6503,33 @@ var g = &grammar{
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 135, col: 28, offset: 3329},
+													pos:   position{line: 194, col: 28, offset: 5459},
 													label: "label",
 													expr: &choiceExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																run: (*parser).callonLetBinding226,
 																expr: &seqExpr{
-																	pos: position{line: 131, col: 9, offset: 3119},
+																	pos: position{line: 190, col: 9, offset: 5249},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 131, col: 9, offset: 3119},
+																			pos:        position{line: 190, col: 9, offset: 5249},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
-																			pos:   position{line: 131, col: 13, offset: 3123},
+																			pos:   position{line: 190, col: 13, offset: 5253},
 																			label: "label",
 																			expr: &actionExpr{
-																				pos: position{line: 129, col: 15, offset: 3060},
+																				pos: position{line: 188, col: 15, offset: 5190},
 																				run: (*parser).callonLetBinding230,
 																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 129, col: 15, offset: 3060},
+																					pos: position{line: 188, col: 15, offset: 5190},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 128, col: 19, offset: 3023},
+																						pos:        position{line: 187, col: 19, offset: 5153},
 																						val:        "[ -_a-~]",
 																						ranges:     []rune{' ', '_', 'a', '~'},
 																						ignoreCase: false,
@@ -6491,7 +6539,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 131, col: 31, offset: 3141},
+																			pos:        position{line: 190, col: 31, offset: 5271},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
@@ -6500,75 +6548,75 @@ var g = &grammar{
 																},
 															},
 															&actionExpr{
-																pos: position{line: 132, col: 9, offset: 3175},
+																pos: position{line: 191, col: 9, offset: 5305},
 																run: (*parser).callonLetBinding234,
 																expr: &labeledExpr{
-																	pos:   position{line: 132, col: 9, offset: 3175},
+																	pos:   position{line: 191, col: 9, offset: 5305},
 																	label: "label",
 																	expr: &choiceExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				run: (*parser).callonLetBinding237,
 																				expr: &seqExpr{
-																					pos: position{line: 122, col: 15, offset: 2816},
+																					pos: position{line: 181, col: 15, offset: 4946},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 249, col: 5, offset: 6269},
+																							pos: position{line: 308, col: 5, offset: 8399},
 																							alternatives: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 231, col: 6, offset: 5936},
+																									pos:        position{line: 290, col: 6, offset: 8066},
 																									val:        "if",
 																									ignoreCase: false,
 																									want:       "\"if\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 232, col: 8, offset: 5950},
+																									pos:        position{line: 291, col: 8, offset: 8080},
 																									val:        "then",
 																									ignoreCase: false,
 																									want:       "\"then\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 233, col: 8, offset: 5966},
+																									pos:        position{line: 292, col: 8, offset: 8096},
 																									val:        "else",
 																									ignoreCase: false,
 																									want:       "\"else\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 234, col: 7, offset: 5981},
+																									pos:        position{line: 293, col: 7, offset: 8111},
 																									val:        "let",
 																									ignoreCase: false,
 																									want:       "\"let\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 235, col: 6, offset: 5994},
+																									pos:        position{line: 294, col: 6, offset: 8124},
 																									val:        "in",
 																									ignoreCase: false,
 																									want:       "\"in\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 237, col: 9, offset: 6021},
+																									pos:        position{line: 296, col: 9, offset: 8151},
 																									val:        "using",
 																									ignoreCase: false,
 																									want:       "\"using\"",
 																								},
 																								&actionExpr{
-																									pos: position{line: 239, col: 11, offset: 6059},
+																									pos: position{line: 298, col: 11, offset: 8189},
 																									run: (*parser).callonLetBinding246,
 																									expr: &seqExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										exprs: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 239, col: 11, offset: 6059},
+																												pos:        position{line: 298, col: 11, offset: 8189},
 																												val:        "missing",
 																												ignoreCase: false,
 																												want:       "\"missing\"",
 																											},
 																											&notExpr{
-																												pos: position{line: 239, col: 21, offset: 6069},
+																												pos: position{line: 298, col: 21, offset: 8199},
 																												expr: &charClassMatcher{
-																													pos:        position{line: 121, col: 23, offset: 2785},
+																													pos:        position{line: 180, col: 23, offset: 4915},
 																													val:        "[_/-A-Za-z0-9]",
 																													chars:      []rune{'_', '/', '-'},
 																													ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -6580,61 +6628,61 @@ var g = &grammar{
 																									},
 																								},
 																								&litMatcher{
-																									pos:        position{line: 244, col: 10, offset: 6199},
+																									pos:        position{line: 303, col: 10, offset: 8329},
 																									val:        "assert",
 																									ignoreCase: false,
 																									want:       "\"assert\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 236, col: 6, offset: 6006},
+																									pos:        position{line: 295, col: 6, offset: 8136},
 																									val:        "as",
 																									ignoreCase: false,
 																									want:       "\"as\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 240, col: 12, offset: 6129},
+																									pos:        position{line: 299, col: 12, offset: 8259},
 																									val:        "Infinity",
 																									ignoreCase: false,
 																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 241, col: 7, offset: 6148},
+																									pos:        position{line: 300, col: 7, offset: 8278},
 																									val:        "NaN",
 																									ignoreCase: false,
 																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 238, col: 9, offset: 6039},
+																									pos:        position{line: 297, col: 9, offset: 8169},
 																									val:        "merge",
 																									ignoreCase: false,
 																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 242, col: 8, offset: 6163},
+																									pos:        position{line: 301, col: 8, offset: 8293},
 																									val:        "Some",
 																									ignoreCase: false,
 																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 243, col: 9, offset: 6180},
+																									pos:        position{line: 302, col: 9, offset: 8310},
 																									val:        "toMap",
 																									ignoreCase: false,
 																									want:       "\"toMap\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 10, offset: 6219},
+																									pos:        position{line: 304, col: 10, offset: 8349},
 																									val:        "forall",
 																									ignoreCase: false,
 																									want:       "\"forall\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 21, offset: 6230},
+																									pos:        position{line: 304, col: 21, offset: 8360},
 																									val:        "∀",
 																									ignoreCase: false,
 																									want:       "\"∀\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 246, col: 8, offset: 6245},
+																									pos:        position{line: 305, col: 8, offset: 8375},
 																									val:        "with",
 																									ignoreCase: false,
 																									want:       "\"with\"",
@@ -6642,9 +6690,9 @@ var g = &grammar{
 																							},
 																						},
 																						&oneOrMoreExpr{
-																							pos: position{line: 122, col: 23, offset: 2824},
+																							pos: position{line: 181, col: 23, offset: 4954},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -6656,68 +6704,68 @@ var g = &grammar{
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				run: (*parser).callonLetBinding263,
 																				expr: &seqExpr{
-																					pos: position{line: 123, col: 13, offset: 2888},
+																					pos: position{line: 182, col: 13, offset: 5018},
 																					exprs: []interface{}{
 																						&notExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							expr: &choiceExpr{
-																								pos: position{line: 249, col: 5, offset: 6269},
+																								pos: position{line: 308, col: 5, offset: 8399},
 																								alternatives: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 231, col: 6, offset: 5936},
+																										pos:        position{line: 290, col: 6, offset: 8066},
 																										val:        "if",
 																										ignoreCase: false,
 																										want:       "\"if\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 232, col: 8, offset: 5950},
+																										pos:        position{line: 291, col: 8, offset: 8080},
 																										val:        "then",
 																										ignoreCase: false,
 																										want:       "\"then\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 233, col: 8, offset: 5966},
+																										pos:        position{line: 292, col: 8, offset: 8096},
 																										val:        "else",
 																										ignoreCase: false,
 																										want:       "\"else\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 234, col: 7, offset: 5981},
+																										pos:        position{line: 293, col: 7, offset: 8111},
 																										val:        "let",
 																										ignoreCase: false,
 																										want:       "\"let\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 235, col: 6, offset: 5994},
+																										pos:        position{line: 294, col: 6, offset: 8124},
 																										val:        "in",
 																										ignoreCase: false,
 																										want:       "\"in\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 237, col: 9, offset: 6021},
+																										pos:        position{line: 296, col: 9, offset: 8151},
 																										val:        "using",
 																										ignoreCase: false,
 																										want:       "\"using\"",
 																									},
 																									&actionExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										run: (*parser).callonLetBinding273,
 																										expr: &seqExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											exprs: []interface{}{
 																												&litMatcher{
-																													pos:        position{line: 239, col: 11, offset: 6059},
+																													pos:        position{line: 298, col: 11, offset: 8189},
 																													val:        "missing",
 																													ignoreCase: false,
 																													want:       "\"missing\"",
 																												},
 																												&notExpr{
-																													pos: position{line: 239, col: 21, offset: 6069},
+																													pos: position{line: 298, col: 21, offset: 8199},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 121, col: 23, offset: 2785},
+																														pos:        position{line: 180, col: 23, offset: 4915},
 																														val:        "[_/-A-Za-z0-9]",
 																														chars:      []rune{'_', '/', '-'},
 																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -6729,61 +6777,61 @@ var g = &grammar{
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 244, col: 10, offset: 6199},
+																										pos:        position{line: 303, col: 10, offset: 8329},
 																										val:        "assert",
 																										ignoreCase: false,
 																										want:       "\"assert\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 236, col: 6, offset: 6006},
+																										pos:        position{line: 295, col: 6, offset: 8136},
 																										val:        "as",
 																										ignoreCase: false,
 																										want:       "\"as\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 240, col: 12, offset: 6129},
+																										pos:        position{line: 299, col: 12, offset: 8259},
 																										val:        "Infinity",
 																										ignoreCase: false,
 																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 241, col: 7, offset: 6148},
+																										pos:        position{line: 300, col: 7, offset: 8278},
 																										val:        "NaN",
 																										ignoreCase: false,
 																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 238, col: 9, offset: 6039},
+																										pos:        position{line: 297, col: 9, offset: 8169},
 																										val:        "merge",
 																										ignoreCase: false,
 																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 242, col: 8, offset: 6163},
+																										pos:        position{line: 301, col: 8, offset: 8293},
 																										val:        "Some",
 																										ignoreCase: false,
 																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 243, col: 9, offset: 6180},
+																										pos:        position{line: 302, col: 9, offset: 8310},
 																										val:        "toMap",
 																										ignoreCase: false,
 																										want:       "\"toMap\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 10, offset: 6219},
+																										pos:        position{line: 304, col: 10, offset: 8349},
 																										val:        "forall",
 																										ignoreCase: false,
 																										want:       "\"forall\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 21, offset: 6230},
+																										pos:        position{line: 304, col: 21, offset: 8360},
 																										val:        "∀",
 																										ignoreCase: false,
 																										want:       "\"∀\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 246, col: 8, offset: 6245},
+																										pos:        position{line: 305, col: 8, offset: 8375},
 																										val:        "with",
 																										ignoreCase: false,
 																										want:       "\"with\"",
@@ -6792,7 +6840,7 @@ var g = &grammar{
 																							},
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 120, col: 24, offset: 2751},
+																							pos:        position{line: 179, col: 24, offset: 4881},
 																							val:        "[_A-Za-z]",
 																							chars:      []rune{'_'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -6800,9 +6848,9 @@ var g = &grammar{
 																							inverted:   false,
 																						},
 																						&zeroOrMoreExpr{
-																							pos: position{line: 123, col: 43, offset: 2918},
+																							pos: position{line: 182, col: 43, offset: 5048},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -6827,23 +6875,23 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 495, col: 44, offset: 14103},
+							pos:  position{line: 554, col: 44, offset: 16233},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 495, col: 46, offset: 14105},
+							pos:   position{line: 554, col: 46, offset: 16235},
 							label: "a",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 495, col: 48, offset: 14107},
+								pos: position{line: 554, col: 48, offset: 16237},
 								expr: &seqExpr{
-									pos: position{line: 495, col: 49, offset: 14108},
+									pos: position{line: 554, col: 49, offset: 16238},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 495, col: 49, offset: 14108},
+											pos:  position{line: 554, col: 49, offset: 16238},
 											name: "Annotation",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 495, col: 60, offset: 14119},
+											pos:  position{line: 554, col: 60, offset: 16249},
 											name: "_",
 										},
 									},
@@ -6851,25 +6899,25 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 496, col: 13, offset: 14135},
+							pos:        position{line: 555, col: 13, offset: 16265},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 496, col: 17, offset: 14139},
+							pos:  position{line: 555, col: 17, offset: 16269},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 496, col: 19, offset: 14141},
+							pos:   position{line: 555, col: 19, offset: 16271},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 496, col: 21, offset: 14143},
+								pos:  position{line: 555, col: 21, offset: 16273},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 496, col: 32, offset: 14154},
+							pos:  position{line: 555, col: 32, offset: 16284},
 							name: "_",
 						},
 					},
@@ -6878,402 +6926,402 @@ var g = &grammar{
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 511, col: 1, offset: 14463},
+			pos:  position{line: 570, col: 1, offset: 16645},
 			expr: &choiceExpr{
-				pos: position{line: 512, col: 7, offset: 14484},
+				pos: position{line: 571, col: 7, offset: 16666},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 512, col: 7, offset: 14484},
+						pos: position{line: 571, col: 7, offset: 16666},
 						run: (*parser).callonExpression2,
 						expr: &seqExpr{
-							pos: position{line: 512, col: 7, offset: 14484},
+							pos: position{line: 571, col: 7, offset: 16666},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 302, col: 10, offset: 8058},
+									pos:        position{line: 361, col: 10, offset: 10188},
 									val:        "[\\\\λ]",
 									chars:      []rune{'\\', 'λ'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 14, offset: 14491},
+									pos:  position{line: 571, col: 14, offset: 16673},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 512, col: 16, offset: 14493},
+									pos:        position{line: 571, col: 16, offset: 16675},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 20, offset: 14497},
+									pos:  position{line: 571, col: 20, offset: 16679},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 512, col: 22, offset: 14499},
+									pos:   position{line: 571, col: 22, offset: 16681},
 									label: "label",
 									expr: &choiceExpr{
-										pos: position{line: 134, col: 20, offset: 3237},
+										pos: position{line: 193, col: 20, offset: 5367},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 134, col: 20, offset: 3237},
+												pos: position{line: 193, col: 20, offset: 5367},
 												run: (*parser).callonExpression10,
 												expr: &seqExpr{
-													pos: position{line: 134, col: 20, offset: 3237},
+													pos: position{line: 193, col: 20, offset: 5367},
 													exprs: []interface{}{
 														&andExpr{
-															pos: position{line: 134, col: 20, offset: 3237},
+															pos: position{line: 193, col: 20, offset: 5367},
 															expr: &seqExpr{
-																pos: position{line: 134, col: 22, offset: 3239},
+																pos: position{line: 193, col: 22, offset: 5369},
 																exprs: []interface{}{
 																	&choiceExpr{
-																		pos: position{line: 259, col: 5, offset: 6416},
+																		pos: position{line: 318, col: 5, offset: 8546},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 259, col: 5, offset: 6416},
+																				pos: position{line: 318, col: 5, offset: 8546},
 																				run: (*parser).callonExpression15,
 																				expr: &litMatcher{
-																					pos:        position{line: 259, col: 5, offset: 6416},
+																					pos:        position{line: 318, col: 5, offset: 8546},
 																					val:        "Natural/fold",
 																					ignoreCase: false,
 																					want:       "\"Natural/fold\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 260, col: 5, offset: 6463},
+																				pos: position{line: 319, col: 5, offset: 8593},
 																				run: (*parser).callonExpression17,
 																				expr: &litMatcher{
-																					pos:        position{line: 260, col: 5, offset: 6463},
+																					pos:        position{line: 319, col: 5, offset: 8593},
 																					val:        "Natural/build",
 																					ignoreCase: false,
 																					want:       "\"Natural/build\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 261, col: 5, offset: 6512},
+																				pos: position{line: 320, col: 5, offset: 8642},
 																				run: (*parser).callonExpression19,
 																				expr: &litMatcher{
-																					pos:        position{line: 261, col: 5, offset: 6512},
+																					pos:        position{line: 320, col: 5, offset: 8642},
 																					val:        "Natural/isZero",
 																					ignoreCase: false,
 																					want:       "\"Natural/isZero\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 262, col: 5, offset: 6563},
+																				pos: position{line: 321, col: 5, offset: 8693},
 																				run: (*parser).callonExpression21,
 																				expr: &litMatcher{
-																					pos:        position{line: 262, col: 5, offset: 6563},
+																					pos:        position{line: 321, col: 5, offset: 8693},
 																					val:        "Natural/even",
 																					ignoreCase: false,
 																					want:       "\"Natural/even\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 263, col: 5, offset: 6610},
+																				pos: position{line: 322, col: 5, offset: 8740},
 																				run: (*parser).callonExpression23,
 																				expr: &litMatcher{
-																					pos:        position{line: 263, col: 5, offset: 6610},
+																					pos:        position{line: 322, col: 5, offset: 8740},
 																					val:        "Natural/odd",
 																					ignoreCase: false,
 																					want:       "\"Natural/odd\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 264, col: 5, offset: 6655},
+																				pos: position{line: 323, col: 5, offset: 8785},
 																				run: (*parser).callonExpression25,
 																				expr: &litMatcher{
-																					pos:        position{line: 264, col: 5, offset: 6655},
+																					pos:        position{line: 323, col: 5, offset: 8785},
 																					val:        "Natural/toInteger",
 																					ignoreCase: false,
 																					want:       "\"Natural/toInteger\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 265, col: 5, offset: 6712},
+																				pos: position{line: 324, col: 5, offset: 8842},
 																				run: (*parser).callonExpression27,
 																				expr: &litMatcher{
-																					pos:        position{line: 265, col: 5, offset: 6712},
+																					pos:        position{line: 324, col: 5, offset: 8842},
 																					val:        "Natural/show",
 																					ignoreCase: false,
 																					want:       "\"Natural/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 266, col: 5, offset: 6759},
+																				pos: position{line: 325, col: 5, offset: 8889},
 																				run: (*parser).callonExpression29,
 																				expr: &litMatcher{
-																					pos:        position{line: 266, col: 5, offset: 6759},
+																					pos:        position{line: 325, col: 5, offset: 8889},
 																					val:        "Integer/toDouble",
 																					ignoreCase: false,
 																					want:       "\"Integer/toDouble\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 267, col: 5, offset: 6814},
+																				pos: position{line: 326, col: 5, offset: 8944},
 																				run: (*parser).callonExpression31,
 																				expr: &litMatcher{
-																					pos:        position{line: 267, col: 5, offset: 6814},
+																					pos:        position{line: 326, col: 5, offset: 8944},
 																					val:        "Integer/show",
 																					ignoreCase: false,
 																					want:       "\"Integer/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 268, col: 5, offset: 6861},
+																				pos: position{line: 327, col: 5, offset: 8991},
 																				run: (*parser).callonExpression33,
 																				expr: &litMatcher{
-																					pos:        position{line: 268, col: 5, offset: 6861},
+																					pos:        position{line: 327, col: 5, offset: 8991},
 																					val:        "Integer/negate",
 																					ignoreCase: false,
 																					want:       "\"Integer/negate\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 269, col: 5, offset: 6912},
+																				pos: position{line: 328, col: 5, offset: 9042},
 																				run: (*parser).callonExpression35,
 																				expr: &litMatcher{
-																					pos:        position{line: 269, col: 5, offset: 6912},
+																					pos:        position{line: 328, col: 5, offset: 9042},
 																					val:        "Integer/clamp",
 																					ignoreCase: false,
 																					want:       "\"Integer/clamp\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 270, col: 5, offset: 6961},
+																				pos: position{line: 329, col: 5, offset: 9091},
 																				run: (*parser).callonExpression37,
 																				expr: &litMatcher{
-																					pos:        position{line: 270, col: 5, offset: 6961},
+																					pos:        position{line: 329, col: 5, offset: 9091},
 																					val:        "Natural/subtract",
 																					ignoreCase: false,
 																					want:       "\"Natural/subtract\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 271, col: 5, offset: 7016},
+																				pos: position{line: 330, col: 5, offset: 9146},
 																				run: (*parser).callonExpression39,
 																				expr: &litMatcher{
-																					pos:        position{line: 271, col: 5, offset: 7016},
+																					pos:        position{line: 330, col: 5, offset: 9146},
 																					val:        "Double/show",
 																					ignoreCase: false,
 																					want:       "\"Double/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 272, col: 5, offset: 7061},
+																				pos: position{line: 331, col: 5, offset: 9191},
 																				run: (*parser).callonExpression41,
 																				expr: &litMatcher{
-																					pos:        position{line: 272, col: 5, offset: 7061},
+																					pos:        position{line: 331, col: 5, offset: 9191},
 																					val:        "List/build",
 																					ignoreCase: false,
 																					want:       "\"List/build\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 273, col: 5, offset: 7104},
+																				pos: position{line: 332, col: 5, offset: 9234},
 																				run: (*parser).callonExpression43,
 																				expr: &litMatcher{
-																					pos:        position{line: 273, col: 5, offset: 7104},
+																					pos:        position{line: 332, col: 5, offset: 9234},
 																					val:        "List/fold",
 																					ignoreCase: false,
 																					want:       "\"List/fold\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 274, col: 5, offset: 7145},
+																				pos: position{line: 333, col: 5, offset: 9275},
 																				run: (*parser).callonExpression45,
 																				expr: &litMatcher{
-																					pos:        position{line: 274, col: 5, offset: 7145},
+																					pos:        position{line: 333, col: 5, offset: 9275},
 																					val:        "List/length",
 																					ignoreCase: false,
 																					want:       "\"List/length\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 275, col: 5, offset: 7190},
+																				pos: position{line: 334, col: 5, offset: 9320},
 																				run: (*parser).callonExpression47,
 																				expr: &litMatcher{
-																					pos:        position{line: 275, col: 5, offset: 7190},
+																					pos:        position{line: 334, col: 5, offset: 9320},
 																					val:        "List/head",
 																					ignoreCase: false,
 																					want:       "\"List/head\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 276, col: 5, offset: 7231},
+																				pos: position{line: 335, col: 5, offset: 9361},
 																				run: (*parser).callonExpression49,
 																				expr: &litMatcher{
-																					pos:        position{line: 276, col: 5, offset: 7231},
+																					pos:        position{line: 335, col: 5, offset: 9361},
 																					val:        "List/last",
 																					ignoreCase: false,
 																					want:       "\"List/last\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 277, col: 5, offset: 7272},
+																				pos: position{line: 336, col: 5, offset: 9402},
 																				run: (*parser).callonExpression51,
 																				expr: &litMatcher{
-																					pos:        position{line: 277, col: 5, offset: 7272},
+																					pos:        position{line: 336, col: 5, offset: 9402},
 																					val:        "List/indexed",
 																					ignoreCase: false,
 																					want:       "\"List/indexed\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 278, col: 5, offset: 7319},
+																				pos: position{line: 337, col: 5, offset: 9449},
 																				run: (*parser).callonExpression53,
 																				expr: &litMatcher{
-																					pos:        position{line: 278, col: 5, offset: 7319},
+																					pos:        position{line: 337, col: 5, offset: 9449},
 																					val:        "List/reverse",
 																					ignoreCase: false,
 																					want:       "\"List/reverse\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 279, col: 5, offset: 7366},
+																				pos: position{line: 338, col: 5, offset: 9496},
 																				run: (*parser).callonExpression55,
 																				expr: &litMatcher{
-																					pos:        position{line: 279, col: 5, offset: 7366},
+																					pos:        position{line: 338, col: 5, offset: 9496},
 																					val:        "Text/show",
 																					ignoreCase: false,
 																					want:       "\"Text/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 280, col: 5, offset: 7407},
+																				pos: position{line: 339, col: 5, offset: 9537},
 																				run: (*parser).callonExpression57,
 																				expr: &litMatcher{
-																					pos:        position{line: 280, col: 5, offset: 7407},
+																					pos:        position{line: 339, col: 5, offset: 9537},
 																					val:        "Text/replace",
 																					ignoreCase: false,
 																					want:       "\"Text/replace\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 281, col: 5, offset: 7454},
+																				pos: position{line: 340, col: 5, offset: 9584},
 																				run: (*parser).callonExpression59,
 																				expr: &litMatcher{
-																					pos:        position{line: 281, col: 5, offset: 7454},
+																					pos:        position{line: 340, col: 5, offset: 9584},
 																					val:        "Bool",
 																					ignoreCase: false,
 																					want:       "\"Bool\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 282, col: 5, offset: 7486},
+																				pos: position{line: 341, col: 5, offset: 9616},
 																				run: (*parser).callonExpression61,
 																				expr: &litMatcher{
-																					pos:        position{line: 282, col: 5, offset: 7486},
+																					pos:        position{line: 341, col: 5, offset: 9616},
 																					val:        "True",
 																					ignoreCase: false,
 																					want:       "\"True\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 283, col: 5, offset: 7518},
+																				pos: position{line: 342, col: 5, offset: 9648},
 																				run: (*parser).callonExpression63,
 																				expr: &litMatcher{
-																					pos:        position{line: 283, col: 5, offset: 7518},
+																					pos:        position{line: 342, col: 5, offset: 9648},
 																					val:        "False",
 																					ignoreCase: false,
 																					want:       "\"False\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 284, col: 5, offset: 7552},
+																				pos: position{line: 343, col: 5, offset: 9682},
 																				run: (*parser).callonExpression65,
 																				expr: &litMatcher{
-																					pos:        position{line: 284, col: 5, offset: 7552},
+																					pos:        position{line: 343, col: 5, offset: 9682},
 																					val:        "Optional",
 																					ignoreCase: false,
 																					want:       "\"Optional\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 285, col: 5, offset: 7592},
+																				pos: position{line: 344, col: 5, offset: 9722},
 																				run: (*parser).callonExpression67,
 																				expr: &litMatcher{
-																					pos:        position{line: 285, col: 5, offset: 7592},
+																					pos:        position{line: 344, col: 5, offset: 9722},
 																					val:        "None",
 																					ignoreCase: false,
 																					want:       "\"None\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 286, col: 5, offset: 7624},
+																				pos: position{line: 345, col: 5, offset: 9754},
 																				run: (*parser).callonExpression69,
 																				expr: &litMatcher{
-																					pos:        position{line: 286, col: 5, offset: 7624},
+																					pos:        position{line: 345, col: 5, offset: 9754},
 																					val:        "Natural",
 																					ignoreCase: false,
 																					want:       "\"Natural\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 287, col: 5, offset: 7662},
+																				pos: position{line: 346, col: 5, offset: 9792},
 																				run: (*parser).callonExpression71,
 																				expr: &litMatcher{
-																					pos:        position{line: 287, col: 5, offset: 7662},
+																					pos:        position{line: 346, col: 5, offset: 9792},
 																					val:        "Integer",
 																					ignoreCase: false,
 																					want:       "\"Integer\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 288, col: 5, offset: 7700},
+																				pos: position{line: 347, col: 5, offset: 9830},
 																				run: (*parser).callonExpression73,
 																				expr: &litMatcher{
-																					pos:        position{line: 288, col: 5, offset: 7700},
+																					pos:        position{line: 347, col: 5, offset: 9830},
 																					val:        "Double",
 																					ignoreCase: false,
 																					want:       "\"Double\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 289, col: 5, offset: 7736},
+																				pos: position{line: 348, col: 5, offset: 9866},
 																				run: (*parser).callonExpression75,
 																				expr: &litMatcher{
-																					pos:        position{line: 289, col: 5, offset: 7736},
+																					pos:        position{line: 348, col: 5, offset: 9866},
 																					val:        "Text",
 																					ignoreCase: false,
 																					want:       "\"Text\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 290, col: 5, offset: 7768},
+																				pos: position{line: 349, col: 5, offset: 9898},
 																				run: (*parser).callonExpression77,
 																				expr: &litMatcher{
-																					pos:        position{line: 290, col: 5, offset: 7768},
+																					pos:        position{line: 349, col: 5, offset: 9898},
 																					val:        "List",
 																					ignoreCase: false,
 																					want:       "\"List\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 291, col: 5, offset: 7800},
+																				pos: position{line: 350, col: 5, offset: 9930},
 																				run: (*parser).callonExpression79,
 																				expr: &litMatcher{
-																					pos:        position{line: 291, col: 5, offset: 7800},
+																					pos:        position{line: 350, col: 5, offset: 9930},
 																					val:        "Type",
 																					ignoreCase: false,
 																					want:       "\"Type\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 292, col: 5, offset: 7832},
+																				pos: position{line: 351, col: 5, offset: 9962},
 																				run: (*parser).callonExpression81,
 																				expr: &litMatcher{
-																					pos:        position{line: 292, col: 5, offset: 7832},
+																					pos:        position{line: 351, col: 5, offset: 9962},
 																					val:        "Kind",
 																					ignoreCase: false,
 																					want:       "\"Kind\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 293, col: 5, offset: 7864},
+																				pos: position{line: 352, col: 5, offset: 9994},
 																				run: (*parser).callonExpression83,
 																				expr: &litMatcher{
-																					pos:        position{line: 293, col: 5, offset: 7864},
+																					pos:        position{line: 352, col: 5, offset: 9994},
 																					val:        "Sort",
 																					ignoreCase: false,
 																					want:       "\"Sort\"",
@@ -7282,7 +7330,7 @@ var g = &grammar{
 																		},
 																	},
 																	&charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -7293,33 +7341,33 @@ var g = &grammar{
 															},
 														},
 														&labeledExpr{
-															pos:   position{line: 134, col: 51, offset: 3268},
+															pos:   position{line: 193, col: 51, offset: 5398},
 															label: "label",
 															expr: &choiceExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 131, col: 9, offset: 3119},
+																		pos: position{line: 190, col: 9, offset: 5249},
 																		run: (*parser).callonExpression88,
 																		expr: &seqExpr{
-																			pos: position{line: 131, col: 9, offset: 3119},
+																			pos: position{line: 190, col: 9, offset: 5249},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 131, col: 9, offset: 3119},
+																					pos:        position{line: 190, col: 9, offset: 5249},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
 																				},
 																				&labeledExpr{
-																					pos:   position{line: 131, col: 13, offset: 3123},
+																					pos:   position{line: 190, col: 13, offset: 5253},
 																					label: "label",
 																					expr: &actionExpr{
-																						pos: position{line: 129, col: 15, offset: 3060},
+																						pos: position{line: 188, col: 15, offset: 5190},
 																						run: (*parser).callonExpression92,
 																						expr: &zeroOrMoreExpr{
-																							pos: position{line: 129, col: 15, offset: 3060},
+																							pos: position{line: 188, col: 15, offset: 5190},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 128, col: 19, offset: 3023},
+																								pos:        position{line: 187, col: 19, offset: 5153},
 																								val:        "[ -_a-~]",
 																								ranges:     []rune{' ', '_', 'a', '~'},
 																								ignoreCase: false,
@@ -7329,7 +7377,7 @@ var g = &grammar{
 																					},
 																				},
 																				&litMatcher{
-																					pos:        position{line: 131, col: 31, offset: 3141},
+																					pos:        position{line: 190, col: 31, offset: 5271},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
@@ -7338,75 +7386,75 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 132, col: 9, offset: 3175},
+																		pos: position{line: 191, col: 9, offset: 5305},
 																		run: (*parser).callonExpression96,
 																		expr: &labeledExpr{
-																			pos:   position{line: 132, col: 9, offset: 3175},
+																			pos:   position{line: 191, col: 9, offset: 5305},
 																			label: "label",
 																			expr: &choiceExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				alternatives: []interface{}{
 																					&actionExpr{
-																						pos: position{line: 122, col: 15, offset: 2816},
+																						pos: position{line: 181, col: 15, offset: 4946},
 																						run: (*parser).callonExpression99,
 																						expr: &seqExpr{
-																							pos: position{line: 122, col: 15, offset: 2816},
+																							pos: position{line: 181, col: 15, offset: 4946},
 																							exprs: []interface{}{
 																								&choiceExpr{
-																									pos: position{line: 249, col: 5, offset: 6269},
+																									pos: position{line: 308, col: 5, offset: 8399},
 																									alternatives: []interface{}{
 																										&litMatcher{
-																											pos:        position{line: 231, col: 6, offset: 5936},
+																											pos:        position{line: 290, col: 6, offset: 8066},
 																											val:        "if",
 																											ignoreCase: false,
 																											want:       "\"if\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 232, col: 8, offset: 5950},
+																											pos:        position{line: 291, col: 8, offset: 8080},
 																											val:        "then",
 																											ignoreCase: false,
 																											want:       "\"then\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 233, col: 8, offset: 5966},
+																											pos:        position{line: 292, col: 8, offset: 8096},
 																											val:        "else",
 																											ignoreCase: false,
 																											want:       "\"else\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 234, col: 7, offset: 5981},
+																											pos:        position{line: 293, col: 7, offset: 8111},
 																											val:        "let",
 																											ignoreCase: false,
 																											want:       "\"let\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 235, col: 6, offset: 5994},
+																											pos:        position{line: 294, col: 6, offset: 8124},
 																											val:        "in",
 																											ignoreCase: false,
 																											want:       "\"in\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 237, col: 9, offset: 6021},
+																											pos:        position{line: 296, col: 9, offset: 8151},
 																											val:        "using",
 																											ignoreCase: false,
 																											want:       "\"using\"",
 																										},
 																										&actionExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											run: (*parser).callonExpression108,
 																											expr: &seqExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												exprs: []interface{}{
 																													&litMatcher{
-																														pos:        position{line: 239, col: 11, offset: 6059},
+																														pos:        position{line: 298, col: 11, offset: 8189},
 																														val:        "missing",
 																														ignoreCase: false,
 																														want:       "\"missing\"",
 																													},
 																													&notExpr{
-																														pos: position{line: 239, col: 21, offset: 6069},
+																														pos: position{line: 298, col: 21, offset: 8199},
 																														expr: &charClassMatcher{
-																															pos:        position{line: 121, col: 23, offset: 2785},
+																															pos:        position{line: 180, col: 23, offset: 4915},
 																															val:        "[_/-A-Za-z0-9]",
 																															chars:      []rune{'_', '/', '-'},
 																															ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -7418,61 +7466,61 @@ var g = &grammar{
 																											},
 																										},
 																										&litMatcher{
-																											pos:        position{line: 244, col: 10, offset: 6199},
+																											pos:        position{line: 303, col: 10, offset: 8329},
 																											val:        "assert",
 																											ignoreCase: false,
 																											want:       "\"assert\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 236, col: 6, offset: 6006},
+																											pos:        position{line: 295, col: 6, offset: 8136},
 																											val:        "as",
 																											ignoreCase: false,
 																											want:       "\"as\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 240, col: 12, offset: 6129},
+																											pos:        position{line: 299, col: 12, offset: 8259},
 																											val:        "Infinity",
 																											ignoreCase: false,
 																											want:       "\"Infinity\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 241, col: 7, offset: 6148},
+																											pos:        position{line: 300, col: 7, offset: 8278},
 																											val:        "NaN",
 																											ignoreCase: false,
 																											want:       "\"NaN\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 238, col: 9, offset: 6039},
+																											pos:        position{line: 297, col: 9, offset: 8169},
 																											val:        "merge",
 																											ignoreCase: false,
 																											want:       "\"merge\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 242, col: 8, offset: 6163},
+																											pos:        position{line: 301, col: 8, offset: 8293},
 																											val:        "Some",
 																											ignoreCase: false,
 																											want:       "\"Some\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 243, col: 9, offset: 6180},
+																											pos:        position{line: 302, col: 9, offset: 8310},
 																											val:        "toMap",
 																											ignoreCase: false,
 																											want:       "\"toMap\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 10, offset: 6219},
+																											pos:        position{line: 304, col: 10, offset: 8349},
 																											val:        "forall",
 																											ignoreCase: false,
 																											want:       "\"forall\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 21, offset: 6230},
+																											pos:        position{line: 304, col: 21, offset: 8360},
 																											val:        "∀",
 																											ignoreCase: false,
 																											want:       "\"∀\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 246, col: 8, offset: 6245},
+																											pos:        position{line: 305, col: 8, offset: 8375},
 																											val:        "with",
 																											ignoreCase: false,
 																											want:       "\"with\"",
@@ -7480,9 +7528,9 @@ var g = &grammar{
 																									},
 																								},
 																								&oneOrMoreExpr{
-																									pos: position{line: 122, col: 23, offset: 2824},
+																									pos: position{line: 181, col: 23, offset: 4954},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -7494,68 +7542,68 @@ var g = &grammar{
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 123, col: 13, offset: 2888},
+																						pos: position{line: 182, col: 13, offset: 5018},
 																						run: (*parser).callonExpression125,
 																						expr: &seqExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							exprs: []interface{}{
 																								&notExpr{
-																									pos: position{line: 123, col: 13, offset: 2888},
+																									pos: position{line: 182, col: 13, offset: 5018},
 																									expr: &choiceExpr{
-																										pos: position{line: 249, col: 5, offset: 6269},
+																										pos: position{line: 308, col: 5, offset: 8399},
 																										alternatives: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 231, col: 6, offset: 5936},
+																												pos:        position{line: 290, col: 6, offset: 8066},
 																												val:        "if",
 																												ignoreCase: false,
 																												want:       "\"if\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 232, col: 8, offset: 5950},
+																												pos:        position{line: 291, col: 8, offset: 8080},
 																												val:        "then",
 																												ignoreCase: false,
 																												want:       "\"then\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 233, col: 8, offset: 5966},
+																												pos:        position{line: 292, col: 8, offset: 8096},
 																												val:        "else",
 																												ignoreCase: false,
 																												want:       "\"else\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 234, col: 7, offset: 5981},
+																												pos:        position{line: 293, col: 7, offset: 8111},
 																												val:        "let",
 																												ignoreCase: false,
 																												want:       "\"let\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 235, col: 6, offset: 5994},
+																												pos:        position{line: 294, col: 6, offset: 8124},
 																												val:        "in",
 																												ignoreCase: false,
 																												want:       "\"in\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 237, col: 9, offset: 6021},
+																												pos:        position{line: 296, col: 9, offset: 8151},
 																												val:        "using",
 																												ignoreCase: false,
 																												want:       "\"using\"",
 																											},
 																											&actionExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												run: (*parser).callonExpression135,
 																												expr: &seqExpr{
-																													pos: position{line: 239, col: 11, offset: 6059},
+																													pos: position{line: 298, col: 11, offset: 8189},
 																													exprs: []interface{}{
 																														&litMatcher{
-																															pos:        position{line: 239, col: 11, offset: 6059},
+																															pos:        position{line: 298, col: 11, offset: 8189},
 																															val:        "missing",
 																															ignoreCase: false,
 																															want:       "\"missing\"",
 																														},
 																														&notExpr{
-																															pos: position{line: 239, col: 21, offset: 6069},
+																															pos: position{line: 298, col: 21, offset: 8199},
 																															expr: &charClassMatcher{
-																																pos:        position{line: 121, col: 23, offset: 2785},
+																																pos:        position{line: 180, col: 23, offset: 4915},
 																																val:        "[_/-A-Za-z0-9]",
 																																chars:      []rune{'_', '/', '-'},
 																																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -7567,61 +7615,61 @@ var g = &grammar{
 																												},
 																											},
 																											&litMatcher{
-																												pos:        position{line: 244, col: 10, offset: 6199},
+																												pos:        position{line: 303, col: 10, offset: 8329},
 																												val:        "assert",
 																												ignoreCase: false,
 																												want:       "\"assert\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 236, col: 6, offset: 6006},
+																												pos:        position{line: 295, col: 6, offset: 8136},
 																												val:        "as",
 																												ignoreCase: false,
 																												want:       "\"as\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 240, col: 12, offset: 6129},
+																												pos:        position{line: 299, col: 12, offset: 8259},
 																												val:        "Infinity",
 																												ignoreCase: false,
 																												want:       "\"Infinity\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 241, col: 7, offset: 6148},
+																												pos:        position{line: 300, col: 7, offset: 8278},
 																												val:        "NaN",
 																												ignoreCase: false,
 																												want:       "\"NaN\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 238, col: 9, offset: 6039},
+																												pos:        position{line: 297, col: 9, offset: 8169},
 																												val:        "merge",
 																												ignoreCase: false,
 																												want:       "\"merge\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 242, col: 8, offset: 6163},
+																												pos:        position{line: 301, col: 8, offset: 8293},
 																												val:        "Some",
 																												ignoreCase: false,
 																												want:       "\"Some\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 243, col: 9, offset: 6180},
+																												pos:        position{line: 302, col: 9, offset: 8310},
 																												val:        "toMap",
 																												ignoreCase: false,
 																												want:       "\"toMap\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 10, offset: 6219},
+																												pos:        position{line: 304, col: 10, offset: 8349},
 																												val:        "forall",
 																												ignoreCase: false,
 																												want:       "\"forall\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 21, offset: 6230},
+																												pos:        position{line: 304, col: 21, offset: 8360},
 																												val:        "∀",
 																												ignoreCase: false,
 																												want:       "\"∀\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 246, col: 8, offset: 6245},
+																												pos:        position{line: 305, col: 8, offset: 8375},
 																												val:        "with",
 																												ignoreCase: false,
 																												want:       "\"with\"",
@@ -7630,7 +7678,7 @@ var g = &grammar{
 																									},
 																								},
 																								&charClassMatcher{
-																									pos:        position{line: 120, col: 24, offset: 2751},
+																									pos:        position{line: 179, col: 24, offset: 4881},
 																									val:        "[_A-Za-z]",
 																									chars:      []rune{'_'},
 																									ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -7638,9 +7686,9 @@ var g = &grammar{
 																									inverted:   false,
 																								},
 																								&zeroOrMoreExpr{
-																									pos: position{line: 123, col: 43, offset: 2918},
+																									pos: position{line: 182, col: 43, offset: 5048},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -7662,361 +7710,361 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 135, col: 19, offset: 3320},
+												pos: position{line: 194, col: 19, offset: 5450},
 												run: (*parser).callonExpression153,
 												expr: &seqExpr{
-													pos: position{line: 135, col: 19, offset: 3320},
+													pos: position{line: 194, col: 19, offset: 5450},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 135, col: 19, offset: 3320},
+															pos: position{line: 194, col: 19, offset: 5450},
 															expr: &choiceExpr{
-																pos: position{line: 259, col: 5, offset: 6416},
+																pos: position{line: 318, col: 5, offset: 8546},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 259, col: 5, offset: 6416},
+																		pos: position{line: 318, col: 5, offset: 8546},
 																		run: (*parser).callonExpression157,
 																		expr: &litMatcher{
-																			pos:        position{line: 259, col: 5, offset: 6416},
+																			pos:        position{line: 318, col: 5, offset: 8546},
 																			val:        "Natural/fold",
 																			ignoreCase: false,
 																			want:       "\"Natural/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 260, col: 5, offset: 6463},
+																		pos: position{line: 319, col: 5, offset: 8593},
 																		run: (*parser).callonExpression159,
 																		expr: &litMatcher{
-																			pos:        position{line: 260, col: 5, offset: 6463},
+																			pos:        position{line: 319, col: 5, offset: 8593},
 																			val:        "Natural/build",
 																			ignoreCase: false,
 																			want:       "\"Natural/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 261, col: 5, offset: 6512},
+																		pos: position{line: 320, col: 5, offset: 8642},
 																		run: (*parser).callonExpression161,
 																		expr: &litMatcher{
-																			pos:        position{line: 261, col: 5, offset: 6512},
+																			pos:        position{line: 320, col: 5, offset: 8642},
 																			val:        "Natural/isZero",
 																			ignoreCase: false,
 																			want:       "\"Natural/isZero\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 262, col: 5, offset: 6563},
+																		pos: position{line: 321, col: 5, offset: 8693},
 																		run: (*parser).callonExpression163,
 																		expr: &litMatcher{
-																			pos:        position{line: 262, col: 5, offset: 6563},
+																			pos:        position{line: 321, col: 5, offset: 8693},
 																			val:        "Natural/even",
 																			ignoreCase: false,
 																			want:       "\"Natural/even\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 263, col: 5, offset: 6610},
+																		pos: position{line: 322, col: 5, offset: 8740},
 																		run: (*parser).callonExpression165,
 																		expr: &litMatcher{
-																			pos:        position{line: 263, col: 5, offset: 6610},
+																			pos:        position{line: 322, col: 5, offset: 8740},
 																			val:        "Natural/odd",
 																			ignoreCase: false,
 																			want:       "\"Natural/odd\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 264, col: 5, offset: 6655},
+																		pos: position{line: 323, col: 5, offset: 8785},
 																		run: (*parser).callonExpression167,
 																		expr: &litMatcher{
-																			pos:        position{line: 264, col: 5, offset: 6655},
+																			pos:        position{line: 323, col: 5, offset: 8785},
 																			val:        "Natural/toInteger",
 																			ignoreCase: false,
 																			want:       "\"Natural/toInteger\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 265, col: 5, offset: 6712},
+																		pos: position{line: 324, col: 5, offset: 8842},
 																		run: (*parser).callonExpression169,
 																		expr: &litMatcher{
-																			pos:        position{line: 265, col: 5, offset: 6712},
+																			pos:        position{line: 324, col: 5, offset: 8842},
 																			val:        "Natural/show",
 																			ignoreCase: false,
 																			want:       "\"Natural/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 266, col: 5, offset: 6759},
+																		pos: position{line: 325, col: 5, offset: 8889},
 																		run: (*parser).callonExpression171,
 																		expr: &litMatcher{
-																			pos:        position{line: 266, col: 5, offset: 6759},
+																			pos:        position{line: 325, col: 5, offset: 8889},
 																			val:        "Integer/toDouble",
 																			ignoreCase: false,
 																			want:       "\"Integer/toDouble\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 267, col: 5, offset: 6814},
+																		pos: position{line: 326, col: 5, offset: 8944},
 																		run: (*parser).callonExpression173,
 																		expr: &litMatcher{
-																			pos:        position{line: 267, col: 5, offset: 6814},
+																			pos:        position{line: 326, col: 5, offset: 8944},
 																			val:        "Integer/show",
 																			ignoreCase: false,
 																			want:       "\"Integer/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 268, col: 5, offset: 6861},
+																		pos: position{line: 327, col: 5, offset: 8991},
 																		run: (*parser).callonExpression175,
 																		expr: &litMatcher{
-																			pos:        position{line: 268, col: 5, offset: 6861},
+																			pos:        position{line: 327, col: 5, offset: 8991},
 																			val:        "Integer/negate",
 																			ignoreCase: false,
 																			want:       "\"Integer/negate\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 269, col: 5, offset: 6912},
+																		pos: position{line: 328, col: 5, offset: 9042},
 																		run: (*parser).callonExpression177,
 																		expr: &litMatcher{
-																			pos:        position{line: 269, col: 5, offset: 6912},
+																			pos:        position{line: 328, col: 5, offset: 9042},
 																			val:        "Integer/clamp",
 																			ignoreCase: false,
 																			want:       "\"Integer/clamp\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 270, col: 5, offset: 6961},
+																		pos: position{line: 329, col: 5, offset: 9091},
 																		run: (*parser).callonExpression179,
 																		expr: &litMatcher{
-																			pos:        position{line: 270, col: 5, offset: 6961},
+																			pos:        position{line: 329, col: 5, offset: 9091},
 																			val:        "Natural/subtract",
 																			ignoreCase: false,
 																			want:       "\"Natural/subtract\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 271, col: 5, offset: 7016},
+																		pos: position{line: 330, col: 5, offset: 9146},
 																		run: (*parser).callonExpression181,
 																		expr: &litMatcher{
-																			pos:        position{line: 271, col: 5, offset: 7016},
+																			pos:        position{line: 330, col: 5, offset: 9146},
 																			val:        "Double/show",
 																			ignoreCase: false,
 																			want:       "\"Double/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 272, col: 5, offset: 7061},
+																		pos: position{line: 331, col: 5, offset: 9191},
 																		run: (*parser).callonExpression183,
 																		expr: &litMatcher{
-																			pos:        position{line: 272, col: 5, offset: 7061},
+																			pos:        position{line: 331, col: 5, offset: 9191},
 																			val:        "List/build",
 																			ignoreCase: false,
 																			want:       "\"List/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 273, col: 5, offset: 7104},
+																		pos: position{line: 332, col: 5, offset: 9234},
 																		run: (*parser).callonExpression185,
 																		expr: &litMatcher{
-																			pos:        position{line: 273, col: 5, offset: 7104},
+																			pos:        position{line: 332, col: 5, offset: 9234},
 																			val:        "List/fold",
 																			ignoreCase: false,
 																			want:       "\"List/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 274, col: 5, offset: 7145},
+																		pos: position{line: 333, col: 5, offset: 9275},
 																		run: (*parser).callonExpression187,
 																		expr: &litMatcher{
-																			pos:        position{line: 274, col: 5, offset: 7145},
+																			pos:        position{line: 333, col: 5, offset: 9275},
 																			val:        "List/length",
 																			ignoreCase: false,
 																			want:       "\"List/length\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 275, col: 5, offset: 7190},
+																		pos: position{line: 334, col: 5, offset: 9320},
 																		run: (*parser).callonExpression189,
 																		expr: &litMatcher{
-																			pos:        position{line: 275, col: 5, offset: 7190},
+																			pos:        position{line: 334, col: 5, offset: 9320},
 																			val:        "List/head",
 																			ignoreCase: false,
 																			want:       "\"List/head\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 276, col: 5, offset: 7231},
+																		pos: position{line: 335, col: 5, offset: 9361},
 																		run: (*parser).callonExpression191,
 																		expr: &litMatcher{
-																			pos:        position{line: 276, col: 5, offset: 7231},
+																			pos:        position{line: 335, col: 5, offset: 9361},
 																			val:        "List/last",
 																			ignoreCase: false,
 																			want:       "\"List/last\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 277, col: 5, offset: 7272},
+																		pos: position{line: 336, col: 5, offset: 9402},
 																		run: (*parser).callonExpression193,
 																		expr: &litMatcher{
-																			pos:        position{line: 277, col: 5, offset: 7272},
+																			pos:        position{line: 336, col: 5, offset: 9402},
 																			val:        "List/indexed",
 																			ignoreCase: false,
 																			want:       "\"List/indexed\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 278, col: 5, offset: 7319},
+																		pos: position{line: 337, col: 5, offset: 9449},
 																		run: (*parser).callonExpression195,
 																		expr: &litMatcher{
-																			pos:        position{line: 278, col: 5, offset: 7319},
+																			pos:        position{line: 337, col: 5, offset: 9449},
 																			val:        "List/reverse",
 																			ignoreCase: false,
 																			want:       "\"List/reverse\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 279, col: 5, offset: 7366},
+																		pos: position{line: 338, col: 5, offset: 9496},
 																		run: (*parser).callonExpression197,
 																		expr: &litMatcher{
-																			pos:        position{line: 279, col: 5, offset: 7366},
+																			pos:        position{line: 338, col: 5, offset: 9496},
 																			val:        "Text/show",
 																			ignoreCase: false,
 																			want:       "\"Text/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 280, col: 5, offset: 7407},
+																		pos: position{line: 339, col: 5, offset: 9537},
 																		run: (*parser).callonExpression199,
 																		expr: &litMatcher{
-																			pos:        position{line: 280, col: 5, offset: 7407},
+																			pos:        position{line: 339, col: 5, offset: 9537},
 																			val:        "Text/replace",
 																			ignoreCase: false,
 																			want:       "\"Text/replace\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 281, col: 5, offset: 7454},
+																		pos: position{line: 340, col: 5, offset: 9584},
 																		run: (*parser).callonExpression201,
 																		expr: &litMatcher{
-																			pos:        position{line: 281, col: 5, offset: 7454},
+																			pos:        position{line: 340, col: 5, offset: 9584},
 																			val:        "Bool",
 																			ignoreCase: false,
 																			want:       "\"Bool\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 282, col: 5, offset: 7486},
+																		pos: position{line: 341, col: 5, offset: 9616},
 																		run: (*parser).callonExpression203,
 																		expr: &litMatcher{
-																			pos:        position{line: 282, col: 5, offset: 7486},
+																			pos:        position{line: 341, col: 5, offset: 9616},
 																			val:        "True",
 																			ignoreCase: false,
 																			want:       "\"True\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 283, col: 5, offset: 7518},
+																		pos: position{line: 342, col: 5, offset: 9648},
 																		run: (*parser).callonExpression205,
 																		expr: &litMatcher{
-																			pos:        position{line: 283, col: 5, offset: 7518},
+																			pos:        position{line: 342, col: 5, offset: 9648},
 																			val:        "False",
 																			ignoreCase: false,
 																			want:       "\"False\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 284, col: 5, offset: 7552},
+																		pos: position{line: 343, col: 5, offset: 9682},
 																		run: (*parser).callonExpression207,
 																		expr: &litMatcher{
-																			pos:        position{line: 284, col: 5, offset: 7552},
+																			pos:        position{line: 343, col: 5, offset: 9682},
 																			val:        "Optional",
 																			ignoreCase: false,
 																			want:       "\"Optional\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 285, col: 5, offset: 7592},
+																		pos: position{line: 344, col: 5, offset: 9722},
 																		run: (*parser).callonExpression209,
 																		expr: &litMatcher{
-																			pos:        position{line: 285, col: 5, offset: 7592},
+																			pos:        position{line: 344, col: 5, offset: 9722},
 																			val:        "None",
 																			ignoreCase: false,
 																			want:       "\"None\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 286, col: 5, offset: 7624},
+																		pos: position{line: 345, col: 5, offset: 9754},
 																		run: (*parser).callonExpression211,
 																		expr: &litMatcher{
-																			pos:        position{line: 286, col: 5, offset: 7624},
+																			pos:        position{line: 345, col: 5, offset: 9754},
 																			val:        "Natural",
 																			ignoreCase: false,
 																			want:       "\"Natural\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 287, col: 5, offset: 7662},
+																		pos: position{line: 346, col: 5, offset: 9792},
 																		run: (*parser).callonExpression213,
 																		expr: &litMatcher{
-																			pos:        position{line: 287, col: 5, offset: 7662},
+																			pos:        position{line: 346, col: 5, offset: 9792},
 																			val:        "Integer",
 																			ignoreCase: false,
 																			want:       "\"Integer\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 288, col: 5, offset: 7700},
+																		pos: position{line: 347, col: 5, offset: 9830},
 																		run: (*parser).callonExpression215,
 																		expr: &litMatcher{
-																			pos:        position{line: 288, col: 5, offset: 7700},
+																			pos:        position{line: 347, col: 5, offset: 9830},
 																			val:        "Double",
 																			ignoreCase: false,
 																			want:       "\"Double\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 289, col: 5, offset: 7736},
+																		pos: position{line: 348, col: 5, offset: 9866},
 																		run: (*parser).callonExpression217,
 																		expr: &litMatcher{
-																			pos:        position{line: 289, col: 5, offset: 7736},
+																			pos:        position{line: 348, col: 5, offset: 9866},
 																			val:        "Text",
 																			ignoreCase: false,
 																			want:       "\"Text\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 290, col: 5, offset: 7768},
+																		pos: position{line: 349, col: 5, offset: 9898},
 																		run: (*parser).callonExpression219,
 																		expr: &litMatcher{
-																			pos:        position{line: 290, col: 5, offset: 7768},
+																			pos:        position{line: 349, col: 5, offset: 9898},
 																			val:        "List",
 																			ignoreCase: false,
 																			want:       "\"List\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 291, col: 5, offset: 7800},
+																		pos: position{line: 350, col: 5, offset: 9930},
 																		run: (*parser).callonExpression221,
 																		expr: &litMatcher{
-																			pos:        position{line: 291, col: 5, offset: 7800},
+																			pos:        position{line: 350, col: 5, offset: 9930},
 																			val:        "Type",
 																			ignoreCase: false,
 																			want:       "\"Type\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 292, col: 5, offset: 7832},
+																		pos: position{line: 351, col: 5, offset: 9962},
 																		run: (*parser).callonExpression223,
 																		expr: &litMatcher{
-																			pos:        position{line: 292, col: 5, offset: 7832},
+																			pos:        position{line: 351, col: 5, offset: 9962},
 																			val:        "Kind",
 																			ignoreCase: false,
 																			want:       "\"Kind\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 293, col: 5, offset: 7864},
+																		pos: position{line: 352, col: 5, offset: 9994},
 																		run: (*parser).callonExpression225,
 																		expr: &litMatcher{
-																			pos:        position{line: 293, col: 5, offset: 7864},
+																			pos:        position{line: 352, col: 5, offset: 9994},
 																			val:        "Sort",
 																			ignoreCase: false,
 																			want:       "\"Sort\"",
@@ -8026,33 +8074,33 @@ var g = &grammar{
 															},
 														},
 														&labeledExpr{
-															pos:   position{line: 135, col: 28, offset: 3329},
+															pos:   position{line: 194, col: 28, offset: 5459},
 															label: "label",
 															expr: &choiceExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 131, col: 9, offset: 3119},
+																		pos: position{line: 190, col: 9, offset: 5249},
 																		run: (*parser).callonExpression229,
 																		expr: &seqExpr{
-																			pos: position{line: 131, col: 9, offset: 3119},
+																			pos: position{line: 190, col: 9, offset: 5249},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 131, col: 9, offset: 3119},
+																					pos:        position{line: 190, col: 9, offset: 5249},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
 																				},
 																				&labeledExpr{
-																					pos:   position{line: 131, col: 13, offset: 3123},
+																					pos:   position{line: 190, col: 13, offset: 5253},
 																					label: "label",
 																					expr: &actionExpr{
-																						pos: position{line: 129, col: 15, offset: 3060},
+																						pos: position{line: 188, col: 15, offset: 5190},
 																						run: (*parser).callonExpression233,
 																						expr: &zeroOrMoreExpr{
-																							pos: position{line: 129, col: 15, offset: 3060},
+																							pos: position{line: 188, col: 15, offset: 5190},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 128, col: 19, offset: 3023},
+																								pos:        position{line: 187, col: 19, offset: 5153},
 																								val:        "[ -_a-~]",
 																								ranges:     []rune{' ', '_', 'a', '~'},
 																								ignoreCase: false,
@@ -8062,7 +8110,7 @@ var g = &grammar{
 																					},
 																				},
 																				&litMatcher{
-																					pos:        position{line: 131, col: 31, offset: 3141},
+																					pos:        position{line: 190, col: 31, offset: 5271},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
@@ -8071,75 +8119,75 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 132, col: 9, offset: 3175},
+																		pos: position{line: 191, col: 9, offset: 5305},
 																		run: (*parser).callonExpression237,
 																		expr: &labeledExpr{
-																			pos:   position{line: 132, col: 9, offset: 3175},
+																			pos:   position{line: 191, col: 9, offset: 5305},
 																			label: "label",
 																			expr: &choiceExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				alternatives: []interface{}{
 																					&actionExpr{
-																						pos: position{line: 122, col: 15, offset: 2816},
+																						pos: position{line: 181, col: 15, offset: 4946},
 																						run: (*parser).callonExpression240,
 																						expr: &seqExpr{
-																							pos: position{line: 122, col: 15, offset: 2816},
+																							pos: position{line: 181, col: 15, offset: 4946},
 																							exprs: []interface{}{
 																								&choiceExpr{
-																									pos: position{line: 249, col: 5, offset: 6269},
+																									pos: position{line: 308, col: 5, offset: 8399},
 																									alternatives: []interface{}{
 																										&litMatcher{
-																											pos:        position{line: 231, col: 6, offset: 5936},
+																											pos:        position{line: 290, col: 6, offset: 8066},
 																											val:        "if",
 																											ignoreCase: false,
 																											want:       "\"if\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 232, col: 8, offset: 5950},
+																											pos:        position{line: 291, col: 8, offset: 8080},
 																											val:        "then",
 																											ignoreCase: false,
 																											want:       "\"then\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 233, col: 8, offset: 5966},
+																											pos:        position{line: 292, col: 8, offset: 8096},
 																											val:        "else",
 																											ignoreCase: false,
 																											want:       "\"else\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 234, col: 7, offset: 5981},
+																											pos:        position{line: 293, col: 7, offset: 8111},
 																											val:        "let",
 																											ignoreCase: false,
 																											want:       "\"let\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 235, col: 6, offset: 5994},
+																											pos:        position{line: 294, col: 6, offset: 8124},
 																											val:        "in",
 																											ignoreCase: false,
 																											want:       "\"in\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 237, col: 9, offset: 6021},
+																											pos:        position{line: 296, col: 9, offset: 8151},
 																											val:        "using",
 																											ignoreCase: false,
 																											want:       "\"using\"",
 																										},
 																										&actionExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											run: (*parser).callonExpression249,
 																											expr: &seqExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												exprs: []interface{}{
 																													&litMatcher{
-																														pos:        position{line: 239, col: 11, offset: 6059},
+																														pos:        position{line: 298, col: 11, offset: 8189},
 																														val:        "missing",
 																														ignoreCase: false,
 																														want:       "\"missing\"",
 																													},
 																													&notExpr{
-																														pos: position{line: 239, col: 21, offset: 6069},
+																														pos: position{line: 298, col: 21, offset: 8199},
 																														expr: &charClassMatcher{
-																															pos:        position{line: 121, col: 23, offset: 2785},
+																															pos:        position{line: 180, col: 23, offset: 4915},
 																															val:        "[_/-A-Za-z0-9]",
 																															chars:      []rune{'_', '/', '-'},
 																															ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -8151,61 +8199,61 @@ var g = &grammar{
 																											},
 																										},
 																										&litMatcher{
-																											pos:        position{line: 244, col: 10, offset: 6199},
+																											pos:        position{line: 303, col: 10, offset: 8329},
 																											val:        "assert",
 																											ignoreCase: false,
 																											want:       "\"assert\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 236, col: 6, offset: 6006},
+																											pos:        position{line: 295, col: 6, offset: 8136},
 																											val:        "as",
 																											ignoreCase: false,
 																											want:       "\"as\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 240, col: 12, offset: 6129},
+																											pos:        position{line: 299, col: 12, offset: 8259},
 																											val:        "Infinity",
 																											ignoreCase: false,
 																											want:       "\"Infinity\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 241, col: 7, offset: 6148},
+																											pos:        position{line: 300, col: 7, offset: 8278},
 																											val:        "NaN",
 																											ignoreCase: false,
 																											want:       "\"NaN\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 238, col: 9, offset: 6039},
+																											pos:        position{line: 297, col: 9, offset: 8169},
 																											val:        "merge",
 																											ignoreCase: false,
 																											want:       "\"merge\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 242, col: 8, offset: 6163},
+																											pos:        position{line: 301, col: 8, offset: 8293},
 																											val:        "Some",
 																											ignoreCase: false,
 																											want:       "\"Some\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 243, col: 9, offset: 6180},
+																											pos:        position{line: 302, col: 9, offset: 8310},
 																											val:        "toMap",
 																											ignoreCase: false,
 																											want:       "\"toMap\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 10, offset: 6219},
+																											pos:        position{line: 304, col: 10, offset: 8349},
 																											val:        "forall",
 																											ignoreCase: false,
 																											want:       "\"forall\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 21, offset: 6230},
+																											pos:        position{line: 304, col: 21, offset: 8360},
 																											val:        "∀",
 																											ignoreCase: false,
 																											want:       "\"∀\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 246, col: 8, offset: 6245},
+																											pos:        position{line: 305, col: 8, offset: 8375},
 																											val:        "with",
 																											ignoreCase: false,
 																											want:       "\"with\"",
@@ -8213,9 +8261,9 @@ var g = &grammar{
 																									},
 																								},
 																								&oneOrMoreExpr{
-																									pos: position{line: 122, col: 23, offset: 2824},
+																									pos: position{line: 181, col: 23, offset: 4954},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -8227,68 +8275,68 @@ var g = &grammar{
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 123, col: 13, offset: 2888},
+																						pos: position{line: 182, col: 13, offset: 5018},
 																						run: (*parser).callonExpression266,
 																						expr: &seqExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							exprs: []interface{}{
 																								&notExpr{
-																									pos: position{line: 123, col: 13, offset: 2888},
+																									pos: position{line: 182, col: 13, offset: 5018},
 																									expr: &choiceExpr{
-																										pos: position{line: 249, col: 5, offset: 6269},
+																										pos: position{line: 308, col: 5, offset: 8399},
 																										alternatives: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 231, col: 6, offset: 5936},
+																												pos:        position{line: 290, col: 6, offset: 8066},
 																												val:        "if",
 																												ignoreCase: false,
 																												want:       "\"if\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 232, col: 8, offset: 5950},
+																												pos:        position{line: 291, col: 8, offset: 8080},
 																												val:        "then",
 																												ignoreCase: false,
 																												want:       "\"then\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 233, col: 8, offset: 5966},
+																												pos:        position{line: 292, col: 8, offset: 8096},
 																												val:        "else",
 																												ignoreCase: false,
 																												want:       "\"else\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 234, col: 7, offset: 5981},
+																												pos:        position{line: 293, col: 7, offset: 8111},
 																												val:        "let",
 																												ignoreCase: false,
 																												want:       "\"let\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 235, col: 6, offset: 5994},
+																												pos:        position{line: 294, col: 6, offset: 8124},
 																												val:        "in",
 																												ignoreCase: false,
 																												want:       "\"in\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 237, col: 9, offset: 6021},
+																												pos:        position{line: 296, col: 9, offset: 8151},
 																												val:        "using",
 																												ignoreCase: false,
 																												want:       "\"using\"",
 																											},
 																											&actionExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												run: (*parser).callonExpression276,
 																												expr: &seqExpr{
-																													pos: position{line: 239, col: 11, offset: 6059},
+																													pos: position{line: 298, col: 11, offset: 8189},
 																													exprs: []interface{}{
 																														&litMatcher{
-																															pos:        position{line: 239, col: 11, offset: 6059},
+																															pos:        position{line: 298, col: 11, offset: 8189},
 																															val:        "missing",
 																															ignoreCase: false,
 																															want:       "\"missing\"",
 																														},
 																														&notExpr{
-																															pos: position{line: 239, col: 21, offset: 6069},
+																															pos: position{line: 298, col: 21, offset: 8199},
 																															expr: &charClassMatcher{
-																																pos:        position{line: 121, col: 23, offset: 2785},
+																																pos:        position{line: 180, col: 23, offset: 4915},
 																																val:        "[_/-A-Za-z0-9]",
 																																chars:      []rune{'_', '/', '-'},
 																																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -8300,61 +8348,61 @@ var g = &grammar{
 																												},
 																											},
 																											&litMatcher{
-																												pos:        position{line: 244, col: 10, offset: 6199},
+																												pos:        position{line: 303, col: 10, offset: 8329},
 																												val:        "assert",
 																												ignoreCase: false,
 																												want:       "\"assert\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 236, col: 6, offset: 6006},
+																												pos:        position{line: 295, col: 6, offset: 8136},
 																												val:        "as",
 																												ignoreCase: false,
 																												want:       "\"as\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 240, col: 12, offset: 6129},
+																												pos:        position{line: 299, col: 12, offset: 8259},
 																												val:        "Infinity",
 																												ignoreCase: false,
 																												want:       "\"Infinity\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 241, col: 7, offset: 6148},
+																												pos:        position{line: 300, col: 7, offset: 8278},
 																												val:        "NaN",
 																												ignoreCase: false,
 																												want:       "\"NaN\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 238, col: 9, offset: 6039},
+																												pos:        position{line: 297, col: 9, offset: 8169},
 																												val:        "merge",
 																												ignoreCase: false,
 																												want:       "\"merge\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 242, col: 8, offset: 6163},
+																												pos:        position{line: 301, col: 8, offset: 8293},
 																												val:        "Some",
 																												ignoreCase: false,
 																												want:       "\"Some\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 243, col: 9, offset: 6180},
+																												pos:        position{line: 302, col: 9, offset: 8310},
 																												val:        "toMap",
 																												ignoreCase: false,
 																												want:       "\"toMap\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 10, offset: 6219},
+																												pos:        position{line: 304, col: 10, offset: 8349},
 																												val:        "forall",
 																												ignoreCase: false,
 																												want:       "\"forall\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 21, offset: 6230},
+																												pos:        position{line: 304, col: 21, offset: 8360},
 																												val:        "∀",
 																												ignoreCase: false,
 																												want:       "\"∀\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 246, col: 8, offset: 6245},
+																												pos:        position{line: 305, col: 8, offset: 8375},
 																												val:        "with",
 																												ignoreCase: false,
 																												want:       "\"with\"",
@@ -8363,7 +8411,7 @@ var g = &grammar{
 																									},
 																								},
 																								&charClassMatcher{
-																									pos:        position{line: 120, col: 24, offset: 2751},
+																									pos:        position{line: 179, col: 24, offset: 4881},
 																									val:        "[_A-Za-z]",
 																									chars:      []rune{'_'},
 																									ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -8371,9 +8419,9 @@ var g = &grammar{
 																									inverted:   false,
 																								},
 																								&zeroOrMoreExpr{
-																									pos: position{line: 123, col: 43, offset: 2918},
+																									pos: position{line: 182, col: 43, offset: 5048},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -8398,52 +8446,52 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 45, offset: 14522},
+									pos:  position{line: 571, col: 45, offset: 16704},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 512, col: 47, offset: 14524},
+									pos:        position{line: 571, col: 47, offset: 16706},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 51, offset: 14528},
+									pos:  position{line: 571, col: 51, offset: 16710},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 512, col: 54, offset: 14531},
+									pos:   position{line: 571, col: 54, offset: 16713},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 512, col: 56, offset: 14533},
+										pos:  position{line: 571, col: 56, offset: 16715},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 67, offset: 14544},
+									pos:  position{line: 571, col: 67, offset: 16726},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 512, col: 69, offset: 14546},
+									pos:        position{line: 571, col: 69, offset: 16728},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 73, offset: 14550},
+									pos:  position{line: 571, col: 73, offset: 16732},
 									name: "_",
 								},
 								&choiceExpr{
-									pos: position{line: 303, col: 9, offset: 8080},
+									pos: position{line: 362, col: 9, offset: 10210},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 303, col: 9, offset: 8080},
+											pos:        position{line: 362, col: 9, offset: 10210},
 											val:        "->",
 											ignoreCase: false,
 											want:       "\"->\"",
 										},
 										&litMatcher{
-											pos:        position{line: 303, col: 16, offset: 8087},
+											pos:        position{line: 362, col: 16, offset: 10217},
 											val:        "→",
 											ignoreCase: false,
 											want:       "\"→\"",
@@ -8451,14 +8499,14 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 512, col: 81, offset: 14558},
+									pos:  position{line: 571, col: 81, offset: 16740},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 512, col: 83, offset: 14560},
+									pos:   position{line: 571, col: 83, offset: 16742},
 									label: "body",
 									expr: &ruleRefExpr{
-										pos:  position{line: 512, col: 88, offset: 14565},
+										pos:  position{line: 571, col: 88, offset: 16747},
 										name: "Expression",
 									},
 								},
@@ -8466,70 +8514,70 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 515, col: 7, offset: 14677},
+						pos: position{line: 574, col: 7, offset: 16859},
 						run: (*parser).callonExpression308,
 						expr: &seqExpr{
-							pos: position{line: 515, col: 7, offset: 14677},
+							pos: position{line: 574, col: 7, offset: 16859},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 231, col: 6, offset: 5936},
+									pos:        position{line: 290, col: 6, offset: 8066},
 									val:        "if",
 									ignoreCase: false,
 									want:       "\"if\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 515, col: 10, offset: 14680},
+									pos:  position{line: 574, col: 10, offset: 16862},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 515, col: 13, offset: 14683},
+									pos:   position{line: 574, col: 13, offset: 16865},
 									label: "cond",
 									expr: &ruleRefExpr{
-										pos:  position{line: 515, col: 18, offset: 14688},
+										pos:  position{line: 574, col: 18, offset: 16870},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 515, col: 29, offset: 14699},
+									pos:  position{line: 574, col: 29, offset: 16881},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 232, col: 8, offset: 5950},
+									pos:        position{line: 291, col: 8, offset: 8080},
 									val:        "then",
 									ignoreCase: false,
 									want:       "\"then\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 515, col: 36, offset: 14706},
+									pos:  position{line: 574, col: 36, offset: 16888},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 515, col: 39, offset: 14709},
+									pos:   position{line: 574, col: 39, offset: 16891},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 515, col: 41, offset: 14711},
+										pos:  position{line: 574, col: 41, offset: 16893},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 515, col: 52, offset: 14722},
+									pos:  position{line: 574, col: 52, offset: 16904},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 233, col: 8, offset: 5966},
+									pos:        position{line: 292, col: 8, offset: 8096},
 									val:        "else",
 									ignoreCase: false,
 									want:       "\"else\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 515, col: 59, offset: 14729},
+									pos:  position{line: 574, col: 59, offset: 16911},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 515, col: 62, offset: 14732},
+									pos:   position{line: 574, col: 62, offset: 16914},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 515, col: 64, offset: 14734},
+										pos:  position{line: 574, col: 64, offset: 16916},
 										name: "Expression",
 									},
 								},
@@ -8537,37 +8585,37 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 518, col: 7, offset: 14816},
+						pos: position{line: 577, col: 7, offset: 16998},
 						run: (*parser).callonExpression324,
 						expr: &seqExpr{
-							pos: position{line: 518, col: 7, offset: 14816},
+							pos: position{line: 577, col: 7, offset: 16998},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 518, col: 7, offset: 14816},
+									pos:   position{line: 577, col: 7, offset: 16998},
 									label: "bindings",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 518, col: 16, offset: 14825},
+										pos: position{line: 577, col: 16, offset: 17007},
 										expr: &ruleRefExpr{
-											pos:  position{line: 518, col: 16, offset: 14825},
+											pos:  position{line: 577, col: 16, offset: 17007},
 											name: "LetBinding",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 235, col: 6, offset: 5994},
+									pos:        position{line: 294, col: 6, offset: 8124},
 									val:        "in",
 									ignoreCase: false,
 									want:       "\"in\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 518, col: 31, offset: 14840},
+									pos:  position{line: 577, col: 31, offset: 17022},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 518, col: 34, offset: 14843},
+									pos:   position{line: 577, col: 34, offset: 17025},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 36, offset: 14845},
+										pos:  position{line: 577, col: 36, offset: 17027},
 										name: "Expression",
 									},
 								},
@@ -8575,22 +8623,22 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 525, col: 7, offset: 15084},
+						pos: position{line: 589, col: 7, offset: 17503},
 						run: (*parser).callonExpression333,
 						expr: &seqExpr{
-							pos: position{line: 525, col: 7, offset: 15084},
+							pos: position{line: 589, col: 7, offset: 17503},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 245, col: 10, offset: 6219},
+									pos: position{line: 304, col: 10, offset: 8349},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 245, col: 10, offset: 6219},
+											pos:        position{line: 304, col: 10, offset: 8349},
 											val:        "forall",
 											ignoreCase: false,
 											want:       "\"forall\"",
 										},
 										&litMatcher{
-											pos:        position{line: 245, col: 21, offset: 6230},
+											pos:        position{line: 304, col: 21, offset: 8360},
 											val:        "∀",
 											ignoreCase: false,
 											want:       "\"∀\"",
@@ -8598,384 +8646,384 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 14, offset: 15091},
+									pos:  position{line: 589, col: 14, offset: 17510},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 525, col: 16, offset: 15093},
+									pos:        position{line: 589, col: 16, offset: 17512},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 20, offset: 15097},
+									pos:  position{line: 589, col: 20, offset: 17516},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 525, col: 22, offset: 15099},
+									pos:   position{line: 589, col: 22, offset: 17518},
 									label: "label",
 									expr: &choiceExpr{
-										pos: position{line: 134, col: 20, offset: 3237},
+										pos: position{line: 193, col: 20, offset: 5367},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 134, col: 20, offset: 3237},
+												pos: position{line: 193, col: 20, offset: 5367},
 												run: (*parser).callonExpression343,
 												expr: &seqExpr{
-													pos: position{line: 134, col: 20, offset: 3237},
+													pos: position{line: 193, col: 20, offset: 5367},
 													exprs: []interface{}{
 														&andExpr{
-															pos: position{line: 134, col: 20, offset: 3237},
+															pos: position{line: 193, col: 20, offset: 5367},
 															expr: &seqExpr{
-																pos: position{line: 134, col: 22, offset: 3239},
+																pos: position{line: 193, col: 22, offset: 5369},
 																exprs: []interface{}{
 																	&choiceExpr{
-																		pos: position{line: 259, col: 5, offset: 6416},
+																		pos: position{line: 318, col: 5, offset: 8546},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 259, col: 5, offset: 6416},
+																				pos: position{line: 318, col: 5, offset: 8546},
 																				run: (*parser).callonExpression348,
 																				expr: &litMatcher{
-																					pos:        position{line: 259, col: 5, offset: 6416},
+																					pos:        position{line: 318, col: 5, offset: 8546},
 																					val:        "Natural/fold",
 																					ignoreCase: false,
 																					want:       "\"Natural/fold\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 260, col: 5, offset: 6463},
+																				pos: position{line: 319, col: 5, offset: 8593},
 																				run: (*parser).callonExpression350,
 																				expr: &litMatcher{
-																					pos:        position{line: 260, col: 5, offset: 6463},
+																					pos:        position{line: 319, col: 5, offset: 8593},
 																					val:        "Natural/build",
 																					ignoreCase: false,
 																					want:       "\"Natural/build\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 261, col: 5, offset: 6512},
+																				pos: position{line: 320, col: 5, offset: 8642},
 																				run: (*parser).callonExpression352,
 																				expr: &litMatcher{
-																					pos:        position{line: 261, col: 5, offset: 6512},
+																					pos:        position{line: 320, col: 5, offset: 8642},
 																					val:        "Natural/isZero",
 																					ignoreCase: false,
 																					want:       "\"Natural/isZero\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 262, col: 5, offset: 6563},
+																				pos: position{line: 321, col: 5, offset: 8693},
 																				run: (*parser).callonExpression354,
 																				expr: &litMatcher{
-																					pos:        position{line: 262, col: 5, offset: 6563},
+																					pos:        position{line: 321, col: 5, offset: 8693},
 																					val:        "Natural/even",
 																					ignoreCase: false,
 																					want:       "\"Natural/even\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 263, col: 5, offset: 6610},
+																				pos: position{line: 322, col: 5, offset: 8740},
 																				run: (*parser).callonExpression356,
 																				expr: &litMatcher{
-																					pos:        position{line: 263, col: 5, offset: 6610},
+																					pos:        position{line: 322, col: 5, offset: 8740},
 																					val:        "Natural/odd",
 																					ignoreCase: false,
 																					want:       "\"Natural/odd\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 264, col: 5, offset: 6655},
+																				pos: position{line: 323, col: 5, offset: 8785},
 																				run: (*parser).callonExpression358,
 																				expr: &litMatcher{
-																					pos:        position{line: 264, col: 5, offset: 6655},
+																					pos:        position{line: 323, col: 5, offset: 8785},
 																					val:        "Natural/toInteger",
 																					ignoreCase: false,
 																					want:       "\"Natural/toInteger\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 265, col: 5, offset: 6712},
+																				pos: position{line: 324, col: 5, offset: 8842},
 																				run: (*parser).callonExpression360,
 																				expr: &litMatcher{
-																					pos:        position{line: 265, col: 5, offset: 6712},
+																					pos:        position{line: 324, col: 5, offset: 8842},
 																					val:        "Natural/show",
 																					ignoreCase: false,
 																					want:       "\"Natural/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 266, col: 5, offset: 6759},
+																				pos: position{line: 325, col: 5, offset: 8889},
 																				run: (*parser).callonExpression362,
 																				expr: &litMatcher{
-																					pos:        position{line: 266, col: 5, offset: 6759},
+																					pos:        position{line: 325, col: 5, offset: 8889},
 																					val:        "Integer/toDouble",
 																					ignoreCase: false,
 																					want:       "\"Integer/toDouble\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 267, col: 5, offset: 6814},
+																				pos: position{line: 326, col: 5, offset: 8944},
 																				run: (*parser).callonExpression364,
 																				expr: &litMatcher{
-																					pos:        position{line: 267, col: 5, offset: 6814},
+																					pos:        position{line: 326, col: 5, offset: 8944},
 																					val:        "Integer/show",
 																					ignoreCase: false,
 																					want:       "\"Integer/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 268, col: 5, offset: 6861},
+																				pos: position{line: 327, col: 5, offset: 8991},
 																				run: (*parser).callonExpression366,
 																				expr: &litMatcher{
-																					pos:        position{line: 268, col: 5, offset: 6861},
+																					pos:        position{line: 327, col: 5, offset: 8991},
 																					val:        "Integer/negate",
 																					ignoreCase: false,
 																					want:       "\"Integer/negate\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 269, col: 5, offset: 6912},
+																				pos: position{line: 328, col: 5, offset: 9042},
 																				run: (*parser).callonExpression368,
 																				expr: &litMatcher{
-																					pos:        position{line: 269, col: 5, offset: 6912},
+																					pos:        position{line: 328, col: 5, offset: 9042},
 																					val:        "Integer/clamp",
 																					ignoreCase: false,
 																					want:       "\"Integer/clamp\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 270, col: 5, offset: 6961},
+																				pos: position{line: 329, col: 5, offset: 9091},
 																				run: (*parser).callonExpression370,
 																				expr: &litMatcher{
-																					pos:        position{line: 270, col: 5, offset: 6961},
+																					pos:        position{line: 329, col: 5, offset: 9091},
 																					val:        "Natural/subtract",
 																					ignoreCase: false,
 																					want:       "\"Natural/subtract\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 271, col: 5, offset: 7016},
+																				pos: position{line: 330, col: 5, offset: 9146},
 																				run: (*parser).callonExpression372,
 																				expr: &litMatcher{
-																					pos:        position{line: 271, col: 5, offset: 7016},
+																					pos:        position{line: 330, col: 5, offset: 9146},
 																					val:        "Double/show",
 																					ignoreCase: false,
 																					want:       "\"Double/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 272, col: 5, offset: 7061},
+																				pos: position{line: 331, col: 5, offset: 9191},
 																				run: (*parser).callonExpression374,
 																				expr: &litMatcher{
-																					pos:        position{line: 272, col: 5, offset: 7061},
+																					pos:        position{line: 331, col: 5, offset: 9191},
 																					val:        "List/build",
 																					ignoreCase: false,
 																					want:       "\"List/build\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 273, col: 5, offset: 7104},
+																				pos: position{line: 332, col: 5, offset: 9234},
 																				run: (*parser).callonExpression376,
 																				expr: &litMatcher{
-																					pos:        position{line: 273, col: 5, offset: 7104},
+																					pos:        position{line: 332, col: 5, offset: 9234},
 																					val:        "List/fold",
 																					ignoreCase: false,
 																					want:       "\"List/fold\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 274, col: 5, offset: 7145},
+																				pos: position{line: 333, col: 5, offset: 9275},
 																				run: (*parser).callonExpression378,
 																				expr: &litMatcher{
-																					pos:        position{line: 274, col: 5, offset: 7145},
+																					pos:        position{line: 333, col: 5, offset: 9275},
 																					val:        "List/length",
 																					ignoreCase: false,
 																					want:       "\"List/length\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 275, col: 5, offset: 7190},
+																				pos: position{line: 334, col: 5, offset: 9320},
 																				run: (*parser).callonExpression380,
 																				expr: &litMatcher{
-																					pos:        position{line: 275, col: 5, offset: 7190},
+																					pos:        position{line: 334, col: 5, offset: 9320},
 																					val:        "List/head",
 																					ignoreCase: false,
 																					want:       "\"List/head\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 276, col: 5, offset: 7231},
+																				pos: position{line: 335, col: 5, offset: 9361},
 																				run: (*parser).callonExpression382,
 																				expr: &litMatcher{
-																					pos:        position{line: 276, col: 5, offset: 7231},
+																					pos:        position{line: 335, col: 5, offset: 9361},
 																					val:        "List/last",
 																					ignoreCase: false,
 																					want:       "\"List/last\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 277, col: 5, offset: 7272},
+																				pos: position{line: 336, col: 5, offset: 9402},
 																				run: (*parser).callonExpression384,
 																				expr: &litMatcher{
-																					pos:        position{line: 277, col: 5, offset: 7272},
+																					pos:        position{line: 336, col: 5, offset: 9402},
 																					val:        "List/indexed",
 																					ignoreCase: false,
 																					want:       "\"List/indexed\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 278, col: 5, offset: 7319},
+																				pos: position{line: 337, col: 5, offset: 9449},
 																				run: (*parser).callonExpression386,
 																				expr: &litMatcher{
-																					pos:        position{line: 278, col: 5, offset: 7319},
+																					pos:        position{line: 337, col: 5, offset: 9449},
 																					val:        "List/reverse",
 																					ignoreCase: false,
 																					want:       "\"List/reverse\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 279, col: 5, offset: 7366},
+																				pos: position{line: 338, col: 5, offset: 9496},
 																				run: (*parser).callonExpression388,
 																				expr: &litMatcher{
-																					pos:        position{line: 279, col: 5, offset: 7366},
+																					pos:        position{line: 338, col: 5, offset: 9496},
 																					val:        "Text/show",
 																					ignoreCase: false,
 																					want:       "\"Text/show\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 280, col: 5, offset: 7407},
+																				pos: position{line: 339, col: 5, offset: 9537},
 																				run: (*parser).callonExpression390,
 																				expr: &litMatcher{
-																					pos:        position{line: 280, col: 5, offset: 7407},
+																					pos:        position{line: 339, col: 5, offset: 9537},
 																					val:        "Text/replace",
 																					ignoreCase: false,
 																					want:       "\"Text/replace\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 281, col: 5, offset: 7454},
+																				pos: position{line: 340, col: 5, offset: 9584},
 																				run: (*parser).callonExpression392,
 																				expr: &litMatcher{
-																					pos:        position{line: 281, col: 5, offset: 7454},
+																					pos:        position{line: 340, col: 5, offset: 9584},
 																					val:        "Bool",
 																					ignoreCase: false,
 																					want:       "\"Bool\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 282, col: 5, offset: 7486},
+																				pos: position{line: 341, col: 5, offset: 9616},
 																				run: (*parser).callonExpression394,
 																				expr: &litMatcher{
-																					pos:        position{line: 282, col: 5, offset: 7486},
+																					pos:        position{line: 341, col: 5, offset: 9616},
 																					val:        "True",
 																					ignoreCase: false,
 																					want:       "\"True\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 283, col: 5, offset: 7518},
+																				pos: position{line: 342, col: 5, offset: 9648},
 																				run: (*parser).callonExpression396,
 																				expr: &litMatcher{
-																					pos:        position{line: 283, col: 5, offset: 7518},
+																					pos:        position{line: 342, col: 5, offset: 9648},
 																					val:        "False",
 																					ignoreCase: false,
 																					want:       "\"False\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 284, col: 5, offset: 7552},
+																				pos: position{line: 343, col: 5, offset: 9682},
 																				run: (*parser).callonExpression398,
 																				expr: &litMatcher{
-																					pos:        position{line: 284, col: 5, offset: 7552},
+																					pos:        position{line: 343, col: 5, offset: 9682},
 																					val:        "Optional",
 																					ignoreCase: false,
 																					want:       "\"Optional\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 285, col: 5, offset: 7592},
+																				pos: position{line: 344, col: 5, offset: 9722},
 																				run: (*parser).callonExpression400,
 																				expr: &litMatcher{
-																					pos:        position{line: 285, col: 5, offset: 7592},
+																					pos:        position{line: 344, col: 5, offset: 9722},
 																					val:        "None",
 																					ignoreCase: false,
 																					want:       "\"None\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 286, col: 5, offset: 7624},
+																				pos: position{line: 345, col: 5, offset: 9754},
 																				run: (*parser).callonExpression402,
 																				expr: &litMatcher{
-																					pos:        position{line: 286, col: 5, offset: 7624},
+																					pos:        position{line: 345, col: 5, offset: 9754},
 																					val:        "Natural",
 																					ignoreCase: false,
 																					want:       "\"Natural\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 287, col: 5, offset: 7662},
+																				pos: position{line: 346, col: 5, offset: 9792},
 																				run: (*parser).callonExpression404,
 																				expr: &litMatcher{
-																					pos:        position{line: 287, col: 5, offset: 7662},
+																					pos:        position{line: 346, col: 5, offset: 9792},
 																					val:        "Integer",
 																					ignoreCase: false,
 																					want:       "\"Integer\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 288, col: 5, offset: 7700},
+																				pos: position{line: 347, col: 5, offset: 9830},
 																				run: (*parser).callonExpression406,
 																				expr: &litMatcher{
-																					pos:        position{line: 288, col: 5, offset: 7700},
+																					pos:        position{line: 347, col: 5, offset: 9830},
 																					val:        "Double",
 																					ignoreCase: false,
 																					want:       "\"Double\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 289, col: 5, offset: 7736},
+																				pos: position{line: 348, col: 5, offset: 9866},
 																				run: (*parser).callonExpression408,
 																				expr: &litMatcher{
-																					pos:        position{line: 289, col: 5, offset: 7736},
+																					pos:        position{line: 348, col: 5, offset: 9866},
 																					val:        "Text",
 																					ignoreCase: false,
 																					want:       "\"Text\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 290, col: 5, offset: 7768},
+																				pos: position{line: 349, col: 5, offset: 9898},
 																				run: (*parser).callonExpression410,
 																				expr: &litMatcher{
-																					pos:        position{line: 290, col: 5, offset: 7768},
+																					pos:        position{line: 349, col: 5, offset: 9898},
 																					val:        "List",
 																					ignoreCase: false,
 																					want:       "\"List\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 291, col: 5, offset: 7800},
+																				pos: position{line: 350, col: 5, offset: 9930},
 																				run: (*parser).callonExpression412,
 																				expr: &litMatcher{
-																					pos:        position{line: 291, col: 5, offset: 7800},
+																					pos:        position{line: 350, col: 5, offset: 9930},
 																					val:        "Type",
 																					ignoreCase: false,
 																					want:       "\"Type\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 292, col: 5, offset: 7832},
+																				pos: position{line: 351, col: 5, offset: 9962},
 																				run: (*parser).callonExpression414,
 																				expr: &litMatcher{
-																					pos:        position{line: 292, col: 5, offset: 7832},
+																					pos:        position{line: 351, col: 5, offset: 9962},
 																					val:        "Kind",
 																					ignoreCase: false,
 																					want:       "\"Kind\"",
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 293, col: 5, offset: 7864},
+																				pos: position{line: 352, col: 5, offset: 9994},
 																				run: (*parser).callonExpression416,
 																				expr: &litMatcher{
-																					pos:        position{line: 293, col: 5, offset: 7864},
+																					pos:        position{line: 352, col: 5, offset: 9994},
 																					val:        "Sort",
 																					ignoreCase: false,
 																					want:       "\"Sort\"",
@@ -8984,7 +9032,7 @@ var g = &grammar{
 																		},
 																	},
 																	&charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -8995,33 +9043,33 @@ var g = &grammar{
 															},
 														},
 														&labeledExpr{
-															pos:   position{line: 134, col: 51, offset: 3268},
+															pos:   position{line: 193, col: 51, offset: 5398},
 															label: "label",
 															expr: &choiceExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 131, col: 9, offset: 3119},
+																		pos: position{line: 190, col: 9, offset: 5249},
 																		run: (*parser).callonExpression421,
 																		expr: &seqExpr{
-																			pos: position{line: 131, col: 9, offset: 3119},
+																			pos: position{line: 190, col: 9, offset: 5249},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 131, col: 9, offset: 3119},
+																					pos:        position{line: 190, col: 9, offset: 5249},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
 																				},
 																				&labeledExpr{
-																					pos:   position{line: 131, col: 13, offset: 3123},
+																					pos:   position{line: 190, col: 13, offset: 5253},
 																					label: "label",
 																					expr: &actionExpr{
-																						pos: position{line: 129, col: 15, offset: 3060},
+																						pos: position{line: 188, col: 15, offset: 5190},
 																						run: (*parser).callonExpression425,
 																						expr: &zeroOrMoreExpr{
-																							pos: position{line: 129, col: 15, offset: 3060},
+																							pos: position{line: 188, col: 15, offset: 5190},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 128, col: 19, offset: 3023},
+																								pos:        position{line: 187, col: 19, offset: 5153},
 																								val:        "[ -_a-~]",
 																								ranges:     []rune{' ', '_', 'a', '~'},
 																								ignoreCase: false,
@@ -9031,7 +9079,7 @@ var g = &grammar{
 																					},
 																				},
 																				&litMatcher{
-																					pos:        position{line: 131, col: 31, offset: 3141},
+																					pos:        position{line: 190, col: 31, offset: 5271},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
@@ -9040,75 +9088,75 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 132, col: 9, offset: 3175},
+																		pos: position{line: 191, col: 9, offset: 5305},
 																		run: (*parser).callonExpression429,
 																		expr: &labeledExpr{
-																			pos:   position{line: 132, col: 9, offset: 3175},
+																			pos:   position{line: 191, col: 9, offset: 5305},
 																			label: "label",
 																			expr: &choiceExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				alternatives: []interface{}{
 																					&actionExpr{
-																						pos: position{line: 122, col: 15, offset: 2816},
+																						pos: position{line: 181, col: 15, offset: 4946},
 																						run: (*parser).callonExpression432,
 																						expr: &seqExpr{
-																							pos: position{line: 122, col: 15, offset: 2816},
+																							pos: position{line: 181, col: 15, offset: 4946},
 																							exprs: []interface{}{
 																								&choiceExpr{
-																									pos: position{line: 249, col: 5, offset: 6269},
+																									pos: position{line: 308, col: 5, offset: 8399},
 																									alternatives: []interface{}{
 																										&litMatcher{
-																											pos:        position{line: 231, col: 6, offset: 5936},
+																											pos:        position{line: 290, col: 6, offset: 8066},
 																											val:        "if",
 																											ignoreCase: false,
 																											want:       "\"if\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 232, col: 8, offset: 5950},
+																											pos:        position{line: 291, col: 8, offset: 8080},
 																											val:        "then",
 																											ignoreCase: false,
 																											want:       "\"then\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 233, col: 8, offset: 5966},
+																											pos:        position{line: 292, col: 8, offset: 8096},
 																											val:        "else",
 																											ignoreCase: false,
 																											want:       "\"else\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 234, col: 7, offset: 5981},
+																											pos:        position{line: 293, col: 7, offset: 8111},
 																											val:        "let",
 																											ignoreCase: false,
 																											want:       "\"let\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 235, col: 6, offset: 5994},
+																											pos:        position{line: 294, col: 6, offset: 8124},
 																											val:        "in",
 																											ignoreCase: false,
 																											want:       "\"in\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 237, col: 9, offset: 6021},
+																											pos:        position{line: 296, col: 9, offset: 8151},
 																											val:        "using",
 																											ignoreCase: false,
 																											want:       "\"using\"",
 																										},
 																										&actionExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											run: (*parser).callonExpression441,
 																											expr: &seqExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												exprs: []interface{}{
 																													&litMatcher{
-																														pos:        position{line: 239, col: 11, offset: 6059},
+																														pos:        position{line: 298, col: 11, offset: 8189},
 																														val:        "missing",
 																														ignoreCase: false,
 																														want:       "\"missing\"",
 																													},
 																													&notExpr{
-																														pos: position{line: 239, col: 21, offset: 6069},
+																														pos: position{line: 298, col: 21, offset: 8199},
 																														expr: &charClassMatcher{
-																															pos:        position{line: 121, col: 23, offset: 2785},
+																															pos:        position{line: 180, col: 23, offset: 4915},
 																															val:        "[_/-A-Za-z0-9]",
 																															chars:      []rune{'_', '/', '-'},
 																															ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -9120,61 +9168,61 @@ var g = &grammar{
 																											},
 																										},
 																										&litMatcher{
-																											pos:        position{line: 244, col: 10, offset: 6199},
+																											pos:        position{line: 303, col: 10, offset: 8329},
 																											val:        "assert",
 																											ignoreCase: false,
 																											want:       "\"assert\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 236, col: 6, offset: 6006},
+																											pos:        position{line: 295, col: 6, offset: 8136},
 																											val:        "as",
 																											ignoreCase: false,
 																											want:       "\"as\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 240, col: 12, offset: 6129},
+																											pos:        position{line: 299, col: 12, offset: 8259},
 																											val:        "Infinity",
 																											ignoreCase: false,
 																											want:       "\"Infinity\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 241, col: 7, offset: 6148},
+																											pos:        position{line: 300, col: 7, offset: 8278},
 																											val:        "NaN",
 																											ignoreCase: false,
 																											want:       "\"NaN\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 238, col: 9, offset: 6039},
+																											pos:        position{line: 297, col: 9, offset: 8169},
 																											val:        "merge",
 																											ignoreCase: false,
 																											want:       "\"merge\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 242, col: 8, offset: 6163},
+																											pos:        position{line: 301, col: 8, offset: 8293},
 																											val:        "Some",
 																											ignoreCase: false,
 																											want:       "\"Some\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 243, col: 9, offset: 6180},
+																											pos:        position{line: 302, col: 9, offset: 8310},
 																											val:        "toMap",
 																											ignoreCase: false,
 																											want:       "\"toMap\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 10, offset: 6219},
+																											pos:        position{line: 304, col: 10, offset: 8349},
 																											val:        "forall",
 																											ignoreCase: false,
 																											want:       "\"forall\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 21, offset: 6230},
+																											pos:        position{line: 304, col: 21, offset: 8360},
 																											val:        "∀",
 																											ignoreCase: false,
 																											want:       "\"∀\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 246, col: 8, offset: 6245},
+																											pos:        position{line: 305, col: 8, offset: 8375},
 																											val:        "with",
 																											ignoreCase: false,
 																											want:       "\"with\"",
@@ -9182,9 +9230,9 @@ var g = &grammar{
 																									},
 																								},
 																								&oneOrMoreExpr{
-																									pos: position{line: 122, col: 23, offset: 2824},
+																									pos: position{line: 181, col: 23, offset: 4954},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -9196,68 +9244,68 @@ var g = &grammar{
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 123, col: 13, offset: 2888},
+																						pos: position{line: 182, col: 13, offset: 5018},
 																						run: (*parser).callonExpression458,
 																						expr: &seqExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							exprs: []interface{}{
 																								&notExpr{
-																									pos: position{line: 123, col: 13, offset: 2888},
+																									pos: position{line: 182, col: 13, offset: 5018},
 																									expr: &choiceExpr{
-																										pos: position{line: 249, col: 5, offset: 6269},
+																										pos: position{line: 308, col: 5, offset: 8399},
 																										alternatives: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 231, col: 6, offset: 5936},
+																												pos:        position{line: 290, col: 6, offset: 8066},
 																												val:        "if",
 																												ignoreCase: false,
 																												want:       "\"if\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 232, col: 8, offset: 5950},
+																												pos:        position{line: 291, col: 8, offset: 8080},
 																												val:        "then",
 																												ignoreCase: false,
 																												want:       "\"then\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 233, col: 8, offset: 5966},
+																												pos:        position{line: 292, col: 8, offset: 8096},
 																												val:        "else",
 																												ignoreCase: false,
 																												want:       "\"else\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 234, col: 7, offset: 5981},
+																												pos:        position{line: 293, col: 7, offset: 8111},
 																												val:        "let",
 																												ignoreCase: false,
 																												want:       "\"let\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 235, col: 6, offset: 5994},
+																												pos:        position{line: 294, col: 6, offset: 8124},
 																												val:        "in",
 																												ignoreCase: false,
 																												want:       "\"in\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 237, col: 9, offset: 6021},
+																												pos:        position{line: 296, col: 9, offset: 8151},
 																												val:        "using",
 																												ignoreCase: false,
 																												want:       "\"using\"",
 																											},
 																											&actionExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												run: (*parser).callonExpression468,
 																												expr: &seqExpr{
-																													pos: position{line: 239, col: 11, offset: 6059},
+																													pos: position{line: 298, col: 11, offset: 8189},
 																													exprs: []interface{}{
 																														&litMatcher{
-																															pos:        position{line: 239, col: 11, offset: 6059},
+																															pos:        position{line: 298, col: 11, offset: 8189},
 																															val:        "missing",
 																															ignoreCase: false,
 																															want:       "\"missing\"",
 																														},
 																														&notExpr{
-																															pos: position{line: 239, col: 21, offset: 6069},
+																															pos: position{line: 298, col: 21, offset: 8199},
 																															expr: &charClassMatcher{
-																																pos:        position{line: 121, col: 23, offset: 2785},
+																																pos:        position{line: 180, col: 23, offset: 4915},
 																																val:        "[_/-A-Za-z0-9]",
 																																chars:      []rune{'_', '/', '-'},
 																																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -9269,61 +9317,61 @@ var g = &grammar{
 																												},
 																											},
 																											&litMatcher{
-																												pos:        position{line: 244, col: 10, offset: 6199},
+																												pos:        position{line: 303, col: 10, offset: 8329},
 																												val:        "assert",
 																												ignoreCase: false,
 																												want:       "\"assert\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 236, col: 6, offset: 6006},
+																												pos:        position{line: 295, col: 6, offset: 8136},
 																												val:        "as",
 																												ignoreCase: false,
 																												want:       "\"as\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 240, col: 12, offset: 6129},
+																												pos:        position{line: 299, col: 12, offset: 8259},
 																												val:        "Infinity",
 																												ignoreCase: false,
 																												want:       "\"Infinity\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 241, col: 7, offset: 6148},
+																												pos:        position{line: 300, col: 7, offset: 8278},
 																												val:        "NaN",
 																												ignoreCase: false,
 																												want:       "\"NaN\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 238, col: 9, offset: 6039},
+																												pos:        position{line: 297, col: 9, offset: 8169},
 																												val:        "merge",
 																												ignoreCase: false,
 																												want:       "\"merge\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 242, col: 8, offset: 6163},
+																												pos:        position{line: 301, col: 8, offset: 8293},
 																												val:        "Some",
 																												ignoreCase: false,
 																												want:       "\"Some\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 243, col: 9, offset: 6180},
+																												pos:        position{line: 302, col: 9, offset: 8310},
 																												val:        "toMap",
 																												ignoreCase: false,
 																												want:       "\"toMap\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 10, offset: 6219},
+																												pos:        position{line: 304, col: 10, offset: 8349},
 																												val:        "forall",
 																												ignoreCase: false,
 																												want:       "\"forall\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 21, offset: 6230},
+																												pos:        position{line: 304, col: 21, offset: 8360},
 																												val:        "∀",
 																												ignoreCase: false,
 																												want:       "\"∀\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 246, col: 8, offset: 6245},
+																												pos:        position{line: 305, col: 8, offset: 8375},
 																												val:        "with",
 																												ignoreCase: false,
 																												want:       "\"with\"",
@@ -9332,7 +9380,7 @@ var g = &grammar{
 																									},
 																								},
 																								&charClassMatcher{
-																									pos:        position{line: 120, col: 24, offset: 2751},
+																									pos:        position{line: 179, col: 24, offset: 4881},
 																									val:        "[_A-Za-z]",
 																									chars:      []rune{'_'},
 																									ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -9340,9 +9388,9 @@ var g = &grammar{
 																									inverted:   false,
 																								},
 																								&zeroOrMoreExpr{
-																									pos: position{line: 123, col: 43, offset: 2918},
+																									pos: position{line: 182, col: 43, offset: 5048},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -9364,361 +9412,361 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 135, col: 19, offset: 3320},
+												pos: position{line: 194, col: 19, offset: 5450},
 												run: (*parser).callonExpression486,
 												expr: &seqExpr{
-													pos: position{line: 135, col: 19, offset: 3320},
+													pos: position{line: 194, col: 19, offset: 5450},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 135, col: 19, offset: 3320},
+															pos: position{line: 194, col: 19, offset: 5450},
 															expr: &choiceExpr{
-																pos: position{line: 259, col: 5, offset: 6416},
+																pos: position{line: 318, col: 5, offset: 8546},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 259, col: 5, offset: 6416},
+																		pos: position{line: 318, col: 5, offset: 8546},
 																		run: (*parser).callonExpression490,
 																		expr: &litMatcher{
-																			pos:        position{line: 259, col: 5, offset: 6416},
+																			pos:        position{line: 318, col: 5, offset: 8546},
 																			val:        "Natural/fold",
 																			ignoreCase: false,
 																			want:       "\"Natural/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 260, col: 5, offset: 6463},
+																		pos: position{line: 319, col: 5, offset: 8593},
 																		run: (*parser).callonExpression492,
 																		expr: &litMatcher{
-																			pos:        position{line: 260, col: 5, offset: 6463},
+																			pos:        position{line: 319, col: 5, offset: 8593},
 																			val:        "Natural/build",
 																			ignoreCase: false,
 																			want:       "\"Natural/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 261, col: 5, offset: 6512},
+																		pos: position{line: 320, col: 5, offset: 8642},
 																		run: (*parser).callonExpression494,
 																		expr: &litMatcher{
-																			pos:        position{line: 261, col: 5, offset: 6512},
+																			pos:        position{line: 320, col: 5, offset: 8642},
 																			val:        "Natural/isZero",
 																			ignoreCase: false,
 																			want:       "\"Natural/isZero\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 262, col: 5, offset: 6563},
+																		pos: position{line: 321, col: 5, offset: 8693},
 																		run: (*parser).callonExpression496,
 																		expr: &litMatcher{
-																			pos:        position{line: 262, col: 5, offset: 6563},
+																			pos:        position{line: 321, col: 5, offset: 8693},
 																			val:        "Natural/even",
 																			ignoreCase: false,
 																			want:       "\"Natural/even\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 263, col: 5, offset: 6610},
+																		pos: position{line: 322, col: 5, offset: 8740},
 																		run: (*parser).callonExpression498,
 																		expr: &litMatcher{
-																			pos:        position{line: 263, col: 5, offset: 6610},
+																			pos:        position{line: 322, col: 5, offset: 8740},
 																			val:        "Natural/odd",
 																			ignoreCase: false,
 																			want:       "\"Natural/odd\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 264, col: 5, offset: 6655},
+																		pos: position{line: 323, col: 5, offset: 8785},
 																		run: (*parser).callonExpression500,
 																		expr: &litMatcher{
-																			pos:        position{line: 264, col: 5, offset: 6655},
+																			pos:        position{line: 323, col: 5, offset: 8785},
 																			val:        "Natural/toInteger",
 																			ignoreCase: false,
 																			want:       "\"Natural/toInteger\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 265, col: 5, offset: 6712},
+																		pos: position{line: 324, col: 5, offset: 8842},
 																		run: (*parser).callonExpression502,
 																		expr: &litMatcher{
-																			pos:        position{line: 265, col: 5, offset: 6712},
+																			pos:        position{line: 324, col: 5, offset: 8842},
 																			val:        "Natural/show",
 																			ignoreCase: false,
 																			want:       "\"Natural/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 266, col: 5, offset: 6759},
+																		pos: position{line: 325, col: 5, offset: 8889},
 																		run: (*parser).callonExpression504,
 																		expr: &litMatcher{
-																			pos:        position{line: 266, col: 5, offset: 6759},
+																			pos:        position{line: 325, col: 5, offset: 8889},
 																			val:        "Integer/toDouble",
 																			ignoreCase: false,
 																			want:       "\"Integer/toDouble\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 267, col: 5, offset: 6814},
+																		pos: position{line: 326, col: 5, offset: 8944},
 																		run: (*parser).callonExpression506,
 																		expr: &litMatcher{
-																			pos:        position{line: 267, col: 5, offset: 6814},
+																			pos:        position{line: 326, col: 5, offset: 8944},
 																			val:        "Integer/show",
 																			ignoreCase: false,
 																			want:       "\"Integer/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 268, col: 5, offset: 6861},
+																		pos: position{line: 327, col: 5, offset: 8991},
 																		run: (*parser).callonExpression508,
 																		expr: &litMatcher{
-																			pos:        position{line: 268, col: 5, offset: 6861},
+																			pos:        position{line: 327, col: 5, offset: 8991},
 																			val:        "Integer/negate",
 																			ignoreCase: false,
 																			want:       "\"Integer/negate\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 269, col: 5, offset: 6912},
+																		pos: position{line: 328, col: 5, offset: 9042},
 																		run: (*parser).callonExpression510,
 																		expr: &litMatcher{
-																			pos:        position{line: 269, col: 5, offset: 6912},
+																			pos:        position{line: 328, col: 5, offset: 9042},
 																			val:        "Integer/clamp",
 																			ignoreCase: false,
 																			want:       "\"Integer/clamp\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 270, col: 5, offset: 6961},
+																		pos: position{line: 329, col: 5, offset: 9091},
 																		run: (*parser).callonExpression512,
 																		expr: &litMatcher{
-																			pos:        position{line: 270, col: 5, offset: 6961},
+																			pos:        position{line: 329, col: 5, offset: 9091},
 																			val:        "Natural/subtract",
 																			ignoreCase: false,
 																			want:       "\"Natural/subtract\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 271, col: 5, offset: 7016},
+																		pos: position{line: 330, col: 5, offset: 9146},
 																		run: (*parser).callonExpression514,
 																		expr: &litMatcher{
-																			pos:        position{line: 271, col: 5, offset: 7016},
+																			pos:        position{line: 330, col: 5, offset: 9146},
 																			val:        "Double/show",
 																			ignoreCase: false,
 																			want:       "\"Double/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 272, col: 5, offset: 7061},
+																		pos: position{line: 331, col: 5, offset: 9191},
 																		run: (*parser).callonExpression516,
 																		expr: &litMatcher{
-																			pos:        position{line: 272, col: 5, offset: 7061},
+																			pos:        position{line: 331, col: 5, offset: 9191},
 																			val:        "List/build",
 																			ignoreCase: false,
 																			want:       "\"List/build\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 273, col: 5, offset: 7104},
+																		pos: position{line: 332, col: 5, offset: 9234},
 																		run: (*parser).callonExpression518,
 																		expr: &litMatcher{
-																			pos:        position{line: 273, col: 5, offset: 7104},
+																			pos:        position{line: 332, col: 5, offset: 9234},
 																			val:        "List/fold",
 																			ignoreCase: false,
 																			want:       "\"List/fold\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 274, col: 5, offset: 7145},
+																		pos: position{line: 333, col: 5, offset: 9275},
 																		run: (*parser).callonExpression520,
 																		expr: &litMatcher{
-																			pos:        position{line: 274, col: 5, offset: 7145},
+																			pos:        position{line: 333, col: 5, offset: 9275},
 																			val:        "List/length",
 																			ignoreCase: false,
 																			want:       "\"List/length\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 275, col: 5, offset: 7190},
+																		pos: position{line: 334, col: 5, offset: 9320},
 																		run: (*parser).callonExpression522,
 																		expr: &litMatcher{
-																			pos:        position{line: 275, col: 5, offset: 7190},
+																			pos:        position{line: 334, col: 5, offset: 9320},
 																			val:        "List/head",
 																			ignoreCase: false,
 																			want:       "\"List/head\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 276, col: 5, offset: 7231},
+																		pos: position{line: 335, col: 5, offset: 9361},
 																		run: (*parser).callonExpression524,
 																		expr: &litMatcher{
-																			pos:        position{line: 276, col: 5, offset: 7231},
+																			pos:        position{line: 335, col: 5, offset: 9361},
 																			val:        "List/last",
 																			ignoreCase: false,
 																			want:       "\"List/last\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 277, col: 5, offset: 7272},
+																		pos: position{line: 336, col: 5, offset: 9402},
 																		run: (*parser).callonExpression526,
 																		expr: &litMatcher{
-																			pos:        position{line: 277, col: 5, offset: 7272},
+																			pos:        position{line: 336, col: 5, offset: 9402},
 																			val:        "List/indexed",
 																			ignoreCase: false,
 																			want:       "\"List/indexed\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 278, col: 5, offset: 7319},
+																		pos: position{line: 337, col: 5, offset: 9449},
 																		run: (*parser).callonExpression528,
 																		expr: &litMatcher{
-																			pos:        position{line: 278, col: 5, offset: 7319},
+																			pos:        position{line: 337, col: 5, offset: 9449},
 																			val:        "List/reverse",
 																			ignoreCase: false,
 																			want:       "\"List/reverse\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 279, col: 5, offset: 7366},
+																		pos: position{line: 338, col: 5, offset: 9496},
 																		run: (*parser).callonExpression530,
 																		expr: &litMatcher{
-																			pos:        position{line: 279, col: 5, offset: 7366},
+																			pos:        position{line: 338, col: 5, offset: 9496},
 																			val:        "Text/show",
 																			ignoreCase: false,
 																			want:       "\"Text/show\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 280, col: 5, offset: 7407},
+																		pos: position{line: 339, col: 5, offset: 9537},
 																		run: (*parser).callonExpression532,
 																		expr: &litMatcher{
-																			pos:        position{line: 280, col: 5, offset: 7407},
+																			pos:        position{line: 339, col: 5, offset: 9537},
 																			val:        "Text/replace",
 																			ignoreCase: false,
 																			want:       "\"Text/replace\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 281, col: 5, offset: 7454},
+																		pos: position{line: 340, col: 5, offset: 9584},
 																		run: (*parser).callonExpression534,
 																		expr: &litMatcher{
-																			pos:        position{line: 281, col: 5, offset: 7454},
+																			pos:        position{line: 340, col: 5, offset: 9584},
 																			val:        "Bool",
 																			ignoreCase: false,
 																			want:       "\"Bool\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 282, col: 5, offset: 7486},
+																		pos: position{line: 341, col: 5, offset: 9616},
 																		run: (*parser).callonExpression536,
 																		expr: &litMatcher{
-																			pos:        position{line: 282, col: 5, offset: 7486},
+																			pos:        position{line: 341, col: 5, offset: 9616},
 																			val:        "True",
 																			ignoreCase: false,
 																			want:       "\"True\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 283, col: 5, offset: 7518},
+																		pos: position{line: 342, col: 5, offset: 9648},
 																		run: (*parser).callonExpression538,
 																		expr: &litMatcher{
-																			pos:        position{line: 283, col: 5, offset: 7518},
+																			pos:        position{line: 342, col: 5, offset: 9648},
 																			val:        "False",
 																			ignoreCase: false,
 																			want:       "\"False\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 284, col: 5, offset: 7552},
+																		pos: position{line: 343, col: 5, offset: 9682},
 																		run: (*parser).callonExpression540,
 																		expr: &litMatcher{
-																			pos:        position{line: 284, col: 5, offset: 7552},
+																			pos:        position{line: 343, col: 5, offset: 9682},
 																			val:        "Optional",
 																			ignoreCase: false,
 																			want:       "\"Optional\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 285, col: 5, offset: 7592},
+																		pos: position{line: 344, col: 5, offset: 9722},
 																		run: (*parser).callonExpression542,
 																		expr: &litMatcher{
-																			pos:        position{line: 285, col: 5, offset: 7592},
+																			pos:        position{line: 344, col: 5, offset: 9722},
 																			val:        "None",
 																			ignoreCase: false,
 																			want:       "\"None\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 286, col: 5, offset: 7624},
+																		pos: position{line: 345, col: 5, offset: 9754},
 																		run: (*parser).callonExpression544,
 																		expr: &litMatcher{
-																			pos:        position{line: 286, col: 5, offset: 7624},
+																			pos:        position{line: 345, col: 5, offset: 9754},
 																			val:        "Natural",
 																			ignoreCase: false,
 																			want:       "\"Natural\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 287, col: 5, offset: 7662},
+																		pos: position{line: 346, col: 5, offset: 9792},
 																		run: (*parser).callonExpression546,
 																		expr: &litMatcher{
-																			pos:        position{line: 287, col: 5, offset: 7662},
+																			pos:        position{line: 346, col: 5, offset: 9792},
 																			val:        "Integer",
 																			ignoreCase: false,
 																			want:       "\"Integer\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 288, col: 5, offset: 7700},
+																		pos: position{line: 347, col: 5, offset: 9830},
 																		run: (*parser).callonExpression548,
 																		expr: &litMatcher{
-																			pos:        position{line: 288, col: 5, offset: 7700},
+																			pos:        position{line: 347, col: 5, offset: 9830},
 																			val:        "Double",
 																			ignoreCase: false,
 																			want:       "\"Double\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 289, col: 5, offset: 7736},
+																		pos: position{line: 348, col: 5, offset: 9866},
 																		run: (*parser).callonExpression550,
 																		expr: &litMatcher{
-																			pos:        position{line: 289, col: 5, offset: 7736},
+																			pos:        position{line: 348, col: 5, offset: 9866},
 																			val:        "Text",
 																			ignoreCase: false,
 																			want:       "\"Text\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 290, col: 5, offset: 7768},
+																		pos: position{line: 349, col: 5, offset: 9898},
 																		run: (*parser).callonExpression552,
 																		expr: &litMatcher{
-																			pos:        position{line: 290, col: 5, offset: 7768},
+																			pos:        position{line: 349, col: 5, offset: 9898},
 																			val:        "List",
 																			ignoreCase: false,
 																			want:       "\"List\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 291, col: 5, offset: 7800},
+																		pos: position{line: 350, col: 5, offset: 9930},
 																		run: (*parser).callonExpression554,
 																		expr: &litMatcher{
-																			pos:        position{line: 291, col: 5, offset: 7800},
+																			pos:        position{line: 350, col: 5, offset: 9930},
 																			val:        "Type",
 																			ignoreCase: false,
 																			want:       "\"Type\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 292, col: 5, offset: 7832},
+																		pos: position{line: 351, col: 5, offset: 9962},
 																		run: (*parser).callonExpression556,
 																		expr: &litMatcher{
-																			pos:        position{line: 292, col: 5, offset: 7832},
+																			pos:        position{line: 351, col: 5, offset: 9962},
 																			val:        "Kind",
 																			ignoreCase: false,
 																			want:       "\"Kind\"",
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 293, col: 5, offset: 7864},
+																		pos: position{line: 352, col: 5, offset: 9994},
 																		run: (*parser).callonExpression558,
 																		expr: &litMatcher{
-																			pos:        position{line: 293, col: 5, offset: 7864},
+																			pos:        position{line: 352, col: 5, offset: 9994},
 																			val:        "Sort",
 																			ignoreCase: false,
 																			want:       "\"Sort\"",
@@ -9728,33 +9776,33 @@ var g = &grammar{
 															},
 														},
 														&labeledExpr{
-															pos:   position{line: 135, col: 28, offset: 3329},
+															pos:   position{line: 194, col: 28, offset: 5459},
 															label: "label",
 															expr: &choiceExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																alternatives: []interface{}{
 																	&actionExpr{
-																		pos: position{line: 131, col: 9, offset: 3119},
+																		pos: position{line: 190, col: 9, offset: 5249},
 																		run: (*parser).callonExpression562,
 																		expr: &seqExpr{
-																			pos: position{line: 131, col: 9, offset: 3119},
+																			pos: position{line: 190, col: 9, offset: 5249},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 131, col: 9, offset: 3119},
+																					pos:        position{line: 190, col: 9, offset: 5249},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
 																				},
 																				&labeledExpr{
-																					pos:   position{line: 131, col: 13, offset: 3123},
+																					pos:   position{line: 190, col: 13, offset: 5253},
 																					label: "label",
 																					expr: &actionExpr{
-																						pos: position{line: 129, col: 15, offset: 3060},
+																						pos: position{line: 188, col: 15, offset: 5190},
 																						run: (*parser).callonExpression566,
 																						expr: &zeroOrMoreExpr{
-																							pos: position{line: 129, col: 15, offset: 3060},
+																							pos: position{line: 188, col: 15, offset: 5190},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 128, col: 19, offset: 3023},
+																								pos:        position{line: 187, col: 19, offset: 5153},
 																								val:        "[ -_a-~]",
 																								ranges:     []rune{' ', '_', 'a', '~'},
 																								ignoreCase: false,
@@ -9764,7 +9812,7 @@ var g = &grammar{
 																					},
 																				},
 																				&litMatcher{
-																					pos:        position{line: 131, col: 31, offset: 3141},
+																					pos:        position{line: 190, col: 31, offset: 5271},
 																					val:        "`",
 																					ignoreCase: false,
 																					want:       "\"`\"",
@@ -9773,75 +9821,75 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 132, col: 9, offset: 3175},
+																		pos: position{line: 191, col: 9, offset: 5305},
 																		run: (*parser).callonExpression570,
 																		expr: &labeledExpr{
-																			pos:   position{line: 132, col: 9, offset: 3175},
+																			pos:   position{line: 191, col: 9, offset: 5305},
 																			label: "label",
 																			expr: &choiceExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				alternatives: []interface{}{
 																					&actionExpr{
-																						pos: position{line: 122, col: 15, offset: 2816},
+																						pos: position{line: 181, col: 15, offset: 4946},
 																						run: (*parser).callonExpression573,
 																						expr: &seqExpr{
-																							pos: position{line: 122, col: 15, offset: 2816},
+																							pos: position{line: 181, col: 15, offset: 4946},
 																							exprs: []interface{}{
 																								&choiceExpr{
-																									pos: position{line: 249, col: 5, offset: 6269},
+																									pos: position{line: 308, col: 5, offset: 8399},
 																									alternatives: []interface{}{
 																										&litMatcher{
-																											pos:        position{line: 231, col: 6, offset: 5936},
+																											pos:        position{line: 290, col: 6, offset: 8066},
 																											val:        "if",
 																											ignoreCase: false,
 																											want:       "\"if\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 232, col: 8, offset: 5950},
+																											pos:        position{line: 291, col: 8, offset: 8080},
 																											val:        "then",
 																											ignoreCase: false,
 																											want:       "\"then\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 233, col: 8, offset: 5966},
+																											pos:        position{line: 292, col: 8, offset: 8096},
 																											val:        "else",
 																											ignoreCase: false,
 																											want:       "\"else\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 234, col: 7, offset: 5981},
+																											pos:        position{line: 293, col: 7, offset: 8111},
 																											val:        "let",
 																											ignoreCase: false,
 																											want:       "\"let\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 235, col: 6, offset: 5994},
+																											pos:        position{line: 294, col: 6, offset: 8124},
 																											val:        "in",
 																											ignoreCase: false,
 																											want:       "\"in\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 237, col: 9, offset: 6021},
+																											pos:        position{line: 296, col: 9, offset: 8151},
 																											val:        "using",
 																											ignoreCase: false,
 																											want:       "\"using\"",
 																										},
 																										&actionExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											run: (*parser).callonExpression582,
 																											expr: &seqExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												exprs: []interface{}{
 																													&litMatcher{
-																														pos:        position{line: 239, col: 11, offset: 6059},
+																														pos:        position{line: 298, col: 11, offset: 8189},
 																														val:        "missing",
 																														ignoreCase: false,
 																														want:       "\"missing\"",
 																													},
 																													&notExpr{
-																														pos: position{line: 239, col: 21, offset: 6069},
+																														pos: position{line: 298, col: 21, offset: 8199},
 																														expr: &charClassMatcher{
-																															pos:        position{line: 121, col: 23, offset: 2785},
+																															pos:        position{line: 180, col: 23, offset: 4915},
 																															val:        "[_/-A-Za-z0-9]",
 																															chars:      []rune{'_', '/', '-'},
 																															ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -9853,61 +9901,61 @@ var g = &grammar{
 																											},
 																										},
 																										&litMatcher{
-																											pos:        position{line: 244, col: 10, offset: 6199},
+																											pos:        position{line: 303, col: 10, offset: 8329},
 																											val:        "assert",
 																											ignoreCase: false,
 																											want:       "\"assert\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 236, col: 6, offset: 6006},
+																											pos:        position{line: 295, col: 6, offset: 8136},
 																											val:        "as",
 																											ignoreCase: false,
 																											want:       "\"as\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 240, col: 12, offset: 6129},
+																											pos:        position{line: 299, col: 12, offset: 8259},
 																											val:        "Infinity",
 																											ignoreCase: false,
 																											want:       "\"Infinity\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 241, col: 7, offset: 6148},
+																											pos:        position{line: 300, col: 7, offset: 8278},
 																											val:        "NaN",
 																											ignoreCase: false,
 																											want:       "\"NaN\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 238, col: 9, offset: 6039},
+																											pos:        position{line: 297, col: 9, offset: 8169},
 																											val:        "merge",
 																											ignoreCase: false,
 																											want:       "\"merge\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 242, col: 8, offset: 6163},
+																											pos:        position{line: 301, col: 8, offset: 8293},
 																											val:        "Some",
 																											ignoreCase: false,
 																											want:       "\"Some\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 243, col: 9, offset: 6180},
+																											pos:        position{line: 302, col: 9, offset: 8310},
 																											val:        "toMap",
 																											ignoreCase: false,
 																											want:       "\"toMap\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 10, offset: 6219},
+																											pos:        position{line: 304, col: 10, offset: 8349},
 																											val:        "forall",
 																											ignoreCase: false,
 																											want:       "\"forall\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 245, col: 21, offset: 6230},
+																											pos:        position{line: 304, col: 21, offset: 8360},
 																											val:        "∀",
 																											ignoreCase: false,
 																											want:       "\"∀\"",
 																										},
 																										&litMatcher{
-																											pos:        position{line: 246, col: 8, offset: 6245},
+																											pos:        position{line: 305, col: 8, offset: 8375},
 																											val:        "with",
 																											ignoreCase: false,
 																											want:       "\"with\"",
@@ -9915,9 +9963,9 @@ var g = &grammar{
 																									},
 																								},
 																								&oneOrMoreExpr{
-																									pos: position{line: 122, col: 23, offset: 2824},
+																									pos: position{line: 181, col: 23, offset: 4954},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -9929,68 +9977,68 @@ var g = &grammar{
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 123, col: 13, offset: 2888},
+																						pos: position{line: 182, col: 13, offset: 5018},
 																						run: (*parser).callonExpression599,
 																						expr: &seqExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							exprs: []interface{}{
 																								&notExpr{
-																									pos: position{line: 123, col: 13, offset: 2888},
+																									pos: position{line: 182, col: 13, offset: 5018},
 																									expr: &choiceExpr{
-																										pos: position{line: 249, col: 5, offset: 6269},
+																										pos: position{line: 308, col: 5, offset: 8399},
 																										alternatives: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 231, col: 6, offset: 5936},
+																												pos:        position{line: 290, col: 6, offset: 8066},
 																												val:        "if",
 																												ignoreCase: false,
 																												want:       "\"if\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 232, col: 8, offset: 5950},
+																												pos:        position{line: 291, col: 8, offset: 8080},
 																												val:        "then",
 																												ignoreCase: false,
 																												want:       "\"then\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 233, col: 8, offset: 5966},
+																												pos:        position{line: 292, col: 8, offset: 8096},
 																												val:        "else",
 																												ignoreCase: false,
 																												want:       "\"else\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 234, col: 7, offset: 5981},
+																												pos:        position{line: 293, col: 7, offset: 8111},
 																												val:        "let",
 																												ignoreCase: false,
 																												want:       "\"let\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 235, col: 6, offset: 5994},
+																												pos:        position{line: 294, col: 6, offset: 8124},
 																												val:        "in",
 																												ignoreCase: false,
 																												want:       "\"in\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 237, col: 9, offset: 6021},
+																												pos:        position{line: 296, col: 9, offset: 8151},
 																												val:        "using",
 																												ignoreCase: false,
 																												want:       "\"using\"",
 																											},
 																											&actionExpr{
-																												pos: position{line: 239, col: 11, offset: 6059},
+																												pos: position{line: 298, col: 11, offset: 8189},
 																												run: (*parser).callonExpression609,
 																												expr: &seqExpr{
-																													pos: position{line: 239, col: 11, offset: 6059},
+																													pos: position{line: 298, col: 11, offset: 8189},
 																													exprs: []interface{}{
 																														&litMatcher{
-																															pos:        position{line: 239, col: 11, offset: 6059},
+																															pos:        position{line: 298, col: 11, offset: 8189},
 																															val:        "missing",
 																															ignoreCase: false,
 																															want:       "\"missing\"",
 																														},
 																														&notExpr{
-																															pos: position{line: 239, col: 21, offset: 6069},
+																															pos: position{line: 298, col: 21, offset: 8199},
 																															expr: &charClassMatcher{
-																																pos:        position{line: 121, col: 23, offset: 2785},
+																																pos:        position{line: 180, col: 23, offset: 4915},
 																																val:        "[_/-A-Za-z0-9]",
 																																chars:      []rune{'_', '/', '-'},
 																																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -10002,61 +10050,61 @@ var g = &grammar{
 																												},
 																											},
 																											&litMatcher{
-																												pos:        position{line: 244, col: 10, offset: 6199},
+																												pos:        position{line: 303, col: 10, offset: 8329},
 																												val:        "assert",
 																												ignoreCase: false,
 																												want:       "\"assert\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 236, col: 6, offset: 6006},
+																												pos:        position{line: 295, col: 6, offset: 8136},
 																												val:        "as",
 																												ignoreCase: false,
 																												want:       "\"as\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 240, col: 12, offset: 6129},
+																												pos:        position{line: 299, col: 12, offset: 8259},
 																												val:        "Infinity",
 																												ignoreCase: false,
 																												want:       "\"Infinity\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 241, col: 7, offset: 6148},
+																												pos:        position{line: 300, col: 7, offset: 8278},
 																												val:        "NaN",
 																												ignoreCase: false,
 																												want:       "\"NaN\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 238, col: 9, offset: 6039},
+																												pos:        position{line: 297, col: 9, offset: 8169},
 																												val:        "merge",
 																												ignoreCase: false,
 																												want:       "\"merge\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 242, col: 8, offset: 6163},
+																												pos:        position{line: 301, col: 8, offset: 8293},
 																												val:        "Some",
 																												ignoreCase: false,
 																												want:       "\"Some\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 243, col: 9, offset: 6180},
+																												pos:        position{line: 302, col: 9, offset: 8310},
 																												val:        "toMap",
 																												ignoreCase: false,
 																												want:       "\"toMap\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 10, offset: 6219},
+																												pos:        position{line: 304, col: 10, offset: 8349},
 																												val:        "forall",
 																												ignoreCase: false,
 																												want:       "\"forall\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 245, col: 21, offset: 6230},
+																												pos:        position{line: 304, col: 21, offset: 8360},
 																												val:        "∀",
 																												ignoreCase: false,
 																												want:       "\"∀\"",
 																											},
 																											&litMatcher{
-																												pos:        position{line: 246, col: 8, offset: 6245},
+																												pos:        position{line: 305, col: 8, offset: 8375},
 																												val:        "with",
 																												ignoreCase: false,
 																												want:       "\"with\"",
@@ -10065,7 +10113,7 @@ var g = &grammar{
 																									},
 																								},
 																								&charClassMatcher{
-																									pos:        position{line: 120, col: 24, offset: 2751},
+																									pos:        position{line: 179, col: 24, offset: 4881},
 																									val:        "[_A-Za-z]",
 																									chars:      []rune{'_'},
 																									ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -10073,9 +10121,9 @@ var g = &grammar{
 																									inverted:   false,
 																								},
 																								&zeroOrMoreExpr{
-																									pos: position{line: 123, col: 43, offset: 2918},
+																									pos: position{line: 182, col: 43, offset: 5048},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -10100,52 +10148,52 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 45, offset: 15122},
+									pos:  position{line: 589, col: 45, offset: 17541},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 525, col: 47, offset: 15124},
+									pos:        position{line: 589, col: 47, offset: 17543},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 51, offset: 15128},
+									pos:  position{line: 589, col: 51, offset: 17547},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 525, col: 54, offset: 15131},
+									pos:   position{line: 589, col: 54, offset: 17550},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 525, col: 56, offset: 15133},
+										pos:  position{line: 589, col: 56, offset: 17552},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 67, offset: 15144},
+									pos:  position{line: 589, col: 67, offset: 17563},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 525, col: 69, offset: 15146},
+									pos:        position{line: 589, col: 69, offset: 17565},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 73, offset: 15150},
+									pos:  position{line: 589, col: 73, offset: 17569},
 									name: "_",
 								},
 								&choiceExpr{
-									pos: position{line: 303, col: 9, offset: 8080},
+									pos: position{line: 362, col: 9, offset: 10210},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 303, col: 9, offset: 8080},
+											pos:        position{line: 362, col: 9, offset: 10210},
 											val:        "->",
 											ignoreCase: false,
 											want:       "\"->\"",
 										},
 										&litMatcher{
-											pos:        position{line: 303, col: 16, offset: 8087},
+											pos:        position{line: 362, col: 16, offset: 10217},
 											val:        "→",
 											ignoreCase: false,
 											want:       "\"→\"",
@@ -10153,14 +10201,14 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 525, col: 81, offset: 15158},
+									pos:  position{line: 589, col: 81, offset: 17577},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 525, col: 83, offset: 15160},
+									pos:   position{line: 589, col: 83, offset: 17579},
 									label: "body",
 									expr: &ruleRefExpr{
-										pos:  position{line: 525, col: 88, offset: 15165},
+										pos:  position{line: 589, col: 88, offset: 17584},
 										name: "Expression",
 									},
 								},
@@ -10168,34 +10216,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 528, col: 7, offset: 15273},
+						pos: position{line: 592, col: 7, offset: 17692},
 						run: (*parser).callonExpression641,
 						expr: &seqExpr{
-							pos: position{line: 528, col: 7, offset: 15273},
+							pos: position{line: 592, col: 7, offset: 17692},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 528, col: 7, offset: 15273},
+									pos:   position{line: 592, col: 7, offset: 17692},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 528, col: 9, offset: 15275},
+										pos:  position{line: 592, col: 9, offset: 17694},
 										name: "OperatorExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 528, col: 28, offset: 15294},
+									pos:  position{line: 592, col: 28, offset: 17713},
 									name: "_",
 								},
 								&choiceExpr{
-									pos: position{line: 303, col: 9, offset: 8080},
+									pos: position{line: 362, col: 9, offset: 10210},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 303, col: 9, offset: 8080},
+											pos:        position{line: 362, col: 9, offset: 10210},
 											val:        "->",
 											ignoreCase: false,
 											want:       "\"->\"",
 										},
 										&litMatcher{
-											pos:        position{line: 303, col: 16, offset: 8087},
+											pos:        position{line: 362, col: 16, offset: 10217},
 											val:        "→",
 											ignoreCase: false,
 											want:       "\"→\"",
@@ -10203,14 +10251,14 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 528, col: 36, offset: 15302},
+									pos:  position{line: 592, col: 36, offset: 17721},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 528, col: 38, offset: 15304},
+									pos:   position{line: 592, col: 38, offset: 17723},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 528, col: 40, offset: 15306},
+										pos:  position{line: 592, col: 40, offset: 17725},
 										name: "Expression",
 									},
 								},
@@ -10218,64 +10266,64 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 529, col: 7, offset: 15368},
+						pos:  position{line: 593, col: 7, offset: 17787},
 						name: "WithExpression",
 					},
 					&actionExpr{
-						pos: position{line: 530, col: 7, offset: 15389},
+						pos: position{line: 594, col: 7, offset: 17808},
 						run: (*parser).callonExpression653,
 						expr: &seqExpr{
-							pos: position{line: 530, col: 7, offset: 15389},
+							pos: position{line: 594, col: 7, offset: 17808},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 238, col: 9, offset: 6039},
+									pos:        position{line: 297, col: 9, offset: 8169},
 									val:        "merge",
 									ignoreCase: false,
 									want:       "\"merge\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 530, col: 13, offset: 15395},
+									pos:  position{line: 594, col: 13, offset: 17814},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 530, col: 16, offset: 15398},
+									pos:   position{line: 594, col: 16, offset: 17817},
 									label: "h",
 									expr: &ruleRefExpr{
-										pos:  position{line: 530, col: 18, offset: 15400},
+										pos:  position{line: 594, col: 18, offset: 17819},
 										name: "ImportExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 530, col: 35, offset: 15417},
+									pos:  position{line: 594, col: 35, offset: 17836},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 530, col: 38, offset: 15420},
+									pos:   position{line: 594, col: 38, offset: 17839},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 530, col: 40, offset: 15422},
+										pos:  position{line: 594, col: 40, offset: 17841},
 										name: "ImportExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 530, col: 57, offset: 15439},
+									pos:  position{line: 594, col: 57, offset: 17858},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 530, col: 59, offset: 15441},
+									pos:        position{line: 594, col: 59, offset: 17860},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 530, col: 63, offset: 15445},
+									pos:  position{line: 594, col: 63, offset: 17864},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 530, col: 66, offset: 15448},
+									pos:   position{line: 594, col: 66, offset: 17867},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 530, col: 68, offset: 15450},
+										pos:  position{line: 594, col: 68, offset: 17869},
 										name: "ApplicationExpression",
 									},
 								},
@@ -10283,52 +10331,52 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 533, col: 7, offset: 15571},
+						pos:  position{line: 597, col: 7, offset: 17990},
 						name: "EmptyList",
 					},
 					&actionExpr{
-						pos: position{line: 534, col: 7, offset: 15587},
+						pos: position{line: 598, col: 7, offset: 18006},
 						run: (*parser).callonExpression668,
 						expr: &seqExpr{
-							pos: position{line: 534, col: 7, offset: 15587},
+							pos: position{line: 598, col: 7, offset: 18006},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 243, col: 9, offset: 6180},
+									pos:        position{line: 302, col: 9, offset: 8310},
 									val:        "toMap",
 									ignoreCase: false,
 									want:       "\"toMap\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 534, col: 13, offset: 15593},
+									pos:  position{line: 598, col: 13, offset: 18012},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 534, col: 16, offset: 15596},
+									pos:   position{line: 598, col: 16, offset: 18015},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 534, col: 18, offset: 15598},
+										pos:  position{line: 598, col: 18, offset: 18017},
 										name: "ImportExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 534, col: 35, offset: 15615},
+									pos:  position{line: 598, col: 35, offset: 18034},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 534, col: 37, offset: 15617},
+									pos:        position{line: 598, col: 37, offset: 18036},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 534, col: 41, offset: 15621},
+									pos:  position{line: 598, col: 41, offset: 18040},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 534, col: 44, offset: 15624},
+									pos:   position{line: 598, col: 44, offset: 18043},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 534, col: 46, offset: 15626},
+										pos:  position{line: 598, col: 46, offset: 18045},
 										name: "ApplicationExpression",
 									},
 								},
@@ -10336,36 +10384,36 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 535, col: 7, offset: 15696},
+						pos: position{line: 599, col: 7, offset: 18115},
 						run: (*parser).callonExpression679,
 						expr: &seqExpr{
-							pos: position{line: 535, col: 7, offset: 15696},
+							pos: position{line: 599, col: 7, offset: 18115},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 244, col: 10, offset: 6199},
+									pos:        position{line: 303, col: 10, offset: 8329},
 									val:        "assert",
 									ignoreCase: false,
 									want:       "\"assert\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 535, col: 14, offset: 15703},
+									pos:  position{line: 599, col: 14, offset: 18122},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 535, col: 16, offset: 15705},
+									pos:        position{line: 599, col: 16, offset: 18124},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 535, col: 20, offset: 15709},
+									pos:  position{line: 599, col: 20, offset: 18128},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 535, col: 23, offset: 15712},
+									pos:   position{line: 599, col: 23, offset: 18131},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 535, col: 25, offset: 15714},
+										pos:  position{line: 599, col: 25, offset: 18133},
 										name: "Expression",
 									},
 								},
@@ -10373,7 +10421,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 536, col: 7, offset: 15776},
+						pos:  position{line: 600, col: 7, offset: 18195},
 						name: "AnnotatedExpression",
 					},
 				},
@@ -10381,28 +10429,28 @@ var g = &grammar{
 		},
 		{
 			name: "Annotation",
-			pos:  position{line: 538, col: 1, offset: 15797},
+			pos:  position{line: 602, col: 1, offset: 18216},
 			expr: &actionExpr{
-				pos: position{line: 538, col: 14, offset: 15812},
+				pos: position{line: 602, col: 14, offset: 18231},
 				run: (*parser).callonAnnotation1,
 				expr: &seqExpr{
-					pos: position{line: 538, col: 14, offset: 15812},
+					pos: position{line: 602, col: 14, offset: 18231},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 538, col: 14, offset: 15812},
+							pos:        position{line: 602, col: 14, offset: 18231},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 538, col: 18, offset: 15816},
+							pos:  position{line: 602, col: 18, offset: 18235},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 538, col: 21, offset: 15819},
+							pos:   position{line: 602, col: 21, offset: 18238},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 538, col: 23, offset: 15821},
+								pos:  position{line: 602, col: 23, offset: 18240},
 								name: "Expression",
 							},
 						},
@@ -10412,35 +10460,35 @@ var g = &grammar{
 		},
 		{
 			name: "AnnotatedExpression",
-			pos:  position{line: 540, col: 1, offset: 15851},
+			pos:  position{line: 604, col: 1, offset: 18270},
 			expr: &actionExpr{
-				pos: position{line: 541, col: 1, offset: 15875},
+				pos: position{line: 605, col: 1, offset: 18294},
 				run: (*parser).callonAnnotatedExpression1,
 				expr: &seqExpr{
-					pos: position{line: 541, col: 1, offset: 15875},
+					pos: position{line: 605, col: 1, offset: 18294},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 541, col: 1, offset: 15875},
+							pos:   position{line: 605, col: 1, offset: 18294},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 541, col: 3, offset: 15877},
+								pos:  position{line: 605, col: 3, offset: 18296},
 								name: "OperatorExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 541, col: 22, offset: 15896},
+							pos:   position{line: 605, col: 22, offset: 18315},
 							label: "a",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 541, col: 24, offset: 15898},
+								pos: position{line: 605, col: 24, offset: 18317},
 								expr: &seqExpr{
-									pos: position{line: 541, col: 25, offset: 15899},
+									pos: position{line: 605, col: 25, offset: 18318},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 541, col: 25, offset: 15899},
+											pos:  position{line: 605, col: 25, offset: 18318},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 541, col: 27, offset: 15901},
+											pos:  position{line: 605, col: 27, offset: 18320},
 											name: "Annotation",
 										},
 									},
@@ -10453,66 +10501,66 @@ var g = &grammar{
 		},
 		{
 			name: "EmptyList",
-			pos:  position{line: 546, col: 1, offset: 16026},
+			pos:  position{line: 610, col: 1, offset: 18445},
 			expr: &actionExpr{
-				pos: position{line: 546, col: 13, offset: 16040},
+				pos: position{line: 610, col: 13, offset: 18459},
 				run: (*parser).callonEmptyList1,
 				expr: &seqExpr{
-					pos: position{line: 546, col: 13, offset: 16040},
+					pos: position{line: 610, col: 13, offset: 18459},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 546, col: 13, offset: 16040},
+							pos:        position{line: 610, col: 13, offset: 18459},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 546, col: 17, offset: 16044},
+							pos:  position{line: 610, col: 17, offset: 18463},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 546, col: 19, offset: 16046},
+							pos: position{line: 610, col: 19, offset: 18465},
 							expr: &seqExpr{
-								pos: position{line: 546, col: 20, offset: 16047},
+								pos: position{line: 610, col: 20, offset: 18466},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 546, col: 20, offset: 16047},
+										pos:        position{line: 610, col: 20, offset: 18466},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 546, col: 24, offset: 16051},
+										pos:  position{line: 610, col: 24, offset: 18470},
 										name: "_",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 28, offset: 16055},
+							pos:        position{line: 610, col: 28, offset: 18474},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 546, col: 32, offset: 16059},
+							pos:  position{line: 610, col: 32, offset: 18478},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 34, offset: 16061},
+							pos:        position{line: 610, col: 34, offset: 18480},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 546, col: 38, offset: 16065},
+							pos:  position{line: 610, col: 38, offset: 18484},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 546, col: 41, offset: 16068},
+							pos:   position{line: 610, col: 41, offset: 18487},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 43, offset: 16070},
+								pos:  position{line: 610, col: 43, offset: 18489},
 								name: "ApplicationExpression",
 							},
 						},
@@ -10522,45 +10570,45 @@ var g = &grammar{
 		},
 		{
 			name: "WithExpression",
-			pos:  position{line: 550, col: 1, offset: 16138},
+			pos:  position{line: 614, col: 1, offset: 18557},
 			expr: &actionExpr{
-				pos: position{line: 551, col: 3, offset: 16159},
+				pos: position{line: 615, col: 3, offset: 18578},
 				run: (*parser).callonWithExpression1,
 				expr: &seqExpr{
-					pos: position{line: 551, col: 3, offset: 16159},
+					pos: position{line: 615, col: 3, offset: 18578},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 551, col: 3, offset: 16159},
+							pos:   position{line: 615, col: 3, offset: 18578},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 551, col: 9, offset: 16165},
+								pos:  position{line: 615, col: 9, offset: 18584},
 								name: "ImportExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 551, col: 26, offset: 16182},
+							pos:   position{line: 615, col: 26, offset: 18601},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 551, col: 31, offset: 16187},
+								pos: position{line: 615, col: 31, offset: 18606},
 								expr: &seqExpr{
-									pos: position{line: 551, col: 32, offset: 16188},
+									pos: position{line: 615, col: 32, offset: 18607},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 551, col: 32, offset: 16188},
+											pos:  position{line: 615, col: 32, offset: 18607},
 											name: "_1",
 										},
 										&litMatcher{
-											pos:        position{line: 246, col: 8, offset: 6245},
+											pos:        position{line: 305, col: 8, offset: 8375},
 											val:        "with",
 											ignoreCase: false,
 											want:       "\"with\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 551, col: 40, offset: 16196},
+											pos:  position{line: 615, col: 40, offset: 18615},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 551, col: 43, offset: 16199},
+											pos:  position{line: 615, col: 43, offset: 18618},
 											name: "WithClause",
 										},
 									},
@@ -10573,30 +10621,30 @@ var g = &grammar{
 		},
 		{
 			name: "WithClause",
-			pos:  position{line: 564, col: 1, offset: 16530},
+			pos:  position{line: 628, col: 1, offset: 18949},
 			expr: &seqExpr{
-				pos: position{line: 564, col: 14, offset: 16545},
+				pos: position{line: 628, col: 14, offset: 18964},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 564, col: 14, offset: 16545},
+						pos:  position{line: 628, col: 14, offset: 18964},
 						name: "FieldPath",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 564, col: 24, offset: 16555},
+						pos:  position{line: 628, col: 24, offset: 18974},
 						name: "_",
 					},
 					&litMatcher{
-						pos:        position{line: 564, col: 26, offset: 16557},
+						pos:        position{line: 628, col: 26, offset: 18976},
 						val:        "=",
 						ignoreCase: false,
 						want:       "\"=\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 564, col: 30, offset: 16561},
+						pos:  position{line: 628, col: 30, offset: 18980},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 564, col: 32, offset: 16563},
+						pos:  position{line: 628, col: 32, offset: 18982},
 						name: "OperatorExpression",
 					},
 				},
@@ -10604,41 +10652,41 @@ var g = &grammar{
 		},
 		{
 			name: "FieldPath",
-			pos:  position{line: 566, col: 1, offset: 16583},
+			pos:  position{line: 630, col: 1, offset: 19002},
 			expr: &actionExpr{
-				pos: position{line: 566, col: 13, offset: 16597},
+				pos: position{line: 630, col: 13, offset: 19016},
 				run: (*parser).callonFieldPath1,
 				expr: &seqExpr{
-					pos: position{line: 566, col: 13, offset: 16597},
+					pos: position{line: 630, col: 13, offset: 19016},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 566, col: 13, offset: 16597},
+							pos:   position{line: 630, col: 13, offset: 19016},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 139, col: 18, offset: 3403},
+								pos: position{line: 198, col: 18, offset: 5533},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 131, col: 9, offset: 3119},
+										pos: position{line: 190, col: 9, offset: 5249},
 										run: (*parser).callonFieldPath5,
 										expr: &seqExpr{
-											pos: position{line: 131, col: 9, offset: 3119},
+											pos: position{line: 190, col: 9, offset: 5249},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 131, col: 9, offset: 3119},
+													pos:        position{line: 190, col: 9, offset: 5249},
 													val:        "`",
 													ignoreCase: false,
 													want:       "\"`\"",
 												},
 												&labeledExpr{
-													pos:   position{line: 131, col: 13, offset: 3123},
+													pos:   position{line: 190, col: 13, offset: 5253},
 													label: "label",
 													expr: &actionExpr{
-														pos: position{line: 129, col: 15, offset: 3060},
+														pos: position{line: 188, col: 15, offset: 5190},
 														run: (*parser).callonFieldPath9,
 														expr: &zeroOrMoreExpr{
-															pos: position{line: 129, col: 15, offset: 3060},
+															pos: position{line: 188, col: 15, offset: 5190},
 															expr: &charClassMatcher{
-																pos:        position{line: 128, col: 19, offset: 3023},
+																pos:        position{line: 187, col: 19, offset: 5153},
 																val:        "[ -_a-~]",
 																ranges:     []rune{' ', '_', 'a', '~'},
 																ignoreCase: false,
@@ -10648,7 +10696,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 131, col: 31, offset: 3141},
+													pos:        position{line: 190, col: 31, offset: 5271},
 													val:        "`",
 													ignoreCase: false,
 													want:       "\"`\"",
@@ -10657,75 +10705,75 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 132, col: 9, offset: 3175},
+										pos: position{line: 191, col: 9, offset: 5305},
 										run: (*parser).callonFieldPath13,
 										expr: &labeledExpr{
-											pos:   position{line: 132, col: 9, offset: 3175},
+											pos:   position{line: 191, col: 9, offset: 5305},
 											label: "label",
 											expr: &choiceExpr{
-												pos: position{line: 122, col: 15, offset: 2816},
+												pos: position{line: 181, col: 15, offset: 4946},
 												alternatives: []interface{}{
 													&actionExpr{
-														pos: position{line: 122, col: 15, offset: 2816},
+														pos: position{line: 181, col: 15, offset: 4946},
 														run: (*parser).callonFieldPath16,
 														expr: &seqExpr{
-															pos: position{line: 122, col: 15, offset: 2816},
+															pos: position{line: 181, col: 15, offset: 4946},
 															exprs: []interface{}{
 																&choiceExpr{
-																	pos: position{line: 249, col: 5, offset: 6269},
+																	pos: position{line: 308, col: 5, offset: 8399},
 																	alternatives: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 231, col: 6, offset: 5936},
+																			pos:        position{line: 290, col: 6, offset: 8066},
 																			val:        "if",
 																			ignoreCase: false,
 																			want:       "\"if\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 232, col: 8, offset: 5950},
+																			pos:        position{line: 291, col: 8, offset: 8080},
 																			val:        "then",
 																			ignoreCase: false,
 																			want:       "\"then\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 233, col: 8, offset: 5966},
+																			pos:        position{line: 292, col: 8, offset: 8096},
 																			val:        "else",
 																			ignoreCase: false,
 																			want:       "\"else\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 234, col: 7, offset: 5981},
+																			pos:        position{line: 293, col: 7, offset: 8111},
 																			val:        "let",
 																			ignoreCase: false,
 																			want:       "\"let\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 235, col: 6, offset: 5994},
+																			pos:        position{line: 294, col: 6, offset: 8124},
 																			val:        "in",
 																			ignoreCase: false,
 																			want:       "\"in\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 237, col: 9, offset: 6021},
+																			pos:        position{line: 296, col: 9, offset: 8151},
 																			val:        "using",
 																			ignoreCase: false,
 																			want:       "\"using\"",
 																		},
 																		&actionExpr{
-																			pos: position{line: 239, col: 11, offset: 6059},
+																			pos: position{line: 298, col: 11, offset: 8189},
 																			run: (*parser).callonFieldPath25,
 																			expr: &seqExpr{
-																				pos: position{line: 239, col: 11, offset: 6059},
+																				pos: position{line: 298, col: 11, offset: 8189},
 																				exprs: []interface{}{
 																					&litMatcher{
-																						pos:        position{line: 239, col: 11, offset: 6059},
+																						pos:        position{line: 298, col: 11, offset: 8189},
 																						val:        "missing",
 																						ignoreCase: false,
 																						want:       "\"missing\"",
 																					},
 																					&notExpr{
-																						pos: position{line: 239, col: 21, offset: 6069},
+																						pos: position{line: 298, col: 21, offset: 8199},
 																						expr: &charClassMatcher{
-																							pos:        position{line: 121, col: 23, offset: 2785},
+																							pos:        position{line: 180, col: 23, offset: 4915},
 																							val:        "[_/-A-Za-z0-9]",
 																							chars:      []rune{'_', '/', '-'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -10737,61 +10785,61 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 244, col: 10, offset: 6199},
+																			pos:        position{line: 303, col: 10, offset: 8329},
 																			val:        "assert",
 																			ignoreCase: false,
 																			want:       "\"assert\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 236, col: 6, offset: 6006},
+																			pos:        position{line: 295, col: 6, offset: 8136},
 																			val:        "as",
 																			ignoreCase: false,
 																			want:       "\"as\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 240, col: 12, offset: 6129},
+																			pos:        position{line: 299, col: 12, offset: 8259},
 																			val:        "Infinity",
 																			ignoreCase: false,
 																			want:       "\"Infinity\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 241, col: 7, offset: 6148},
+																			pos:        position{line: 300, col: 7, offset: 8278},
 																			val:        "NaN",
 																			ignoreCase: false,
 																			want:       "\"NaN\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 238, col: 9, offset: 6039},
+																			pos:        position{line: 297, col: 9, offset: 8169},
 																			val:        "merge",
 																			ignoreCase: false,
 																			want:       "\"merge\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 242, col: 8, offset: 6163},
+																			pos:        position{line: 301, col: 8, offset: 8293},
 																			val:        "Some",
 																			ignoreCase: false,
 																			want:       "\"Some\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 243, col: 9, offset: 6180},
+																			pos:        position{line: 302, col: 9, offset: 8310},
 																			val:        "toMap",
 																			ignoreCase: false,
 																			want:       "\"toMap\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 245, col: 10, offset: 6219},
+																			pos:        position{line: 304, col: 10, offset: 8349},
 																			val:        "forall",
 																			ignoreCase: false,
 																			want:       "\"forall\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 245, col: 21, offset: 6230},
+																			pos:        position{line: 304, col: 21, offset: 8360},
 																			val:        "∀",
 																			ignoreCase: false,
 																			want:       "\"∀\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 246, col: 8, offset: 6245},
+																			pos:        position{line: 305, col: 8, offset: 8375},
 																			val:        "with",
 																			ignoreCase: false,
 																			want:       "\"with\"",
@@ -10799,9 +10847,9 @@ var g = &grammar{
 																	},
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 122, col: 23, offset: 2824},
+																	pos: position{line: 181, col: 23, offset: 4954},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -10813,68 +10861,68 @@ var g = &grammar{
 														},
 													},
 													&actionExpr{
-														pos: position{line: 123, col: 13, offset: 2888},
+														pos: position{line: 182, col: 13, offset: 5018},
 														run: (*parser).callonFieldPath42,
 														expr: &seqExpr{
-															pos: position{line: 123, col: 13, offset: 2888},
+															pos: position{line: 182, col: 13, offset: 5018},
 															exprs: []interface{}{
 																&notExpr{
-																	pos: position{line: 123, col: 13, offset: 2888},
+																	pos: position{line: 182, col: 13, offset: 5018},
 																	expr: &choiceExpr{
-																		pos: position{line: 249, col: 5, offset: 6269},
+																		pos: position{line: 308, col: 5, offset: 8399},
 																		alternatives: []interface{}{
 																			&litMatcher{
-																				pos:        position{line: 231, col: 6, offset: 5936},
+																				pos:        position{line: 290, col: 6, offset: 8066},
 																				val:        "if",
 																				ignoreCase: false,
 																				want:       "\"if\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 232, col: 8, offset: 5950},
+																				pos:        position{line: 291, col: 8, offset: 8080},
 																				val:        "then",
 																				ignoreCase: false,
 																				want:       "\"then\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 233, col: 8, offset: 5966},
+																				pos:        position{line: 292, col: 8, offset: 8096},
 																				val:        "else",
 																				ignoreCase: false,
 																				want:       "\"else\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 234, col: 7, offset: 5981},
+																				pos:        position{line: 293, col: 7, offset: 8111},
 																				val:        "let",
 																				ignoreCase: false,
 																				want:       "\"let\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 235, col: 6, offset: 5994},
+																				pos:        position{line: 294, col: 6, offset: 8124},
 																				val:        "in",
 																				ignoreCase: false,
 																				want:       "\"in\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 237, col: 9, offset: 6021},
+																				pos:        position{line: 296, col: 9, offset: 8151},
 																				val:        "using",
 																				ignoreCase: false,
 																				want:       "\"using\"",
 																			},
 																			&actionExpr{
-																				pos: position{line: 239, col: 11, offset: 6059},
+																				pos: position{line: 298, col: 11, offset: 8189},
 																				run: (*parser).callonFieldPath52,
 																				expr: &seqExpr{
-																					pos: position{line: 239, col: 11, offset: 6059},
+																					pos: position{line: 298, col: 11, offset: 8189},
 																					exprs: []interface{}{
 																						&litMatcher{
-																							pos:        position{line: 239, col: 11, offset: 6059},
+																							pos:        position{line: 298, col: 11, offset: 8189},
 																							val:        "missing",
 																							ignoreCase: false,
 																							want:       "\"missing\"",
 																						},
 																						&notExpr{
-																							pos: position{line: 239, col: 21, offset: 6069},
+																							pos: position{line: 298, col: 21, offset: 8199},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -10886,61 +10934,61 @@ var g = &grammar{
 																				},
 																			},
 																			&litMatcher{
-																				pos:        position{line: 244, col: 10, offset: 6199},
+																				pos:        position{line: 303, col: 10, offset: 8329},
 																				val:        "assert",
 																				ignoreCase: false,
 																				want:       "\"assert\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 236, col: 6, offset: 6006},
+																				pos:        position{line: 295, col: 6, offset: 8136},
 																				val:        "as",
 																				ignoreCase: false,
 																				want:       "\"as\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 240, col: 12, offset: 6129},
+																				pos:        position{line: 299, col: 12, offset: 8259},
 																				val:        "Infinity",
 																				ignoreCase: false,
 																				want:       "\"Infinity\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 241, col: 7, offset: 6148},
+																				pos:        position{line: 300, col: 7, offset: 8278},
 																				val:        "NaN",
 																				ignoreCase: false,
 																				want:       "\"NaN\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 238, col: 9, offset: 6039},
+																				pos:        position{line: 297, col: 9, offset: 8169},
 																				val:        "merge",
 																				ignoreCase: false,
 																				want:       "\"merge\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 242, col: 8, offset: 6163},
+																				pos:        position{line: 301, col: 8, offset: 8293},
 																				val:        "Some",
 																				ignoreCase: false,
 																				want:       "\"Some\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 243, col: 9, offset: 6180},
+																				pos:        position{line: 302, col: 9, offset: 8310},
 																				val:        "toMap",
 																				ignoreCase: false,
 																				want:       "\"toMap\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 245, col: 10, offset: 6219},
+																				pos:        position{line: 304, col: 10, offset: 8349},
 																				val:        "forall",
 																				ignoreCase: false,
 																				want:       "\"forall\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 245, col: 21, offset: 6230},
+																				pos:        position{line: 304, col: 21, offset: 8360},
 																				val:        "∀",
 																				ignoreCase: false,
 																				want:       "\"∀\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 246, col: 8, offset: 6245},
+																				pos:        position{line: 305, col: 8, offset: 8375},
 																				val:        "with",
 																				ignoreCase: false,
 																				want:       "\"with\"",
@@ -10949,7 +10997,7 @@ var g = &grammar{
 																	},
 																},
 																&charClassMatcher{
-																	pos:        position{line: 120, col: 24, offset: 2751},
+																	pos:        position{line: 179, col: 24, offset: 4881},
 																	val:        "[_A-Za-z]",
 																	chars:      []rune{'_'},
 																	ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -10957,9 +11005,9 @@ var g = &grammar{
 																	inverted:   false,
 																},
 																&zeroOrMoreExpr{
-																	pos: position{line: 123, col: 43, offset: 2918},
+																	pos: position{line: 182, col: 43, offset: 5048},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -10975,10 +11023,10 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 139, col: 29, offset: 3414},
+										pos: position{line: 198, col: 29, offset: 5544},
 										run: (*parser).callonFieldPath70,
 										expr: &litMatcher{
-											pos:        position{line: 242, col: 8, offset: 6163},
+											pos:        position{line: 301, col: 8, offset: 8293},
 											val:        "Some",
 											ignoreCase: false,
 											want:       "\"Some\"",
@@ -10988,52 +11036,52 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 566, col: 34, offset: 16618},
+							pos:   position{line: 630, col: 34, offset: 19037},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 566, col: 39, offset: 16623},
+								pos: position{line: 630, col: 39, offset: 19042},
 								expr: &seqExpr{
-									pos: position{line: 566, col: 40, offset: 16624},
+									pos: position{line: 630, col: 40, offset: 19043},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 566, col: 40, offset: 16624},
+											pos:  position{line: 630, col: 40, offset: 19043},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 566, col: 42, offset: 16626},
+											pos:        position{line: 630, col: 42, offset: 19045},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 566, col: 46, offset: 16630},
+											pos:  position{line: 630, col: 46, offset: 19049},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 139, col: 18, offset: 3403},
+											pos: position{line: 198, col: 18, offset: 5533},
 											alternatives: []interface{}{
 												&actionExpr{
-													pos: position{line: 131, col: 9, offset: 3119},
+													pos: position{line: 190, col: 9, offset: 5249},
 													run: (*parser).callonFieldPath79,
 													expr: &seqExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 131, col: 9, offset: 3119},
+																pos:        position{line: 190, col: 9, offset: 5249},
 																val:        "`",
 																ignoreCase: false,
 																want:       "\"`\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 131, col: 13, offset: 3123},
+																pos:   position{line: 190, col: 13, offset: 5253},
 																label: "label",
 																expr: &actionExpr{
-																	pos: position{line: 129, col: 15, offset: 3060},
+																	pos: position{line: 188, col: 15, offset: 5190},
 																	run: (*parser).callonFieldPath83,
 																	expr: &zeroOrMoreExpr{
-																		pos: position{line: 129, col: 15, offset: 3060},
+																		pos: position{line: 188, col: 15, offset: 5190},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 128, col: 19, offset: 3023},
+																			pos:        position{line: 187, col: 19, offset: 5153},
 																			val:        "[ -_a-~]",
 																			ranges:     []rune{' ', '_', 'a', '~'},
 																			ignoreCase: false,
@@ -11043,7 +11091,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 131, col: 31, offset: 3141},
+																pos:        position{line: 190, col: 31, offset: 5271},
 																val:        "`",
 																ignoreCase: false,
 																want:       "\"`\"",
@@ -11052,75 +11100,75 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 132, col: 9, offset: 3175},
+													pos: position{line: 191, col: 9, offset: 5305},
 													run: (*parser).callonFieldPath87,
 													expr: &labeledExpr{
-														pos:   position{line: 132, col: 9, offset: 3175},
+														pos:   position{line: 191, col: 9, offset: 5305},
 														label: "label",
 														expr: &choiceExpr{
-															pos: position{line: 122, col: 15, offset: 2816},
+															pos: position{line: 181, col: 15, offset: 4946},
 															alternatives: []interface{}{
 																&actionExpr{
-																	pos: position{line: 122, col: 15, offset: 2816},
+																	pos: position{line: 181, col: 15, offset: 4946},
 																	run: (*parser).callonFieldPath90,
 																	expr: &seqExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		exprs: []interface{}{
 																			&choiceExpr{
-																				pos: position{line: 249, col: 5, offset: 6269},
+																				pos: position{line: 308, col: 5, offset: 8399},
 																				alternatives: []interface{}{
 																					&litMatcher{
-																						pos:        position{line: 231, col: 6, offset: 5936},
+																						pos:        position{line: 290, col: 6, offset: 8066},
 																						val:        "if",
 																						ignoreCase: false,
 																						want:       "\"if\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 232, col: 8, offset: 5950},
+																						pos:        position{line: 291, col: 8, offset: 8080},
 																						val:        "then",
 																						ignoreCase: false,
 																						want:       "\"then\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 233, col: 8, offset: 5966},
+																						pos:        position{line: 292, col: 8, offset: 8096},
 																						val:        "else",
 																						ignoreCase: false,
 																						want:       "\"else\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 234, col: 7, offset: 5981},
+																						pos:        position{line: 293, col: 7, offset: 8111},
 																						val:        "let",
 																						ignoreCase: false,
 																						want:       "\"let\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 235, col: 6, offset: 5994},
+																						pos:        position{line: 294, col: 6, offset: 8124},
 																						val:        "in",
 																						ignoreCase: false,
 																						want:       "\"in\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 237, col: 9, offset: 6021},
+																						pos:        position{line: 296, col: 9, offset: 8151},
 																						val:        "using",
 																						ignoreCase: false,
 																						want:       "\"using\"",
 																					},
 																					&actionExpr{
-																						pos: position{line: 239, col: 11, offset: 6059},
+																						pos: position{line: 298, col: 11, offset: 8189},
 																						run: (*parser).callonFieldPath99,
 																						expr: &seqExpr{
-																							pos: position{line: 239, col: 11, offset: 6059},
+																							pos: position{line: 298, col: 11, offset: 8189},
 																							exprs: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 239, col: 11, offset: 6059},
+																									pos:        position{line: 298, col: 11, offset: 8189},
 																									val:        "missing",
 																									ignoreCase: false,
 																									want:       "\"missing\"",
 																								},
 																								&notExpr{
-																									pos: position{line: 239, col: 21, offset: 6069},
+																									pos: position{line: 298, col: 21, offset: 8199},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -11132,61 +11180,61 @@ var g = &grammar{
 																						},
 																					},
 																					&litMatcher{
-																						pos:        position{line: 244, col: 10, offset: 6199},
+																						pos:        position{line: 303, col: 10, offset: 8329},
 																						val:        "assert",
 																						ignoreCase: false,
 																						want:       "\"assert\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 236, col: 6, offset: 6006},
+																						pos:        position{line: 295, col: 6, offset: 8136},
 																						val:        "as",
 																						ignoreCase: false,
 																						want:       "\"as\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 240, col: 12, offset: 6129},
+																						pos:        position{line: 299, col: 12, offset: 8259},
 																						val:        "Infinity",
 																						ignoreCase: false,
 																						want:       "\"Infinity\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 241, col: 7, offset: 6148},
+																						pos:        position{line: 300, col: 7, offset: 8278},
 																						val:        "NaN",
 																						ignoreCase: false,
 																						want:       "\"NaN\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 238, col: 9, offset: 6039},
+																						pos:        position{line: 297, col: 9, offset: 8169},
 																						val:        "merge",
 																						ignoreCase: false,
 																						want:       "\"merge\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 242, col: 8, offset: 6163},
+																						pos:        position{line: 301, col: 8, offset: 8293},
 																						val:        "Some",
 																						ignoreCase: false,
 																						want:       "\"Some\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 243, col: 9, offset: 6180},
+																						pos:        position{line: 302, col: 9, offset: 8310},
 																						val:        "toMap",
 																						ignoreCase: false,
 																						want:       "\"toMap\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 245, col: 10, offset: 6219},
+																						pos:        position{line: 304, col: 10, offset: 8349},
 																						val:        "forall",
 																						ignoreCase: false,
 																						want:       "\"forall\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 245, col: 21, offset: 6230},
+																						pos:        position{line: 304, col: 21, offset: 8360},
 																						val:        "∀",
 																						ignoreCase: false,
 																						want:       "\"∀\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 246, col: 8, offset: 6245},
+																						pos:        position{line: 305, col: 8, offset: 8375},
 																						val:        "with",
 																						ignoreCase: false,
 																						want:       "\"with\"",
@@ -11194,9 +11242,9 @@ var g = &grammar{
 																				},
 																			},
 																			&oneOrMoreExpr{
-																				pos: position{line: 122, col: 23, offset: 2824},
+																				pos: position{line: 181, col: 23, offset: 4954},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -11208,68 +11256,68 @@ var g = &grammar{
 																	},
 																},
 																&actionExpr{
-																	pos: position{line: 123, col: 13, offset: 2888},
+																	pos: position{line: 182, col: 13, offset: 5018},
 																	run: (*parser).callonFieldPath116,
 																	expr: &seqExpr{
-																		pos: position{line: 123, col: 13, offset: 2888},
+																		pos: position{line: 182, col: 13, offset: 5018},
 																		exprs: []interface{}{
 																			&notExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				expr: &choiceExpr{
-																					pos: position{line: 249, col: 5, offset: 6269},
+																					pos: position{line: 308, col: 5, offset: 8399},
 																					alternatives: []interface{}{
 																						&litMatcher{
-																							pos:        position{line: 231, col: 6, offset: 5936},
+																							pos:        position{line: 290, col: 6, offset: 8066},
 																							val:        "if",
 																							ignoreCase: false,
 																							want:       "\"if\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 232, col: 8, offset: 5950},
+																							pos:        position{line: 291, col: 8, offset: 8080},
 																							val:        "then",
 																							ignoreCase: false,
 																							want:       "\"then\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 233, col: 8, offset: 5966},
+																							pos:        position{line: 292, col: 8, offset: 8096},
 																							val:        "else",
 																							ignoreCase: false,
 																							want:       "\"else\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 234, col: 7, offset: 5981},
+																							pos:        position{line: 293, col: 7, offset: 8111},
 																							val:        "let",
 																							ignoreCase: false,
 																							want:       "\"let\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 235, col: 6, offset: 5994},
+																							pos:        position{line: 294, col: 6, offset: 8124},
 																							val:        "in",
 																							ignoreCase: false,
 																							want:       "\"in\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 237, col: 9, offset: 6021},
+																							pos:        position{line: 296, col: 9, offset: 8151},
 																							val:        "using",
 																							ignoreCase: false,
 																							want:       "\"using\"",
 																						},
 																						&actionExpr{
-																							pos: position{line: 239, col: 11, offset: 6059},
+																							pos: position{line: 298, col: 11, offset: 8189},
 																							run: (*parser).callonFieldPath126,
 																							expr: &seqExpr{
-																								pos: position{line: 239, col: 11, offset: 6059},
+																								pos: position{line: 298, col: 11, offset: 8189},
 																								exprs: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 239, col: 11, offset: 6059},
+																										pos:        position{line: 298, col: 11, offset: 8189},
 																										val:        "missing",
 																										ignoreCase: false,
 																										want:       "\"missing\"",
 																									},
 																									&notExpr{
-																										pos: position{line: 239, col: 21, offset: 6069},
+																										pos: position{line: 298, col: 21, offset: 8199},
 																										expr: &charClassMatcher{
-																											pos:        position{line: 121, col: 23, offset: 2785},
+																											pos:        position{line: 180, col: 23, offset: 4915},
 																											val:        "[_/-A-Za-z0-9]",
 																											chars:      []rune{'_', '/', '-'},
 																											ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -11281,61 +11329,61 @@ var g = &grammar{
 																							},
 																						},
 																						&litMatcher{
-																							pos:        position{line: 244, col: 10, offset: 6199},
+																							pos:        position{line: 303, col: 10, offset: 8329},
 																							val:        "assert",
 																							ignoreCase: false,
 																							want:       "\"assert\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 236, col: 6, offset: 6006},
+																							pos:        position{line: 295, col: 6, offset: 8136},
 																							val:        "as",
 																							ignoreCase: false,
 																							want:       "\"as\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 240, col: 12, offset: 6129},
+																							pos:        position{line: 299, col: 12, offset: 8259},
 																							val:        "Infinity",
 																							ignoreCase: false,
 																							want:       "\"Infinity\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 241, col: 7, offset: 6148},
+																							pos:        position{line: 300, col: 7, offset: 8278},
 																							val:        "NaN",
 																							ignoreCase: false,
 																							want:       "\"NaN\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 238, col: 9, offset: 6039},
+																							pos:        position{line: 297, col: 9, offset: 8169},
 																							val:        "merge",
 																							ignoreCase: false,
 																							want:       "\"merge\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 242, col: 8, offset: 6163},
+																							pos:        position{line: 301, col: 8, offset: 8293},
 																							val:        "Some",
 																							ignoreCase: false,
 																							want:       "\"Some\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 243, col: 9, offset: 6180},
+																							pos:        position{line: 302, col: 9, offset: 8310},
 																							val:        "toMap",
 																							ignoreCase: false,
 																							want:       "\"toMap\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 245, col: 10, offset: 6219},
+																							pos:        position{line: 304, col: 10, offset: 8349},
 																							val:        "forall",
 																							ignoreCase: false,
 																							want:       "\"forall\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 245, col: 21, offset: 6230},
+																							pos:        position{line: 304, col: 21, offset: 8360},
 																							val:        "∀",
 																							ignoreCase: false,
 																							want:       "\"∀\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 246, col: 8, offset: 6245},
+																							pos:        position{line: 305, col: 8, offset: 8375},
 																							val:        "with",
 																							ignoreCase: false,
 																							want:       "\"with\"",
@@ -11344,7 +11392,7 @@ var g = &grammar{
 																				},
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 120, col: 24, offset: 2751},
+																				pos:        position{line: 179, col: 24, offset: 4881},
 																				val:        "[_A-Za-z]",
 																				chars:      []rune{'_'},
 																				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -11352,9 +11400,9 @@ var g = &grammar{
 																				inverted:   false,
 																			},
 																			&zeroOrMoreExpr{
-																				pos: position{line: 123, col: 43, offset: 2918},
+																				pos: position{line: 182, col: 43, offset: 5048},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -11370,10 +11418,10 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 139, col: 29, offset: 3414},
+													pos: position{line: 198, col: 29, offset: 5544},
 													run: (*parser).callonFieldPath144,
 													expr: &litMatcher{
-														pos:        position{line: 242, col: 8, offset: 6163},
+														pos:        position{line: 301, col: 8, offset: 8293},
 														val:        "Some",
 														ignoreCase: false,
 														want:       "\"Some\"",
@@ -11391,52 +11439,52 @@ var g = &grammar{
 		},
 		{
 			name: "OperatorExpression",
-			pos:  position{line: 576, col: 1, offset: 16870},
+			pos:  position{line: 640, col: 1, offset: 19289},
 			expr: &ruleRefExpr{
-				pos:  position{line: 576, col: 22, offset: 16893},
+				pos:  position{line: 640, col: 22, offset: 19312},
 				name: "EquivalentExpression",
 			},
 		},
 		{
 			name: "EquivalentExpression",
-			pos:  position{line: 578, col: 1, offset: 16915},
+			pos:  position{line: 642, col: 1, offset: 19334},
 			expr: &actionExpr{
-				pos: position{line: 578, col: 26, offset: 16942},
+				pos: position{line: 642, col: 26, offset: 19361},
 				run: (*parser).callonEquivalentExpression1,
 				expr: &seqExpr{
-					pos: position{line: 578, col: 26, offset: 16942},
+					pos: position{line: 642, col: 26, offset: 19361},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 578, col: 26, offset: 16942},
+							pos:   position{line: 642, col: 26, offset: 19361},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 32, offset: 16948},
+								pos:  position{line: 642, col: 32, offset: 19367},
 								name: "ImportAltExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 55, offset: 16971},
+							pos:   position{line: 642, col: 55, offset: 19390},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 578, col: 60, offset: 16976},
+								pos: position{line: 642, col: 60, offset: 19395},
 								expr: &seqExpr{
-									pos: position{line: 578, col: 61, offset: 16977},
+									pos: position{line: 642, col: 61, offset: 19396},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 578, col: 61, offset: 16977},
+											pos:  position{line: 642, col: 61, offset: 19396},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 300, col: 14, offset: 8009},
+											pos: position{line: 359, col: 14, offset: 10139},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 300, col: 14, offset: 8009},
+													pos:        position{line: 359, col: 14, offset: 10139},
 													val:        "===",
 													ignoreCase: false,
 													want:       "\"===\"",
 												},
 												&litMatcher{
-													pos:        position{line: 300, col: 22, offset: 8017},
+													pos:        position{line: 359, col: 22, offset: 10147},
 													val:        "≡",
 													ignoreCase: false,
 													want:       "\"≡\"",
@@ -11444,14 +11492,14 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 578, col: 74, offset: 16990},
+											pos:  position{line: 642, col: 74, offset: 19409},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 578, col: 76, offset: 16992},
+											pos:   position{line: 642, col: 76, offset: 19411},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 578, col: 78, offset: 16994},
+												pos:  position{line: 642, col: 78, offset: 19413},
 												name: "ImportAltExpression",
 											},
 										},
@@ -11465,45 +11513,45 @@ var g = &grammar{
 		},
 		{
 			name: "ImportAltExpression",
-			pos:  position{line: 580, col: 1, offset: 17068},
+			pos:  position{line: 644, col: 1, offset: 19487},
 			expr: &actionExpr{
-				pos: position{line: 580, col: 26, offset: 17095},
+				pos: position{line: 644, col: 26, offset: 19514},
 				run: (*parser).callonImportAltExpression1,
 				expr: &seqExpr{
-					pos: position{line: 580, col: 26, offset: 17095},
+					pos: position{line: 644, col: 26, offset: 19514},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 580, col: 26, offset: 17095},
+							pos:   position{line: 644, col: 26, offset: 19514},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 580, col: 32, offset: 17101},
+								pos:  position{line: 644, col: 32, offset: 19520},
 								name: "OrExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 580, col: 55, offset: 17124},
+							pos:   position{line: 644, col: 55, offset: 19543},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 580, col: 60, offset: 17129},
+								pos: position{line: 644, col: 60, offset: 19548},
 								expr: &seqExpr{
-									pos: position{line: 580, col: 61, offset: 17130},
+									pos: position{line: 644, col: 61, offset: 19549},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 580, col: 61, offset: 17130},
+											pos:  position{line: 644, col: 61, offset: 19549},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 580, col: 63, offset: 17132},
+											pos:        position{line: 644, col: 63, offset: 19551},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 580, col: 67, offset: 17136},
+											pos:  position{line: 644, col: 67, offset: 19555},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 580, col: 70, offset: 17139},
+											pos:  position{line: 644, col: 70, offset: 19558},
 											name: "OrExpression",
 										},
 									},
@@ -11516,45 +11564,45 @@ var g = &grammar{
 		},
 		{
 			name: "OrExpression",
-			pos:  position{line: 582, col: 1, offset: 17210},
+			pos:  position{line: 646, col: 1, offset: 19629},
 			expr: &actionExpr{
-				pos: position{line: 582, col: 26, offset: 17237},
+				pos: position{line: 646, col: 26, offset: 19656},
 				run: (*parser).callonOrExpression1,
 				expr: &seqExpr{
-					pos: position{line: 582, col: 26, offset: 17237},
+					pos: position{line: 646, col: 26, offset: 19656},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 582, col: 26, offset: 17237},
+							pos:   position{line: 646, col: 26, offset: 19656},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 582, col: 32, offset: 17243},
+								pos:  position{line: 646, col: 32, offset: 19662},
 								name: "PlusExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 582, col: 55, offset: 17266},
+							pos:   position{line: 646, col: 55, offset: 19685},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 582, col: 60, offset: 17271},
+								pos: position{line: 646, col: 60, offset: 19690},
 								expr: &seqExpr{
-									pos: position{line: 582, col: 61, offset: 17272},
+									pos: position{line: 646, col: 61, offset: 19691},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 582, col: 61, offset: 17272},
+											pos:  position{line: 646, col: 61, offset: 19691},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 582, col: 63, offset: 17274},
+											pos:        position{line: 646, col: 63, offset: 19693},
 											val:        "||",
 											ignoreCase: false,
 											want:       "\"||\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 582, col: 68, offset: 17279},
+											pos:  position{line: 646, col: 68, offset: 19698},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 582, col: 70, offset: 17281},
+											pos:  position{line: 646, col: 70, offset: 19700},
 											name: "PlusExpression",
 										},
 									},
@@ -11567,48 +11615,48 @@ var g = &grammar{
 		},
 		{
 			name: "PlusExpression",
-			pos:  position{line: 584, col: 1, offset: 17347},
+			pos:  position{line: 648, col: 1, offset: 19766},
 			expr: &actionExpr{
-				pos: position{line: 584, col: 26, offset: 17374},
+				pos: position{line: 648, col: 26, offset: 19793},
 				run: (*parser).callonPlusExpression1,
 				expr: &seqExpr{
-					pos: position{line: 584, col: 26, offset: 17374},
+					pos: position{line: 648, col: 26, offset: 19793},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 584, col: 26, offset: 17374},
+							pos:   position{line: 648, col: 26, offset: 19793},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 584, col: 32, offset: 17380},
+								pos:  position{line: 648, col: 32, offset: 19799},
 								name: "TextAppendExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 584, col: 55, offset: 17403},
+							pos:   position{line: 648, col: 55, offset: 19822},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 584, col: 60, offset: 17408},
+								pos: position{line: 648, col: 60, offset: 19827},
 								expr: &seqExpr{
-									pos: position{line: 584, col: 61, offset: 17409},
+									pos: position{line: 648, col: 61, offset: 19828},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 584, col: 61, offset: 17409},
+											pos:  position{line: 648, col: 61, offset: 19828},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 584, col: 63, offset: 17411},
+											pos:        position{line: 648, col: 63, offset: 19830},
 											val:        "+",
 											ignoreCase: false,
 											want:       "\"+\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 584, col: 67, offset: 17415},
+											pos:  position{line: 648, col: 67, offset: 19834},
 											name: "_1",
 										},
 										&labeledExpr{
-											pos:   position{line: 584, col: 70, offset: 17418},
+											pos:   position{line: 648, col: 70, offset: 19837},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 584, col: 72, offset: 17420},
+												pos:  position{line: 648, col: 72, offset: 19839},
 												name: "TextAppendExpression",
 											},
 										},
@@ -11622,48 +11670,48 @@ var g = &grammar{
 		},
 		{
 			name: "TextAppendExpression",
-			pos:  position{line: 586, col: 1, offset: 17494},
+			pos:  position{line: 650, col: 1, offset: 19913},
 			expr: &actionExpr{
-				pos: position{line: 586, col: 26, offset: 17521},
+				pos: position{line: 650, col: 26, offset: 19940},
 				run: (*parser).callonTextAppendExpression1,
 				expr: &seqExpr{
-					pos: position{line: 586, col: 26, offset: 17521},
+					pos: position{line: 650, col: 26, offset: 19940},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 586, col: 26, offset: 17521},
+							pos:   position{line: 650, col: 26, offset: 19940},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 32, offset: 17527},
+								pos:  position{line: 650, col: 32, offset: 19946},
 								name: "ListAppendExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 586, col: 55, offset: 17550},
+							pos:   position{line: 650, col: 55, offset: 19969},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 586, col: 60, offset: 17555},
+								pos: position{line: 650, col: 60, offset: 19974},
 								expr: &seqExpr{
-									pos: position{line: 586, col: 61, offset: 17556},
+									pos: position{line: 650, col: 61, offset: 19975},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 586, col: 61, offset: 17556},
+											pos:  position{line: 650, col: 61, offset: 19975},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 586, col: 63, offset: 17558},
+											pos:        position{line: 650, col: 63, offset: 19977},
 											val:        "++",
 											ignoreCase: false,
 											want:       "\"++\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 586, col: 68, offset: 17563},
+											pos:  position{line: 650, col: 68, offset: 19982},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 586, col: 70, offset: 17565},
+											pos:   position{line: 650, col: 70, offset: 19984},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 586, col: 72, offset: 17567},
+												pos:  position{line: 650, col: 72, offset: 19986},
 												name: "ListAppendExpression",
 											},
 										},
@@ -11677,48 +11725,48 @@ var g = &grammar{
 		},
 		{
 			name: "ListAppendExpression",
-			pos:  position{line: 588, col: 1, offset: 17647},
+			pos:  position{line: 652, col: 1, offset: 20066},
 			expr: &actionExpr{
-				pos: position{line: 588, col: 26, offset: 17674},
+				pos: position{line: 652, col: 26, offset: 20093},
 				run: (*parser).callonListAppendExpression1,
 				expr: &seqExpr{
-					pos: position{line: 588, col: 26, offset: 17674},
+					pos: position{line: 652, col: 26, offset: 20093},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 588, col: 26, offset: 17674},
+							pos:   position{line: 652, col: 26, offset: 20093},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 588, col: 32, offset: 17680},
+								pos:  position{line: 652, col: 32, offset: 20099},
 								name: "AndExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 588, col: 55, offset: 17703},
+							pos:   position{line: 652, col: 55, offset: 20122},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 588, col: 60, offset: 17708},
+								pos: position{line: 652, col: 60, offset: 20127},
 								expr: &seqExpr{
-									pos: position{line: 588, col: 61, offset: 17709},
+									pos: position{line: 652, col: 61, offset: 20128},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 588, col: 61, offset: 17709},
+											pos:  position{line: 652, col: 61, offset: 20128},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 588, col: 63, offset: 17711},
+											pos:        position{line: 652, col: 63, offset: 20130},
 											val:        "#",
 											ignoreCase: false,
 											want:       "\"#\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 588, col: 67, offset: 17715},
+											pos:  position{line: 652, col: 67, offset: 20134},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 588, col: 69, offset: 17717},
+											pos:   position{line: 652, col: 69, offset: 20136},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 588, col: 71, offset: 17719},
+												pos:  position{line: 652, col: 71, offset: 20138},
 												name: "AndExpression",
 											},
 										},
@@ -11732,48 +11780,48 @@ var g = &grammar{
 		},
 		{
 			name: "AndExpression",
-			pos:  position{line: 590, col: 1, offset: 17792},
+			pos:  position{line: 654, col: 1, offset: 20211},
 			expr: &actionExpr{
-				pos: position{line: 590, col: 26, offset: 17819},
+				pos: position{line: 654, col: 26, offset: 20238},
 				run: (*parser).callonAndExpression1,
 				expr: &seqExpr{
-					pos: position{line: 590, col: 26, offset: 17819},
+					pos: position{line: 654, col: 26, offset: 20238},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 590, col: 26, offset: 17819},
+							pos:   position{line: 654, col: 26, offset: 20238},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 590, col: 32, offset: 17825},
+								pos:  position{line: 654, col: 32, offset: 20244},
 								name: "CombineExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 590, col: 55, offset: 17848},
+							pos:   position{line: 654, col: 55, offset: 20267},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 590, col: 60, offset: 17853},
+								pos: position{line: 654, col: 60, offset: 20272},
 								expr: &seqExpr{
-									pos: position{line: 590, col: 61, offset: 17854},
+									pos: position{line: 654, col: 61, offset: 20273},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 590, col: 61, offset: 17854},
+											pos:  position{line: 654, col: 61, offset: 20273},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 590, col: 63, offset: 17856},
+											pos:        position{line: 654, col: 63, offset: 20275},
 											val:        "&&",
 											ignoreCase: false,
 											want:       "\"&&\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 590, col: 68, offset: 17861},
+											pos:  position{line: 654, col: 68, offset: 20280},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 590, col: 70, offset: 17863},
+											pos:   position{line: 654, col: 70, offset: 20282},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 590, col: 72, offset: 17865},
+												pos:  position{line: 654, col: 72, offset: 20284},
 												name: "CombineExpression",
 											},
 										},
@@ -11787,44 +11835,44 @@ var g = &grammar{
 		},
 		{
 			name: "CombineExpression",
-			pos:  position{line: 592, col: 1, offset: 17935},
+			pos:  position{line: 656, col: 1, offset: 20354},
 			expr: &actionExpr{
-				pos: position{line: 592, col: 26, offset: 17962},
+				pos: position{line: 656, col: 26, offset: 20381},
 				run: (*parser).callonCombineExpression1,
 				expr: &seqExpr{
-					pos: position{line: 592, col: 26, offset: 17962},
+					pos: position{line: 656, col: 26, offset: 20381},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 592, col: 26, offset: 17962},
+							pos:   position{line: 656, col: 26, offset: 20381},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 592, col: 32, offset: 17968},
+								pos:  position{line: 656, col: 32, offset: 20387},
 								name: "PreferExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 592, col: 55, offset: 17991},
+							pos:   position{line: 656, col: 55, offset: 20410},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 592, col: 60, offset: 17996},
+								pos: position{line: 656, col: 60, offset: 20415},
 								expr: &seqExpr{
-									pos: position{line: 592, col: 61, offset: 17997},
+									pos: position{line: 656, col: 61, offset: 20416},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 592, col: 61, offset: 17997},
+											pos:  position{line: 656, col: 61, offset: 20416},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 298, col: 11, offset: 7946},
+											pos: position{line: 357, col: 11, offset: 10076},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 298, col: 11, offset: 7946},
+													pos:        position{line: 357, col: 11, offset: 10076},
 													val:        "/\\",
 													ignoreCase: false,
 													want:       "\"/\\\\\"",
 												},
 												&litMatcher{
-													pos:        position{line: 298, col: 19, offset: 7954},
+													pos:        position{line: 357, col: 19, offset: 10084},
 													val:        "∧",
 													ignoreCase: false,
 													want:       "\"∧\"",
@@ -11832,14 +11880,14 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 592, col: 71, offset: 18007},
+											pos:  position{line: 656, col: 71, offset: 20426},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 592, col: 73, offset: 18009},
+											pos:   position{line: 656, col: 73, offset: 20428},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 592, col: 75, offset: 18011},
+												pos:  position{line: 656, col: 75, offset: 20430},
 												name: "PreferExpression",
 											},
 										},
@@ -11853,44 +11901,44 @@ var g = &grammar{
 		},
 		{
 			name: "PreferExpression",
-			pos:  position{line: 594, col: 1, offset: 18088},
+			pos:  position{line: 658, col: 1, offset: 20507},
 			expr: &actionExpr{
-				pos: position{line: 594, col: 26, offset: 18115},
+				pos: position{line: 658, col: 26, offset: 20534},
 				run: (*parser).callonPreferExpression1,
 				expr: &seqExpr{
-					pos: position{line: 594, col: 26, offset: 18115},
+					pos: position{line: 658, col: 26, offset: 20534},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 594, col: 26, offset: 18115},
+							pos:   position{line: 658, col: 26, offset: 20534},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 594, col: 32, offset: 18121},
+								pos:  position{line: 658, col: 32, offset: 20540},
 								name: "CombineTypesExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 594, col: 55, offset: 18144},
+							pos:   position{line: 658, col: 55, offset: 20563},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 594, col: 60, offset: 18149},
+								pos: position{line: 658, col: 60, offset: 20568},
 								expr: &seqExpr{
-									pos: position{line: 594, col: 61, offset: 18150},
+									pos: position{line: 658, col: 61, offset: 20569},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 594, col: 61, offset: 18150},
+											pos:  position{line: 658, col: 61, offset: 20569},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 301, col: 10, offset: 8034},
+											pos: position{line: 360, col: 10, offset: 10164},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 301, col: 10, offset: 8034},
+													pos:        position{line: 360, col: 10, offset: 10164},
 													val:        "//",
 													ignoreCase: false,
 													want:       "\"//\"",
 												},
 												&litMatcher{
-													pos:        position{line: 301, col: 17, offset: 8041},
+													pos:        position{line: 360, col: 17, offset: 10171},
 													val:        "⫽",
 													ignoreCase: false,
 													want:       "\"⫽\"",
@@ -11898,14 +11946,14 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 594, col: 70, offset: 18159},
+											pos:  position{line: 658, col: 70, offset: 20578},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 594, col: 72, offset: 18161},
+											pos:   position{line: 658, col: 72, offset: 20580},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 594, col: 74, offset: 18163},
+												pos:  position{line: 658, col: 74, offset: 20582},
 												name: "CombineTypesExpression",
 											},
 										},
@@ -11919,44 +11967,44 @@ var g = &grammar{
 		},
 		{
 			name: "CombineTypesExpression",
-			pos:  position{line: 596, col: 1, offset: 18257},
+			pos:  position{line: 660, col: 1, offset: 20676},
 			expr: &actionExpr{
-				pos: position{line: 596, col: 26, offset: 18284},
+				pos: position{line: 660, col: 26, offset: 20703},
 				run: (*parser).callonCombineTypesExpression1,
 				expr: &seqExpr{
-					pos: position{line: 596, col: 26, offset: 18284},
+					pos: position{line: 660, col: 26, offset: 20703},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 596, col: 26, offset: 18284},
+							pos:   position{line: 660, col: 26, offset: 20703},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 596, col: 32, offset: 18290},
+								pos:  position{line: 660, col: 32, offset: 20709},
 								name: "TimesExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 596, col: 55, offset: 18313},
+							pos:   position{line: 660, col: 55, offset: 20732},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 596, col: 60, offset: 18318},
+								pos: position{line: 660, col: 60, offset: 20737},
 								expr: &seqExpr{
-									pos: position{line: 596, col: 61, offset: 18319},
+									pos: position{line: 660, col: 61, offset: 20738},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 596, col: 61, offset: 18319},
+											pos:  position{line: 660, col: 61, offset: 20738},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 299, col: 16, offset: 7977},
+											pos: position{line: 358, col: 16, offset: 10107},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 299, col: 16, offset: 7977},
+													pos:        position{line: 358, col: 16, offset: 10107},
 													val:        "//\\\\",
 													ignoreCase: false,
 													want:       "\"//\\\\\\\\\"",
 												},
 												&litMatcher{
-													pos:        position{line: 299, col: 27, offset: 7988},
+													pos:        position{line: 358, col: 27, offset: 10118},
 													val:        "⩓",
 													ignoreCase: false,
 													want:       "\"⩓\"",
@@ -11964,14 +12012,14 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 596, col: 76, offset: 18334},
+											pos:  position{line: 660, col: 76, offset: 20753},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 596, col: 78, offset: 18336},
+											pos:   position{line: 660, col: 78, offset: 20755},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 596, col: 80, offset: 18338},
+												pos:  position{line: 660, col: 80, offset: 20757},
 												name: "TimesExpression",
 											},
 										},
@@ -11985,48 +12033,48 @@ var g = &grammar{
 		},
 		{
 			name: "TimesExpression",
-			pos:  position{line: 598, col: 1, offset: 18418},
+			pos:  position{line: 662, col: 1, offset: 20837},
 			expr: &actionExpr{
-				pos: position{line: 598, col: 26, offset: 18445},
+				pos: position{line: 662, col: 26, offset: 20864},
 				run: (*parser).callonTimesExpression1,
 				expr: &seqExpr{
-					pos: position{line: 598, col: 26, offset: 18445},
+					pos: position{line: 662, col: 26, offset: 20864},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 598, col: 26, offset: 18445},
+							pos:   position{line: 662, col: 26, offset: 20864},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 32, offset: 18451},
+								pos:  position{line: 662, col: 32, offset: 20870},
 								name: "EqualExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 598, col: 55, offset: 18474},
+							pos:   position{line: 662, col: 55, offset: 20893},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 598, col: 60, offset: 18479},
+								pos: position{line: 662, col: 60, offset: 20898},
 								expr: &seqExpr{
-									pos: position{line: 598, col: 61, offset: 18480},
+									pos: position{line: 662, col: 61, offset: 20899},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 598, col: 61, offset: 18480},
+											pos:  position{line: 662, col: 61, offset: 20899},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 598, col: 63, offset: 18482},
+											pos:        position{line: 662, col: 63, offset: 20901},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 598, col: 67, offset: 18486},
+											pos:  position{line: 662, col: 67, offset: 20905},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 598, col: 69, offset: 18488},
+											pos:   position{line: 662, col: 69, offset: 20907},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 598, col: 71, offset: 18490},
+												pos:  position{line: 662, col: 71, offset: 20909},
 												name: "EqualExpression",
 											},
 										},
@@ -12040,48 +12088,48 @@ var g = &grammar{
 		},
 		{
 			name: "EqualExpression",
-			pos:  position{line: 600, col: 1, offset: 18560},
+			pos:  position{line: 664, col: 1, offset: 20979},
 			expr: &actionExpr{
-				pos: position{line: 600, col: 26, offset: 18587},
+				pos: position{line: 664, col: 26, offset: 21006},
 				run: (*parser).callonEqualExpression1,
 				expr: &seqExpr{
-					pos: position{line: 600, col: 26, offset: 18587},
+					pos: position{line: 664, col: 26, offset: 21006},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 600, col: 26, offset: 18587},
+							pos:   position{line: 664, col: 26, offset: 21006},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 600, col: 32, offset: 18593},
+								pos:  position{line: 664, col: 32, offset: 21012},
 								name: "NotEqualExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 600, col: 55, offset: 18616},
+							pos:   position{line: 664, col: 55, offset: 21035},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 600, col: 60, offset: 18621},
+								pos: position{line: 664, col: 60, offset: 21040},
 								expr: &seqExpr{
-									pos: position{line: 600, col: 61, offset: 18622},
+									pos: position{line: 664, col: 61, offset: 21041},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 600, col: 61, offset: 18622},
+											pos:  position{line: 664, col: 61, offset: 21041},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 600, col: 63, offset: 18624},
+											pos:        position{line: 664, col: 63, offset: 21043},
 											val:        "==",
 											ignoreCase: false,
 											want:       "\"==\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 600, col: 68, offset: 18629},
+											pos:  position{line: 664, col: 68, offset: 21048},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 600, col: 70, offset: 18631},
+											pos:   position{line: 664, col: 70, offset: 21050},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 600, col: 72, offset: 18633},
+												pos:  position{line: 664, col: 72, offset: 21052},
 												name: "NotEqualExpression",
 											},
 										},
@@ -12095,48 +12143,48 @@ var g = &grammar{
 		},
 		{
 			name: "NotEqualExpression",
-			pos:  position{line: 602, col: 1, offset: 18703},
+			pos:  position{line: 666, col: 1, offset: 21122},
 			expr: &actionExpr{
-				pos: position{line: 602, col: 26, offset: 18730},
+				pos: position{line: 666, col: 26, offset: 21149},
 				run: (*parser).callonNotEqualExpression1,
 				expr: &seqExpr{
-					pos: position{line: 602, col: 26, offset: 18730},
+					pos: position{line: 666, col: 26, offset: 21149},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 602, col: 26, offset: 18730},
+							pos:   position{line: 666, col: 26, offset: 21149},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 602, col: 32, offset: 18736},
+								pos:  position{line: 666, col: 32, offset: 21155},
 								name: "ApplicationExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 602, col: 55, offset: 18759},
+							pos:   position{line: 666, col: 55, offset: 21178},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 602, col: 60, offset: 18764},
+								pos: position{line: 666, col: 60, offset: 21183},
 								expr: &seqExpr{
-									pos: position{line: 602, col: 61, offset: 18765},
+									pos: position{line: 666, col: 61, offset: 21184},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 602, col: 61, offset: 18765},
+											pos:  position{line: 666, col: 61, offset: 21184},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 602, col: 63, offset: 18767},
+											pos:        position{line: 666, col: 63, offset: 21186},
 											val:        "!=",
 											ignoreCase: false,
 											want:       "\"!=\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 602, col: 68, offset: 18772},
+											pos:  position{line: 666, col: 68, offset: 21191},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 602, col: 70, offset: 18774},
+											pos:   position{line: 666, col: 70, offset: 21193},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 602, col: 72, offset: 18776},
+												pos:  position{line: 666, col: 72, offset: 21195},
 												name: "ApplicationExpression",
 											},
 										},
@@ -12150,35 +12198,35 @@ var g = &grammar{
 		},
 		{
 			name: "ApplicationExpression",
-			pos:  position{line: 605, col: 1, offset: 18850},
+			pos:  position{line: 669, col: 1, offset: 21269},
 			expr: &actionExpr{
-				pos: position{line: 605, col: 25, offset: 18876},
+				pos: position{line: 669, col: 25, offset: 21295},
 				run: (*parser).callonApplicationExpression1,
 				expr: &seqExpr{
-					pos: position{line: 605, col: 25, offset: 18876},
+					pos: position{line: 669, col: 25, offset: 21295},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 605, col: 25, offset: 18876},
+							pos:   position{line: 669, col: 25, offset: 21295},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 605, col: 27, offset: 18878},
+								pos:  position{line: 669, col: 27, offset: 21297},
 								name: "FirstApplicationExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 605, col: 55, offset: 18906},
+							pos:   position{line: 669, col: 55, offset: 21325},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 605, col: 60, offset: 18911},
+								pos: position{line: 669, col: 60, offset: 21330},
 								expr: &seqExpr{
-									pos: position{line: 605, col: 61, offset: 18912},
+									pos: position{line: 669, col: 61, offset: 21331},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 605, col: 61, offset: 18912},
+											pos:  position{line: 669, col: 61, offset: 21331},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 605, col: 64, offset: 18915},
+											pos:  position{line: 669, col: 64, offset: 21334},
 											name: "ImportExpression",
 										},
 									},
@@ -12191,43 +12239,43 @@ var g = &grammar{
 		},
 		{
 			name: "FirstApplicationExpression",
-			pos:  position{line: 614, col: 1, offset: 19158},
+			pos:  position{line: 678, col: 1, offset: 21577},
 			expr: &choiceExpr{
-				pos: position{line: 615, col: 8, offset: 19196},
+				pos: position{line: 679, col: 8, offset: 21615},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 615, col: 8, offset: 19196},
+						pos: position{line: 679, col: 8, offset: 21615},
 						run: (*parser).callonFirstApplicationExpression2,
 						expr: &seqExpr{
-							pos: position{line: 615, col: 8, offset: 19196},
+							pos: position{line: 679, col: 8, offset: 21615},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 238, col: 9, offset: 6039},
+									pos:        position{line: 297, col: 9, offset: 8169},
 									val:        "merge",
 									ignoreCase: false,
 									want:       "\"merge\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 615, col: 14, offset: 19202},
+									pos:  position{line: 679, col: 14, offset: 21621},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 615, col: 17, offset: 19205},
+									pos:   position{line: 679, col: 17, offset: 21624},
 									label: "h",
 									expr: &ruleRefExpr{
-										pos:  position{line: 615, col: 19, offset: 19207},
+										pos:  position{line: 679, col: 19, offset: 21626},
 										name: "ImportExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 615, col: 36, offset: 19224},
+									pos:  position{line: 679, col: 36, offset: 21643},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 615, col: 39, offset: 19227},
+									pos:   position{line: 679, col: 39, offset: 21646},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 615, col: 41, offset: 19229},
+										pos:  position{line: 679, col: 41, offset: 21648},
 										name: "ImportExpression",
 									},
 								},
@@ -12235,26 +12283,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 618, col: 8, offset: 19332},
+						pos: position{line: 682, col: 8, offset: 21751},
 						run: (*parser).callonFirstApplicationExpression11,
 						expr: &seqExpr{
-							pos: position{line: 618, col: 8, offset: 19332},
+							pos: position{line: 682, col: 8, offset: 21751},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 242, col: 8, offset: 6163},
+									pos:        position{line: 301, col: 8, offset: 8293},
 									val:        "Some",
 									ignoreCase: false,
 									want:       "\"Some\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 618, col: 13, offset: 19337},
+									pos:  position{line: 682, col: 13, offset: 21756},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 618, col: 16, offset: 19340},
+									pos:   position{line: 682, col: 16, offset: 21759},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 618, col: 18, offset: 19342},
+										pos:  position{line: 682, col: 18, offset: 21761},
 										name: "ImportExpression",
 									},
 								},
@@ -12262,26 +12310,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 619, col: 8, offset: 19397},
+						pos: position{line: 683, col: 8, offset: 21816},
 						run: (*parser).callonFirstApplicationExpression17,
 						expr: &seqExpr{
-							pos: position{line: 619, col: 8, offset: 19397},
+							pos: position{line: 683, col: 8, offset: 21816},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 243, col: 9, offset: 6180},
+									pos:        position{line: 302, col: 9, offset: 8310},
 									val:        "toMap",
 									ignoreCase: false,
 									want:       "\"toMap\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 619, col: 14, offset: 19403},
+									pos:  position{line: 683, col: 14, offset: 21822},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 619, col: 17, offset: 19406},
+									pos:   position{line: 683, col: 17, offset: 21825},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 619, col: 19, offset: 19408},
+										pos:  position{line: 683, col: 19, offset: 21827},
 										name: "ImportExpression",
 									},
 								},
@@ -12289,7 +12337,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 620, col: 8, offset: 19472},
+						pos:  position{line: 684, col: 8, offset: 21891},
 						name: "ImportExpression",
 					},
 				},
@@ -12297,16 +12345,16 @@ var g = &grammar{
 		},
 		{
 			name: "ImportExpression",
-			pos:  position{line: 622, col: 1, offset: 19490},
+			pos:  position{line: 686, col: 1, offset: 21909},
 			expr: &choiceExpr{
-				pos: position{line: 622, col: 20, offset: 19511},
+				pos: position{line: 686, col: 20, offset: 21930},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 622, col: 20, offset: 19511},
+						pos:  position{line: 686, col: 20, offset: 21930},
 						name: "Import",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 622, col: 29, offset: 19520},
+						pos:  position{line: 686, col: 29, offset: 21939},
 						name: "CompletionExpression",
 					},
 				},
@@ -12314,45 +12362,45 @@ var g = &grammar{
 		},
 		{
 			name: "CompletionExpression",
-			pos:  position{line: 624, col: 1, offset: 19542},
+			pos:  position{line: 688, col: 1, offset: 21961},
 			expr: &actionExpr{
-				pos: position{line: 624, col: 24, offset: 19567},
+				pos: position{line: 688, col: 24, offset: 21986},
 				run: (*parser).callonCompletionExpression1,
 				expr: &seqExpr{
-					pos: position{line: 624, col: 24, offset: 19567},
+					pos: position{line: 688, col: 24, offset: 21986},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 624, col: 24, offset: 19567},
+							pos:   position{line: 688, col: 24, offset: 21986},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 624, col: 26, offset: 19569},
+								pos:  position{line: 688, col: 26, offset: 21988},
 								name: "SelectorExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 624, col: 45, offset: 19588},
+							pos:   position{line: 688, col: 45, offset: 22007},
 							label: "b",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 624, col: 47, offset: 19590},
+								pos: position{line: 688, col: 47, offset: 22009},
 								expr: &seqExpr{
-									pos: position{line: 624, col: 48, offset: 19591},
+									pos: position{line: 688, col: 48, offset: 22010},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 624, col: 48, offset: 19591},
+											pos:  position{line: 688, col: 48, offset: 22010},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 304, col: 12, offset: 8106},
+											pos:        position{line: 363, col: 12, offset: 10236},
 											val:        "::",
 											ignoreCase: false,
 											want:       "\"::\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 624, col: 59, offset: 19602},
+											pos:  position{line: 688, col: 59, offset: 22021},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 624, col: 61, offset: 19604},
+											pos:  position{line: 688, col: 61, offset: 22023},
 											name: "SelectorExpression",
 										},
 									},
@@ -12365,45 +12413,45 @@ var g = &grammar{
 		},
 		{
 			name: "SelectorExpression",
-			pos:  position{line: 631, col: 1, offset: 19755},
+			pos:  position{line: 695, col: 1, offset: 22174},
 			expr: &actionExpr{
-				pos: position{line: 631, col: 22, offset: 19778},
+				pos: position{line: 695, col: 22, offset: 22197},
 				run: (*parser).callonSelectorExpression1,
 				expr: &seqExpr{
-					pos: position{line: 631, col: 22, offset: 19778},
+					pos: position{line: 695, col: 22, offset: 22197},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 631, col: 22, offset: 19778},
+							pos:   position{line: 695, col: 22, offset: 22197},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 631, col: 24, offset: 19780},
+								pos:  position{line: 695, col: 24, offset: 22199},
 								name: "PrimitiveExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 631, col: 44, offset: 19800},
+							pos:   position{line: 695, col: 44, offset: 22219},
 							label: "ls",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 631, col: 47, offset: 19803},
+								pos: position{line: 695, col: 47, offset: 22222},
 								expr: &seqExpr{
-									pos: position{line: 631, col: 48, offset: 19804},
+									pos: position{line: 695, col: 48, offset: 22223},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 631, col: 48, offset: 19804},
+											pos:  position{line: 695, col: 48, offset: 22223},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 631, col: 50, offset: 19806},
+											pos:        position{line: 695, col: 50, offset: 22225},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 631, col: 54, offset: 19810},
+											pos:  position{line: 695, col: 54, offset: 22229},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 631, col: 56, offset: 19812},
+											pos:  position{line: 695, col: 56, offset: 22231},
 											name: "Selector",
 										},
 									},
@@ -12416,32 +12464,32 @@ var g = &grammar{
 		},
 		{
 			name: "Selector",
-			pos:  position{line: 650, col: 1, offset: 20365},
+			pos:  position{line: 714, col: 1, offset: 22784},
 			expr: &choiceExpr{
-				pos: position{line: 650, col: 12, offset: 20378},
+				pos: position{line: 714, col: 12, offset: 22797},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 131, col: 9, offset: 3119},
+						pos: position{line: 190, col: 9, offset: 5249},
 						run: (*parser).callonSelector2,
 						expr: &seqExpr{
-							pos: position{line: 131, col: 9, offset: 3119},
+							pos: position{line: 190, col: 9, offset: 5249},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 131, col: 9, offset: 3119},
+									pos:        position{line: 190, col: 9, offset: 5249},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 131, col: 13, offset: 3123},
+									pos:   position{line: 190, col: 13, offset: 5253},
 									label: "label",
 									expr: &actionExpr{
-										pos: position{line: 129, col: 15, offset: 3060},
+										pos: position{line: 188, col: 15, offset: 5190},
 										run: (*parser).callonSelector6,
 										expr: &zeroOrMoreExpr{
-											pos: position{line: 129, col: 15, offset: 3060},
+											pos: position{line: 188, col: 15, offset: 5190},
 											expr: &charClassMatcher{
-												pos:        position{line: 128, col: 19, offset: 3023},
+												pos:        position{line: 187, col: 19, offset: 5153},
 												val:        "[ -_a-~]",
 												ranges:     []rune{' ', '_', 'a', '~'},
 												ignoreCase: false,
@@ -12451,7 +12499,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 131, col: 31, offset: 3141},
+									pos:        position{line: 190, col: 31, offset: 5271},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -12460,75 +12508,75 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 132, col: 9, offset: 3175},
+						pos: position{line: 191, col: 9, offset: 5305},
 						run: (*parser).callonSelector10,
 						expr: &labeledExpr{
-							pos:   position{line: 132, col: 9, offset: 3175},
+							pos:   position{line: 191, col: 9, offset: 5305},
 							label: "label",
 							expr: &choiceExpr{
-								pos: position{line: 122, col: 15, offset: 2816},
+								pos: position{line: 181, col: 15, offset: 4946},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 122, col: 15, offset: 2816},
+										pos: position{line: 181, col: 15, offset: 4946},
 										run: (*parser).callonSelector13,
 										expr: &seqExpr{
-											pos: position{line: 122, col: 15, offset: 2816},
+											pos: position{line: 181, col: 15, offset: 4946},
 											exprs: []interface{}{
 												&choiceExpr{
-													pos: position{line: 249, col: 5, offset: 6269},
+													pos: position{line: 308, col: 5, offset: 8399},
 													alternatives: []interface{}{
 														&litMatcher{
-															pos:        position{line: 231, col: 6, offset: 5936},
+															pos:        position{line: 290, col: 6, offset: 8066},
 															val:        "if",
 															ignoreCase: false,
 															want:       "\"if\"",
 														},
 														&litMatcher{
-															pos:        position{line: 232, col: 8, offset: 5950},
+															pos:        position{line: 291, col: 8, offset: 8080},
 															val:        "then",
 															ignoreCase: false,
 															want:       "\"then\"",
 														},
 														&litMatcher{
-															pos:        position{line: 233, col: 8, offset: 5966},
+															pos:        position{line: 292, col: 8, offset: 8096},
 															val:        "else",
 															ignoreCase: false,
 															want:       "\"else\"",
 														},
 														&litMatcher{
-															pos:        position{line: 234, col: 7, offset: 5981},
+															pos:        position{line: 293, col: 7, offset: 8111},
 															val:        "let",
 															ignoreCase: false,
 															want:       "\"let\"",
 														},
 														&litMatcher{
-															pos:        position{line: 235, col: 6, offset: 5994},
+															pos:        position{line: 294, col: 6, offset: 8124},
 															val:        "in",
 															ignoreCase: false,
 															want:       "\"in\"",
 														},
 														&litMatcher{
-															pos:        position{line: 237, col: 9, offset: 6021},
+															pos:        position{line: 296, col: 9, offset: 8151},
 															val:        "using",
 															ignoreCase: false,
 															want:       "\"using\"",
 														},
 														&actionExpr{
-															pos: position{line: 239, col: 11, offset: 6059},
+															pos: position{line: 298, col: 11, offset: 8189},
 															run: (*parser).callonSelector22,
 															expr: &seqExpr{
-																pos: position{line: 239, col: 11, offset: 6059},
+																pos: position{line: 298, col: 11, offset: 8189},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 239, col: 11, offset: 6059},
+																		pos:        position{line: 298, col: 11, offset: 8189},
 																		val:        "missing",
 																		ignoreCase: false,
 																		want:       "\"missing\"",
 																	},
 																	&notExpr{
-																		pos: position{line: 239, col: 21, offset: 6069},
+																		pos: position{line: 298, col: 21, offset: 8199},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 121, col: 23, offset: 2785},
+																			pos:        position{line: 180, col: 23, offset: 4915},
 																			val:        "[_/-A-Za-z0-9]",
 																			chars:      []rune{'_', '/', '-'},
 																			ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -12540,61 +12588,61 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 244, col: 10, offset: 6199},
+															pos:        position{line: 303, col: 10, offset: 8329},
 															val:        "assert",
 															ignoreCase: false,
 															want:       "\"assert\"",
 														},
 														&litMatcher{
-															pos:        position{line: 236, col: 6, offset: 6006},
+															pos:        position{line: 295, col: 6, offset: 8136},
 															val:        "as",
 															ignoreCase: false,
 															want:       "\"as\"",
 														},
 														&litMatcher{
-															pos:        position{line: 240, col: 12, offset: 6129},
+															pos:        position{line: 299, col: 12, offset: 8259},
 															val:        "Infinity",
 															ignoreCase: false,
 															want:       "\"Infinity\"",
 														},
 														&litMatcher{
-															pos:        position{line: 241, col: 7, offset: 6148},
+															pos:        position{line: 300, col: 7, offset: 8278},
 															val:        "NaN",
 															ignoreCase: false,
 															want:       "\"NaN\"",
 														},
 														&litMatcher{
-															pos:        position{line: 238, col: 9, offset: 6039},
+															pos:        position{line: 297, col: 9, offset: 8169},
 															val:        "merge",
 															ignoreCase: false,
 															want:       "\"merge\"",
 														},
 														&litMatcher{
-															pos:        position{line: 242, col: 8, offset: 6163},
+															pos:        position{line: 301, col: 8, offset: 8293},
 															val:        "Some",
 															ignoreCase: false,
 															want:       "\"Some\"",
 														},
 														&litMatcher{
-															pos:        position{line: 243, col: 9, offset: 6180},
+															pos:        position{line: 302, col: 9, offset: 8310},
 															val:        "toMap",
 															ignoreCase: false,
 															want:       "\"toMap\"",
 														},
 														&litMatcher{
-															pos:        position{line: 245, col: 10, offset: 6219},
+															pos:        position{line: 304, col: 10, offset: 8349},
 															val:        "forall",
 															ignoreCase: false,
 															want:       "\"forall\"",
 														},
 														&litMatcher{
-															pos:        position{line: 245, col: 21, offset: 6230},
+															pos:        position{line: 304, col: 21, offset: 8360},
 															val:        "∀",
 															ignoreCase: false,
 															want:       "\"∀\"",
 														},
 														&litMatcher{
-															pos:        position{line: 246, col: 8, offset: 6245},
+															pos:        position{line: 305, col: 8, offset: 8375},
 															val:        "with",
 															ignoreCase: false,
 															want:       "\"with\"",
@@ -12602,9 +12650,9 @@ var g = &grammar{
 													},
 												},
 												&oneOrMoreExpr{
-													pos: position{line: 122, col: 23, offset: 2824},
+													pos: position{line: 181, col: 23, offset: 4954},
 													expr: &charClassMatcher{
-														pos:        position{line: 121, col: 23, offset: 2785},
+														pos:        position{line: 180, col: 23, offset: 4915},
 														val:        "[_/-A-Za-z0-9]",
 														chars:      []rune{'_', '/', '-'},
 														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -12616,68 +12664,68 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 123, col: 13, offset: 2888},
+										pos: position{line: 182, col: 13, offset: 5018},
 										run: (*parser).callonSelector39,
 										expr: &seqExpr{
-											pos: position{line: 123, col: 13, offset: 2888},
+											pos: position{line: 182, col: 13, offset: 5018},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 123, col: 13, offset: 2888},
+													pos: position{line: 182, col: 13, offset: 5018},
 													expr: &choiceExpr{
-														pos: position{line: 249, col: 5, offset: 6269},
+														pos: position{line: 308, col: 5, offset: 8399},
 														alternatives: []interface{}{
 															&litMatcher{
-																pos:        position{line: 231, col: 6, offset: 5936},
+																pos:        position{line: 290, col: 6, offset: 8066},
 																val:        "if",
 																ignoreCase: false,
 																want:       "\"if\"",
 															},
 															&litMatcher{
-																pos:        position{line: 232, col: 8, offset: 5950},
+																pos:        position{line: 291, col: 8, offset: 8080},
 																val:        "then",
 																ignoreCase: false,
 																want:       "\"then\"",
 															},
 															&litMatcher{
-																pos:        position{line: 233, col: 8, offset: 5966},
+																pos:        position{line: 292, col: 8, offset: 8096},
 																val:        "else",
 																ignoreCase: false,
 																want:       "\"else\"",
 															},
 															&litMatcher{
-																pos:        position{line: 234, col: 7, offset: 5981},
+																pos:        position{line: 293, col: 7, offset: 8111},
 																val:        "let",
 																ignoreCase: false,
 																want:       "\"let\"",
 															},
 															&litMatcher{
-																pos:        position{line: 235, col: 6, offset: 5994},
+																pos:        position{line: 294, col: 6, offset: 8124},
 																val:        "in",
 																ignoreCase: false,
 																want:       "\"in\"",
 															},
 															&litMatcher{
-																pos:        position{line: 237, col: 9, offset: 6021},
+																pos:        position{line: 296, col: 9, offset: 8151},
 																val:        "using",
 																ignoreCase: false,
 																want:       "\"using\"",
 															},
 															&actionExpr{
-																pos: position{line: 239, col: 11, offset: 6059},
+																pos: position{line: 298, col: 11, offset: 8189},
 																run: (*parser).callonSelector49,
 																expr: &seqExpr{
-																	pos: position{line: 239, col: 11, offset: 6059},
+																	pos: position{line: 298, col: 11, offset: 8189},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 239, col: 11, offset: 6059},
+																			pos:        position{line: 298, col: 11, offset: 8189},
 																			val:        "missing",
 																			ignoreCase: false,
 																			want:       "\"missing\"",
 																		},
 																		&notExpr{
-																			pos: position{line: 239, col: 21, offset: 6069},
+																			pos: position{line: 298, col: 21, offset: 8199},
 																			expr: &charClassMatcher{
-																				pos:        position{line: 121, col: 23, offset: 2785},
+																				pos:        position{line: 180, col: 23, offset: 4915},
 																				val:        "[_/-A-Za-z0-9]",
 																				chars:      []rune{'_', '/', '-'},
 																				ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -12689,61 +12737,61 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 244, col: 10, offset: 6199},
+																pos:        position{line: 303, col: 10, offset: 8329},
 																val:        "assert",
 																ignoreCase: false,
 																want:       "\"assert\"",
 															},
 															&litMatcher{
-																pos:        position{line: 236, col: 6, offset: 6006},
+																pos:        position{line: 295, col: 6, offset: 8136},
 																val:        "as",
 																ignoreCase: false,
 																want:       "\"as\"",
 															},
 															&litMatcher{
-																pos:        position{line: 240, col: 12, offset: 6129},
+																pos:        position{line: 299, col: 12, offset: 8259},
 																val:        "Infinity",
 																ignoreCase: false,
 																want:       "\"Infinity\"",
 															},
 															&litMatcher{
-																pos:        position{line: 241, col: 7, offset: 6148},
+																pos:        position{line: 300, col: 7, offset: 8278},
 																val:        "NaN",
 																ignoreCase: false,
 																want:       "\"NaN\"",
 															},
 															&litMatcher{
-																pos:        position{line: 238, col: 9, offset: 6039},
+																pos:        position{line: 297, col: 9, offset: 8169},
 																val:        "merge",
 																ignoreCase: false,
 																want:       "\"merge\"",
 															},
 															&litMatcher{
-																pos:        position{line: 242, col: 8, offset: 6163},
+																pos:        position{line: 301, col: 8, offset: 8293},
 																val:        "Some",
 																ignoreCase: false,
 																want:       "\"Some\"",
 															},
 															&litMatcher{
-																pos:        position{line: 243, col: 9, offset: 6180},
+																pos:        position{line: 302, col: 9, offset: 8310},
 																val:        "toMap",
 																ignoreCase: false,
 																want:       "\"toMap\"",
 															},
 															&litMatcher{
-																pos:        position{line: 245, col: 10, offset: 6219},
+																pos:        position{line: 304, col: 10, offset: 8349},
 																val:        "forall",
 																ignoreCase: false,
 																want:       "\"forall\"",
 															},
 															&litMatcher{
-																pos:        position{line: 245, col: 21, offset: 6230},
+																pos:        position{line: 304, col: 21, offset: 8360},
 																val:        "∀",
 																ignoreCase: false,
 																want:       "\"∀\"",
 															},
 															&litMatcher{
-																pos:        position{line: 246, col: 8, offset: 6245},
+																pos:        position{line: 305, col: 8, offset: 8375},
 																val:        "with",
 																ignoreCase: false,
 																want:       "\"with\"",
@@ -12752,7 +12800,7 @@ var g = &grammar{
 													},
 												},
 												&charClassMatcher{
-													pos:        position{line: 120, col: 24, offset: 2751},
+													pos:        position{line: 179, col: 24, offset: 4881},
 													val:        "[_A-Za-z]",
 													chars:      []rune{'_'},
 													ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -12760,9 +12808,9 @@ var g = &grammar{
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 123, col: 43, offset: 2918},
+													pos: position{line: 182, col: 43, offset: 5048},
 													expr: &charClassMatcher{
-														pos:        position{line: 121, col: 23, offset: 2785},
+														pos:        position{line: 180, col: 23, offset: 4915},
 														val:        "[_/-A-Za-z0-9]",
 														chars:      []rune{'_', '/', '-'},
 														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -12778,11 +12826,11 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 650, col: 23, offset: 20389},
+						pos:  position{line: 714, col: 23, offset: 22808},
 						name: "Labels",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 650, col: 32, offset: 20398},
+						pos:  position{line: 714, col: 32, offset: 22817},
 						name: "TypeSelector",
 					},
 				},
@@ -12790,74 +12838,74 @@ var g = &grammar{
 		},
 		{
 			name: "Labels",
-			pos:  position{line: 652, col: 1, offset: 20412},
+			pos:  position{line: 716, col: 1, offset: 22831},
 			expr: &actionExpr{
-				pos: position{line: 653, col: 5, offset: 20427},
+				pos: position{line: 717, col: 5, offset: 22846},
 				run: (*parser).callonLabels1,
 				expr: &seqExpr{
-					pos: position{line: 653, col: 5, offset: 20427},
+					pos: position{line: 717, col: 5, offset: 22846},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 653, col: 5, offset: 20427},
+							pos:        position{line: 717, col: 5, offset: 22846},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 653, col: 9, offset: 20431},
+							pos:  position{line: 717, col: 9, offset: 22850},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 653, col: 11, offset: 20433},
+							pos: position{line: 717, col: 11, offset: 22852},
 							expr: &seqExpr{
-								pos: position{line: 653, col: 13, offset: 20435},
+								pos: position{line: 717, col: 13, offset: 22854},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 653, col: 13, offset: 20435},
+										pos:        position{line: 717, col: 13, offset: 22854},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 653, col: 17, offset: 20439},
+										pos:  position{line: 717, col: 17, offset: 22858},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 653, col: 22, offset: 20444},
+							pos:   position{line: 717, col: 22, offset: 22863},
 							label: "optclauses",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 653, col: 33, offset: 20455},
+								pos: position{line: 717, col: 33, offset: 22874},
 								expr: &seqExpr{
-									pos: position{line: 653, col: 35, offset: 20457},
+									pos: position{line: 717, col: 35, offset: 22876},
 									exprs: []interface{}{
 										&choiceExpr{
-											pos: position{line: 139, col: 18, offset: 3403},
+											pos: position{line: 198, col: 18, offset: 5533},
 											alternatives: []interface{}{
 												&actionExpr{
-													pos: position{line: 131, col: 9, offset: 3119},
+													pos: position{line: 190, col: 9, offset: 5249},
 													run: (*parser).callonLabels13,
 													expr: &seqExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 131, col: 9, offset: 3119},
+																pos:        position{line: 190, col: 9, offset: 5249},
 																val:        "`",
 																ignoreCase: false,
 																want:       "\"`\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 131, col: 13, offset: 3123},
+																pos:   position{line: 190, col: 13, offset: 5253},
 																label: "label",
 																expr: &actionExpr{
-																	pos: position{line: 129, col: 15, offset: 3060},
+																	pos: position{line: 188, col: 15, offset: 5190},
 																	run: (*parser).callonLabels17,
 																	expr: &zeroOrMoreExpr{
-																		pos: position{line: 129, col: 15, offset: 3060},
+																		pos: position{line: 188, col: 15, offset: 5190},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 128, col: 19, offset: 3023},
+																			pos:        position{line: 187, col: 19, offset: 5153},
 																			val:        "[ -_a-~]",
 																			ranges:     []rune{' ', '_', 'a', '~'},
 																			ignoreCase: false,
@@ -12867,7 +12915,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 131, col: 31, offset: 3141},
+																pos:        position{line: 190, col: 31, offset: 5271},
 																val:        "`",
 																ignoreCase: false,
 																want:       "\"`\"",
@@ -12876,75 +12924,75 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 132, col: 9, offset: 3175},
+													pos: position{line: 191, col: 9, offset: 5305},
 													run: (*parser).callonLabels21,
 													expr: &labeledExpr{
-														pos:   position{line: 132, col: 9, offset: 3175},
+														pos:   position{line: 191, col: 9, offset: 5305},
 														label: "label",
 														expr: &choiceExpr{
-															pos: position{line: 122, col: 15, offset: 2816},
+															pos: position{line: 181, col: 15, offset: 4946},
 															alternatives: []interface{}{
 																&actionExpr{
-																	pos: position{line: 122, col: 15, offset: 2816},
+																	pos: position{line: 181, col: 15, offset: 4946},
 																	run: (*parser).callonLabels24,
 																	expr: &seqExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		exprs: []interface{}{
 																			&choiceExpr{
-																				pos: position{line: 249, col: 5, offset: 6269},
+																				pos: position{line: 308, col: 5, offset: 8399},
 																				alternatives: []interface{}{
 																					&litMatcher{
-																						pos:        position{line: 231, col: 6, offset: 5936},
+																						pos:        position{line: 290, col: 6, offset: 8066},
 																						val:        "if",
 																						ignoreCase: false,
 																						want:       "\"if\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 232, col: 8, offset: 5950},
+																						pos:        position{line: 291, col: 8, offset: 8080},
 																						val:        "then",
 																						ignoreCase: false,
 																						want:       "\"then\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 233, col: 8, offset: 5966},
+																						pos:        position{line: 292, col: 8, offset: 8096},
 																						val:        "else",
 																						ignoreCase: false,
 																						want:       "\"else\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 234, col: 7, offset: 5981},
+																						pos:        position{line: 293, col: 7, offset: 8111},
 																						val:        "let",
 																						ignoreCase: false,
 																						want:       "\"let\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 235, col: 6, offset: 5994},
+																						pos:        position{line: 294, col: 6, offset: 8124},
 																						val:        "in",
 																						ignoreCase: false,
 																						want:       "\"in\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 237, col: 9, offset: 6021},
+																						pos:        position{line: 296, col: 9, offset: 8151},
 																						val:        "using",
 																						ignoreCase: false,
 																						want:       "\"using\"",
 																					},
 																					&actionExpr{
-																						pos: position{line: 239, col: 11, offset: 6059},
+																						pos: position{line: 298, col: 11, offset: 8189},
 																						run: (*parser).callonLabels33,
 																						expr: &seqExpr{
-																							pos: position{line: 239, col: 11, offset: 6059},
+																							pos: position{line: 298, col: 11, offset: 8189},
 																							exprs: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 239, col: 11, offset: 6059},
+																									pos:        position{line: 298, col: 11, offset: 8189},
 																									val:        "missing",
 																									ignoreCase: false,
 																									want:       "\"missing\"",
 																								},
 																								&notExpr{
-																									pos: position{line: 239, col: 21, offset: 6069},
+																									pos: position{line: 298, col: 21, offset: 8199},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -12956,61 +13004,61 @@ var g = &grammar{
 																						},
 																					},
 																					&litMatcher{
-																						pos:        position{line: 244, col: 10, offset: 6199},
+																						pos:        position{line: 303, col: 10, offset: 8329},
 																						val:        "assert",
 																						ignoreCase: false,
 																						want:       "\"assert\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 236, col: 6, offset: 6006},
+																						pos:        position{line: 295, col: 6, offset: 8136},
 																						val:        "as",
 																						ignoreCase: false,
 																						want:       "\"as\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 240, col: 12, offset: 6129},
+																						pos:        position{line: 299, col: 12, offset: 8259},
 																						val:        "Infinity",
 																						ignoreCase: false,
 																						want:       "\"Infinity\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 241, col: 7, offset: 6148},
+																						pos:        position{line: 300, col: 7, offset: 8278},
 																						val:        "NaN",
 																						ignoreCase: false,
 																						want:       "\"NaN\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 238, col: 9, offset: 6039},
+																						pos:        position{line: 297, col: 9, offset: 8169},
 																						val:        "merge",
 																						ignoreCase: false,
 																						want:       "\"merge\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 242, col: 8, offset: 6163},
+																						pos:        position{line: 301, col: 8, offset: 8293},
 																						val:        "Some",
 																						ignoreCase: false,
 																						want:       "\"Some\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 243, col: 9, offset: 6180},
+																						pos:        position{line: 302, col: 9, offset: 8310},
 																						val:        "toMap",
 																						ignoreCase: false,
 																						want:       "\"toMap\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 245, col: 10, offset: 6219},
+																						pos:        position{line: 304, col: 10, offset: 8349},
 																						val:        "forall",
 																						ignoreCase: false,
 																						want:       "\"forall\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 245, col: 21, offset: 6230},
+																						pos:        position{line: 304, col: 21, offset: 8360},
 																						val:        "∀",
 																						ignoreCase: false,
 																						want:       "\"∀\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 246, col: 8, offset: 6245},
+																						pos:        position{line: 305, col: 8, offset: 8375},
 																						val:        "with",
 																						ignoreCase: false,
 																						want:       "\"with\"",
@@ -13018,9 +13066,9 @@ var g = &grammar{
 																				},
 																			},
 																			&oneOrMoreExpr{
-																				pos: position{line: 122, col: 23, offset: 2824},
+																				pos: position{line: 181, col: 23, offset: 4954},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13032,68 +13080,68 @@ var g = &grammar{
 																	},
 																},
 																&actionExpr{
-																	pos: position{line: 123, col: 13, offset: 2888},
+																	pos: position{line: 182, col: 13, offset: 5018},
 																	run: (*parser).callonLabels50,
 																	expr: &seqExpr{
-																		pos: position{line: 123, col: 13, offset: 2888},
+																		pos: position{line: 182, col: 13, offset: 5018},
 																		exprs: []interface{}{
 																			&notExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				expr: &choiceExpr{
-																					pos: position{line: 249, col: 5, offset: 6269},
+																					pos: position{line: 308, col: 5, offset: 8399},
 																					alternatives: []interface{}{
 																						&litMatcher{
-																							pos:        position{line: 231, col: 6, offset: 5936},
+																							pos:        position{line: 290, col: 6, offset: 8066},
 																							val:        "if",
 																							ignoreCase: false,
 																							want:       "\"if\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 232, col: 8, offset: 5950},
+																							pos:        position{line: 291, col: 8, offset: 8080},
 																							val:        "then",
 																							ignoreCase: false,
 																							want:       "\"then\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 233, col: 8, offset: 5966},
+																							pos:        position{line: 292, col: 8, offset: 8096},
 																							val:        "else",
 																							ignoreCase: false,
 																							want:       "\"else\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 234, col: 7, offset: 5981},
+																							pos:        position{line: 293, col: 7, offset: 8111},
 																							val:        "let",
 																							ignoreCase: false,
 																							want:       "\"let\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 235, col: 6, offset: 5994},
+																							pos:        position{line: 294, col: 6, offset: 8124},
 																							val:        "in",
 																							ignoreCase: false,
 																							want:       "\"in\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 237, col: 9, offset: 6021},
+																							pos:        position{line: 296, col: 9, offset: 8151},
 																							val:        "using",
 																							ignoreCase: false,
 																							want:       "\"using\"",
 																						},
 																						&actionExpr{
-																							pos: position{line: 239, col: 11, offset: 6059},
+																							pos: position{line: 298, col: 11, offset: 8189},
 																							run: (*parser).callonLabels60,
 																							expr: &seqExpr{
-																								pos: position{line: 239, col: 11, offset: 6059},
+																								pos: position{line: 298, col: 11, offset: 8189},
 																								exprs: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 239, col: 11, offset: 6059},
+																										pos:        position{line: 298, col: 11, offset: 8189},
 																										val:        "missing",
 																										ignoreCase: false,
 																										want:       "\"missing\"",
 																									},
 																									&notExpr{
-																										pos: position{line: 239, col: 21, offset: 6069},
+																										pos: position{line: 298, col: 21, offset: 8199},
 																										expr: &charClassMatcher{
-																											pos:        position{line: 121, col: 23, offset: 2785},
+																											pos:        position{line: 180, col: 23, offset: 4915},
 																											val:        "[_/-A-Za-z0-9]",
 																											chars:      []rune{'_', '/', '-'},
 																											ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13105,61 +13153,61 @@ var g = &grammar{
 																							},
 																						},
 																						&litMatcher{
-																							pos:        position{line: 244, col: 10, offset: 6199},
+																							pos:        position{line: 303, col: 10, offset: 8329},
 																							val:        "assert",
 																							ignoreCase: false,
 																							want:       "\"assert\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 236, col: 6, offset: 6006},
+																							pos:        position{line: 295, col: 6, offset: 8136},
 																							val:        "as",
 																							ignoreCase: false,
 																							want:       "\"as\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 240, col: 12, offset: 6129},
+																							pos:        position{line: 299, col: 12, offset: 8259},
 																							val:        "Infinity",
 																							ignoreCase: false,
 																							want:       "\"Infinity\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 241, col: 7, offset: 6148},
+																							pos:        position{line: 300, col: 7, offset: 8278},
 																							val:        "NaN",
 																							ignoreCase: false,
 																							want:       "\"NaN\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 238, col: 9, offset: 6039},
+																							pos:        position{line: 297, col: 9, offset: 8169},
 																							val:        "merge",
 																							ignoreCase: false,
 																							want:       "\"merge\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 242, col: 8, offset: 6163},
+																							pos:        position{line: 301, col: 8, offset: 8293},
 																							val:        "Some",
 																							ignoreCase: false,
 																							want:       "\"Some\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 243, col: 9, offset: 6180},
+																							pos:        position{line: 302, col: 9, offset: 8310},
 																							val:        "toMap",
 																							ignoreCase: false,
 																							want:       "\"toMap\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 245, col: 10, offset: 6219},
+																							pos:        position{line: 304, col: 10, offset: 8349},
 																							val:        "forall",
 																							ignoreCase: false,
 																							want:       "\"forall\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 245, col: 21, offset: 6230},
+																							pos:        position{line: 304, col: 21, offset: 8360},
 																							val:        "∀",
 																							ignoreCase: false,
 																							want:       "\"∀\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 246, col: 8, offset: 6245},
+																							pos:        position{line: 305, col: 8, offset: 8375},
 																							val:        "with",
 																							ignoreCase: false,
 																							want:       "\"with\"",
@@ -13168,7 +13216,7 @@ var g = &grammar{
 																				},
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 120, col: 24, offset: 2751},
+																				pos:        position{line: 179, col: 24, offset: 4881},
 																				val:        "[_A-Za-z]",
 																				chars:      []rune{'_'},
 																				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -13176,9 +13224,9 @@ var g = &grammar{
 																				inverted:   false,
 																			},
 																			&zeroOrMoreExpr{
-																				pos: position{line: 123, col: 43, offset: 2918},
+																				pos: position{line: 182, col: 43, offset: 5048},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13194,10 +13242,10 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 139, col: 29, offset: 3414},
+													pos: position{line: 198, col: 29, offset: 5544},
 													run: (*parser).callonLabels78,
 													expr: &litMatcher{
-														pos:        position{line: 242, col: 8, offset: 6163},
+														pos:        position{line: 301, col: 8, offset: 8293},
 														val:        "Some",
 														ignoreCase: false,
 														want:       "\"Some\"",
@@ -13206,49 +13254,49 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 653, col: 50, offset: 20472},
+											pos:  position{line: 717, col: 50, offset: 22891},
 											name: "_",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 653, col: 52, offset: 20474},
+											pos: position{line: 717, col: 52, offset: 22893},
 											expr: &seqExpr{
-												pos: position{line: 653, col: 53, offset: 20475},
+												pos: position{line: 717, col: 53, offset: 22894},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 653, col: 53, offset: 20475},
+														pos:        position{line: 717, col: 53, offset: 22894},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 653, col: 57, offset: 20479},
+														pos:  position{line: 717, col: 57, offset: 22898},
 														name: "_",
 													},
 													&choiceExpr{
-														pos: position{line: 139, col: 18, offset: 3403},
+														pos: position{line: 198, col: 18, offset: 5533},
 														alternatives: []interface{}{
 															&actionExpr{
-																pos: position{line: 131, col: 9, offset: 3119},
+																pos: position{line: 190, col: 9, offset: 5249},
 																run: (*parser).callonLabels86,
 																expr: &seqExpr{
-																	pos: position{line: 131, col: 9, offset: 3119},
+																	pos: position{line: 190, col: 9, offset: 5249},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 131, col: 9, offset: 3119},
+																			pos:        position{line: 190, col: 9, offset: 5249},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
-																			pos:   position{line: 131, col: 13, offset: 3123},
+																			pos:   position{line: 190, col: 13, offset: 5253},
 																			label: "label",
 																			expr: &actionExpr{
-																				pos: position{line: 129, col: 15, offset: 3060},
+																				pos: position{line: 188, col: 15, offset: 5190},
 																				run: (*parser).callonLabels90,
 																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 129, col: 15, offset: 3060},
+																					pos: position{line: 188, col: 15, offset: 5190},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 128, col: 19, offset: 3023},
+																						pos:        position{line: 187, col: 19, offset: 5153},
 																						val:        "[ -_a-~]",
 																						ranges:     []rune{' ', '_', 'a', '~'},
 																						ignoreCase: false,
@@ -13258,7 +13306,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 131, col: 31, offset: 3141},
+																			pos:        position{line: 190, col: 31, offset: 5271},
 																			val:        "`",
 																			ignoreCase: false,
 																			want:       "\"`\"",
@@ -13267,75 +13315,75 @@ var g = &grammar{
 																},
 															},
 															&actionExpr{
-																pos: position{line: 132, col: 9, offset: 3175},
+																pos: position{line: 191, col: 9, offset: 5305},
 																run: (*parser).callonLabels94,
 																expr: &labeledExpr{
-																	pos:   position{line: 132, col: 9, offset: 3175},
+																	pos:   position{line: 191, col: 9, offset: 5305},
 																	label: "label",
 																	expr: &choiceExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		alternatives: []interface{}{
 																			&actionExpr{
-																				pos: position{line: 122, col: 15, offset: 2816},
+																				pos: position{line: 181, col: 15, offset: 4946},
 																				run: (*parser).callonLabels97,
 																				expr: &seqExpr{
-																					pos: position{line: 122, col: 15, offset: 2816},
+																					pos: position{line: 181, col: 15, offset: 4946},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 249, col: 5, offset: 6269},
+																							pos: position{line: 308, col: 5, offset: 8399},
 																							alternatives: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 231, col: 6, offset: 5936},
+																									pos:        position{line: 290, col: 6, offset: 8066},
 																									val:        "if",
 																									ignoreCase: false,
 																									want:       "\"if\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 232, col: 8, offset: 5950},
+																									pos:        position{line: 291, col: 8, offset: 8080},
 																									val:        "then",
 																									ignoreCase: false,
 																									want:       "\"then\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 233, col: 8, offset: 5966},
+																									pos:        position{line: 292, col: 8, offset: 8096},
 																									val:        "else",
 																									ignoreCase: false,
 																									want:       "\"else\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 234, col: 7, offset: 5981},
+																									pos:        position{line: 293, col: 7, offset: 8111},
 																									val:        "let",
 																									ignoreCase: false,
 																									want:       "\"let\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 235, col: 6, offset: 5994},
+																									pos:        position{line: 294, col: 6, offset: 8124},
 																									val:        "in",
 																									ignoreCase: false,
 																									want:       "\"in\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 237, col: 9, offset: 6021},
+																									pos:        position{line: 296, col: 9, offset: 8151},
 																									val:        "using",
 																									ignoreCase: false,
 																									want:       "\"using\"",
 																								},
 																								&actionExpr{
-																									pos: position{line: 239, col: 11, offset: 6059},
+																									pos: position{line: 298, col: 11, offset: 8189},
 																									run: (*parser).callonLabels106,
 																									expr: &seqExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										exprs: []interface{}{
 																											&litMatcher{
-																												pos:        position{line: 239, col: 11, offset: 6059},
+																												pos:        position{line: 298, col: 11, offset: 8189},
 																												val:        "missing",
 																												ignoreCase: false,
 																												want:       "\"missing\"",
 																											},
 																											&notExpr{
-																												pos: position{line: 239, col: 21, offset: 6069},
+																												pos: position{line: 298, col: 21, offset: 8199},
 																												expr: &charClassMatcher{
-																													pos:        position{line: 121, col: 23, offset: 2785},
+																													pos:        position{line: 180, col: 23, offset: 4915},
 																													val:        "[_/-A-Za-z0-9]",
 																													chars:      []rune{'_', '/', '-'},
 																													ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13347,61 +13395,61 @@ var g = &grammar{
 																									},
 																								},
 																								&litMatcher{
-																									pos:        position{line: 244, col: 10, offset: 6199},
+																									pos:        position{line: 303, col: 10, offset: 8329},
 																									val:        "assert",
 																									ignoreCase: false,
 																									want:       "\"assert\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 236, col: 6, offset: 6006},
+																									pos:        position{line: 295, col: 6, offset: 8136},
 																									val:        "as",
 																									ignoreCase: false,
 																									want:       "\"as\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 240, col: 12, offset: 6129},
+																									pos:        position{line: 299, col: 12, offset: 8259},
 																									val:        "Infinity",
 																									ignoreCase: false,
 																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 241, col: 7, offset: 6148},
+																									pos:        position{line: 300, col: 7, offset: 8278},
 																									val:        "NaN",
 																									ignoreCase: false,
 																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 238, col: 9, offset: 6039},
+																									pos:        position{line: 297, col: 9, offset: 8169},
 																									val:        "merge",
 																									ignoreCase: false,
 																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 242, col: 8, offset: 6163},
+																									pos:        position{line: 301, col: 8, offset: 8293},
 																									val:        "Some",
 																									ignoreCase: false,
 																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 243, col: 9, offset: 6180},
+																									pos:        position{line: 302, col: 9, offset: 8310},
 																									val:        "toMap",
 																									ignoreCase: false,
 																									want:       "\"toMap\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 10, offset: 6219},
+																									pos:        position{line: 304, col: 10, offset: 8349},
 																									val:        "forall",
 																									ignoreCase: false,
 																									want:       "\"forall\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 245, col: 21, offset: 6230},
+																									pos:        position{line: 304, col: 21, offset: 8360},
 																									val:        "∀",
 																									ignoreCase: false,
 																									want:       "\"∀\"",
 																								},
 																								&litMatcher{
-																									pos:        position{line: 246, col: 8, offset: 6245},
+																									pos:        position{line: 305, col: 8, offset: 8375},
 																									val:        "with",
 																									ignoreCase: false,
 																									want:       "\"with\"",
@@ -13409,9 +13457,9 @@ var g = &grammar{
 																							},
 																						},
 																						&oneOrMoreExpr{
-																							pos: position{line: 122, col: 23, offset: 2824},
+																							pos: position{line: 181, col: 23, offset: 4954},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13423,68 +13471,68 @@ var g = &grammar{
 																				},
 																			},
 																			&actionExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				run: (*parser).callonLabels123,
 																				expr: &seqExpr{
-																					pos: position{line: 123, col: 13, offset: 2888},
+																					pos: position{line: 182, col: 13, offset: 5018},
 																					exprs: []interface{}{
 																						&notExpr{
-																							pos: position{line: 123, col: 13, offset: 2888},
+																							pos: position{line: 182, col: 13, offset: 5018},
 																							expr: &choiceExpr{
-																								pos: position{line: 249, col: 5, offset: 6269},
+																								pos: position{line: 308, col: 5, offset: 8399},
 																								alternatives: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 231, col: 6, offset: 5936},
+																										pos:        position{line: 290, col: 6, offset: 8066},
 																										val:        "if",
 																										ignoreCase: false,
 																										want:       "\"if\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 232, col: 8, offset: 5950},
+																										pos:        position{line: 291, col: 8, offset: 8080},
 																										val:        "then",
 																										ignoreCase: false,
 																										want:       "\"then\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 233, col: 8, offset: 5966},
+																										pos:        position{line: 292, col: 8, offset: 8096},
 																										val:        "else",
 																										ignoreCase: false,
 																										want:       "\"else\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 234, col: 7, offset: 5981},
+																										pos:        position{line: 293, col: 7, offset: 8111},
 																										val:        "let",
 																										ignoreCase: false,
 																										want:       "\"let\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 235, col: 6, offset: 5994},
+																										pos:        position{line: 294, col: 6, offset: 8124},
 																										val:        "in",
 																										ignoreCase: false,
 																										want:       "\"in\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 237, col: 9, offset: 6021},
+																										pos:        position{line: 296, col: 9, offset: 8151},
 																										val:        "using",
 																										ignoreCase: false,
 																										want:       "\"using\"",
 																									},
 																									&actionExpr{
-																										pos: position{line: 239, col: 11, offset: 6059},
+																										pos: position{line: 298, col: 11, offset: 8189},
 																										run: (*parser).callonLabels133,
 																										expr: &seqExpr{
-																											pos: position{line: 239, col: 11, offset: 6059},
+																											pos: position{line: 298, col: 11, offset: 8189},
 																											exprs: []interface{}{
 																												&litMatcher{
-																													pos:        position{line: 239, col: 11, offset: 6059},
+																													pos:        position{line: 298, col: 11, offset: 8189},
 																													val:        "missing",
 																													ignoreCase: false,
 																													want:       "\"missing\"",
 																												},
 																												&notExpr{
-																													pos: position{line: 239, col: 21, offset: 6069},
+																													pos: position{line: 298, col: 21, offset: 8199},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 121, col: 23, offset: 2785},
+																														pos:        position{line: 180, col: 23, offset: 4915},
 																														val:        "[_/-A-Za-z0-9]",
 																														chars:      []rune{'_', '/', '-'},
 																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13496,61 +13544,61 @@ var g = &grammar{
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 244, col: 10, offset: 6199},
+																										pos:        position{line: 303, col: 10, offset: 8329},
 																										val:        "assert",
 																										ignoreCase: false,
 																										want:       "\"assert\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 236, col: 6, offset: 6006},
+																										pos:        position{line: 295, col: 6, offset: 8136},
 																										val:        "as",
 																										ignoreCase: false,
 																										want:       "\"as\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 240, col: 12, offset: 6129},
+																										pos:        position{line: 299, col: 12, offset: 8259},
 																										val:        "Infinity",
 																										ignoreCase: false,
 																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 241, col: 7, offset: 6148},
+																										pos:        position{line: 300, col: 7, offset: 8278},
 																										val:        "NaN",
 																										ignoreCase: false,
 																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 238, col: 9, offset: 6039},
+																										pos:        position{line: 297, col: 9, offset: 8169},
 																										val:        "merge",
 																										ignoreCase: false,
 																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 242, col: 8, offset: 6163},
+																										pos:        position{line: 301, col: 8, offset: 8293},
 																										val:        "Some",
 																										ignoreCase: false,
 																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 243, col: 9, offset: 6180},
+																										pos:        position{line: 302, col: 9, offset: 8310},
 																										val:        "toMap",
 																										ignoreCase: false,
 																										want:       "\"toMap\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 10, offset: 6219},
+																										pos:        position{line: 304, col: 10, offset: 8349},
 																										val:        "forall",
 																										ignoreCase: false,
 																										want:       "\"forall\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 245, col: 21, offset: 6230},
+																										pos:        position{line: 304, col: 21, offset: 8360},
 																										val:        "∀",
 																										ignoreCase: false,
 																										want:       "\"∀\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 246, col: 8, offset: 6245},
+																										pos:        position{line: 305, col: 8, offset: 8375},
 																										val:        "with",
 																										ignoreCase: false,
 																										want:       "\"with\"",
@@ -13559,7 +13607,7 @@ var g = &grammar{
 																							},
 																						},
 																						&charClassMatcher{
-																							pos:        position{line: 120, col: 24, offset: 2751},
+																							pos:        position{line: 179, col: 24, offset: 4881},
 																							val:        "[_A-Za-z]",
 																							chars:      []rune{'_'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -13567,9 +13615,9 @@ var g = &grammar{
 																							inverted:   false,
 																						},
 																						&zeroOrMoreExpr{
-																							pos: position{line: 123, col: 43, offset: 2918},
+																							pos: position{line: 182, col: 43, offset: 5048},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -13585,10 +13633,10 @@ var g = &grammar{
 																},
 															},
 															&actionExpr{
-																pos: position{line: 139, col: 29, offset: 3414},
+																pos: position{line: 198, col: 29, offset: 5544},
 																run: (*parser).callonLabels151,
 																expr: &litMatcher{
-																	pos:        position{line: 242, col: 8, offset: 6163},
+																	pos:        position{line: 301, col: 8, offset: 8293},
 																	val:        "Some",
 																	ignoreCase: false,
 																	want:       "\"Some\"",
@@ -13597,25 +13645,25 @@ var g = &grammar{
 														},
 													},
 													&ruleRefExpr{
-														pos:  position{line: 653, col: 74, offset: 20496},
+														pos:  position{line: 717, col: 74, offset: 22915},
 														name: "_",
 													},
 												},
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 653, col: 79, offset: 20501},
+											pos: position{line: 717, col: 79, offset: 22920},
 											expr: &seqExpr{
-												pos: position{line: 653, col: 80, offset: 20502},
+												pos: position{line: 717, col: 80, offset: 22921},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 653, col: 80, offset: 20502},
+														pos:        position{line: 717, col: 80, offset: 22921},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 653, col: 84, offset: 20506},
+														pos:  position{line: 717, col: 84, offset: 22925},
 														name: "_",
 													},
 												},
@@ -13626,7 +13674,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 653, col: 91, offset: 20513},
+							pos:        position{line: 717, col: 91, offset: 22932},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -13637,37 +13685,37 @@ var g = &grammar{
 		},
 		{
 			name: "TypeSelector",
-			pos:  position{line: 663, col: 1, offset: 20809},
+			pos:  position{line: 727, col: 1, offset: 23228},
 			expr: &actionExpr{
-				pos: position{line: 663, col: 16, offset: 20826},
+				pos: position{line: 727, col: 16, offset: 23245},
 				run: (*parser).callonTypeSelector1,
 				expr: &seqExpr{
-					pos: position{line: 663, col: 16, offset: 20826},
+					pos: position{line: 727, col: 16, offset: 23245},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 663, col: 16, offset: 20826},
+							pos:        position{line: 727, col: 16, offset: 23245},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 663, col: 20, offset: 20830},
+							pos:  position{line: 727, col: 20, offset: 23249},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 663, col: 22, offset: 20832},
+							pos:   position{line: 727, col: 22, offset: 23251},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 663, col: 24, offset: 20834},
+								pos:  position{line: 727, col: 24, offset: 23253},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 663, col: 35, offset: 20845},
+							pos:  position{line: 727, col: 35, offset: 23264},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 663, col: 37, offset: 20847},
+							pos:        position{line: 727, col: 37, offset: 23266},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -13678,23 +13726,23 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveExpression",
-			pos:  position{line: 665, col: 1, offset: 20870},
+			pos:  position{line: 729, col: 1, offset: 23289},
 			expr: &choiceExpr{
-				pos: position{line: 666, col: 7, offset: 20900},
+				pos: position{line: 730, col: 7, offset: 23319},
 				alternatives: []interface{}{
 					&labeledExpr{
-						pos:   position{line: 316, col: 17, offset: 8381},
+						pos:   position{line: 375, col: 17, offset: 10511},
 						label: "d",
 						expr: &actionExpr{
-							pos: position{line: 308, col: 24, offset: 8169},
+							pos: position{line: 367, col: 24, offset: 10299},
 							run: (*parser).callonPrimitiveExpression3,
 							expr: &seqExpr{
-								pos: position{line: 308, col: 24, offset: 8169},
+								pos: position{line: 367, col: 24, offset: 10299},
 								exprs: []interface{}{
 									&zeroOrOneExpr{
-										pos: position{line: 308, col: 24, offset: 8169},
+										pos: position{line: 367, col: 24, offset: 10299},
 										expr: &charClassMatcher{
-											pos:        position{line: 308, col: 24, offset: 8169},
+											pos:        position{line: 367, col: 24, offset: 10299},
 											val:        "[+-]",
 											chars:      []rune{'+', '-'},
 											ignoreCase: false,
@@ -13702,9 +13750,9 @@ var g = &grammar{
 										},
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 308, col: 30, offset: 8175},
+										pos: position{line: 367, col: 30, offset: 10305},
 										expr: &charClassMatcher{
-											pos:        position{line: 116, col: 9, offset: 2692},
+											pos:        position{line: 175, col: 9, offset: 4822},
 											val:        "[0-9]",
 											ranges:     []rune{'0', '9'},
 											ignoreCase: false,
@@ -13712,21 +13760,21 @@ var g = &grammar{
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 308, col: 39, offset: 8184},
+										pos: position{line: 367, col: 39, offset: 10314},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 308, col: 39, offset: 8184},
+												pos: position{line: 367, col: 39, offset: 10314},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 308, col: 39, offset: 8184},
+														pos:        position{line: 367, col: 39, offset: 10314},
 														val:        ".",
 														ignoreCase: false,
 														want:       "\".\"",
 													},
 													&oneOrMoreExpr{
-														pos: position{line: 308, col: 43, offset: 8188},
+														pos: position{line: 367, col: 43, offset: 10318},
 														expr: &charClassMatcher{
-															pos:        position{line: 116, col: 9, offset: 2692},
+															pos:        position{line: 175, col: 9, offset: 4822},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -13734,20 +13782,20 @@ var g = &grammar{
 														},
 													},
 													&zeroOrOneExpr{
-														pos: position{line: 308, col: 50, offset: 8195},
+														pos: position{line: 367, col: 50, offset: 10325},
 														expr: &seqExpr{
-															pos: position{line: 306, col: 12, offset: 8125},
+															pos: position{line: 365, col: 12, offset: 10255},
 															exprs: []interface{}{
 																&litMatcher{
-																	pos:        position{line: 306, col: 12, offset: 8125},
+																	pos:        position{line: 365, col: 12, offset: 10255},
 																	val:        "e",
 																	ignoreCase: true,
 																	want:       "\"e\"i",
 																},
 																&zeroOrOneExpr{
-																	pos: position{line: 306, col: 17, offset: 8130},
+																	pos: position{line: 365, col: 17, offset: 10260},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 306, col: 17, offset: 8130},
+																		pos:        position{line: 365, col: 17, offset: 10260},
 																		val:        "[+-]",
 																		chars:      []rune{'+', '-'},
 																		ignoreCase: false,
@@ -13755,9 +13803,9 @@ var g = &grammar{
 																	},
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 306, col: 23, offset: 8136},
+																	pos: position{line: 365, col: 23, offset: 10266},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 116, col: 9, offset: 2692},
+																		pos:        position{line: 175, col: 9, offset: 4822},
 																		val:        "[0-9]",
 																		ranges:     []rune{'0', '9'},
 																		ignoreCase: false,
@@ -13770,18 +13818,18 @@ var g = &grammar{
 												},
 											},
 											&seqExpr{
-												pos: position{line: 306, col: 12, offset: 8125},
+												pos: position{line: 365, col: 12, offset: 10255},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 306, col: 12, offset: 8125},
+														pos:        position{line: 365, col: 12, offset: 10255},
 														val:        "e",
 														ignoreCase: true,
 														want:       "\"e\"i",
 													},
 													&zeroOrOneExpr{
-														pos: position{line: 306, col: 17, offset: 8130},
+														pos: position{line: 365, col: 17, offset: 10260},
 														expr: &charClassMatcher{
-															pos:        position{line: 306, col: 17, offset: 8130},
+															pos:        position{line: 365, col: 17, offset: 10260},
 															val:        "[+-]",
 															chars:      []rune{'+', '-'},
 															ignoreCase: false,
@@ -13789,9 +13837,9 @@ var g = &grammar{
 														},
 													},
 													&oneOrMoreExpr{
-														pos: position{line: 306, col: 23, offset: 8136},
+														pos: position{line: 365, col: 23, offset: 10266},
 														expr: &charClassMatcher{
-															pos:        position{line: 116, col: 9, offset: 2692},
+															pos:        position{line: 175, col: 9, offset: 4822},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -13807,64 +13855,64 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 317, col: 5, offset: 8408},
+						pos: position{line: 376, col: 5, offset: 10538},
 						run: (*parser).callonPrimitiveExpression27,
 						expr: &litMatcher{
-							pos:        position{line: 317, col: 5, offset: 8408},
+							pos:        position{line: 376, col: 5, offset: 10538},
 							val:        "-Infinity",
 							ignoreCase: false,
 							want:       "\"-Infinity\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 318, col: 5, offset: 8465},
+						pos: position{line: 377, col: 5, offset: 10595},
 						run: (*parser).callonPrimitiveExpression29,
 						expr: &litMatcher{
-							pos:        position{line: 240, col: 12, offset: 6129},
+							pos:        position{line: 299, col: 12, offset: 8259},
 							val:        "Infinity",
 							ignoreCase: false,
 							want:       "\"Infinity\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 319, col: 5, offset: 8517},
+						pos: position{line: 378, col: 5, offset: 10647},
 						run: (*parser).callonPrimitiveExpression31,
 						expr: &litMatcher{
-							pos:        position{line: 241, col: 7, offset: 6148},
+							pos:        position{line: 300, col: 7, offset: 8278},
 							val:        "NaN",
 							ignoreCase: false,
 							want:       "\"NaN\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 323, col: 3, offset: 8618},
+						pos: position{line: 382, col: 3, offset: 10748},
 						run: (*parser).callonPrimitiveExpression33,
 						expr: &choiceExpr{
-							pos: position{line: 323, col: 4, offset: 8619},
+							pos: position{line: 382, col: 4, offset: 10749},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 323, col: 4, offset: 8619},
+									pos: position{line: 382, col: 4, offset: 10749},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 323, col: 4, offset: 8619},
+											pos:        position{line: 382, col: 4, offset: 10749},
 											val:        "0x",
 											ignoreCase: false,
 											want:       "\"0x\"",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 323, col: 9, offset: 8624},
+											pos: position{line: 382, col: 9, offset: 10754},
 											expr: &choiceExpr{
-												pos: position{line: 118, col: 10, offset: 2710},
+												pos: position{line: 177, col: 10, offset: 4840},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 116, col: 9, offset: 2692},
+														pos:        position{line: 175, col: 9, offset: 4822},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&charClassMatcher{
-														pos:        position{line: 118, col: 18, offset: 2718},
+														pos:        position{line: 177, col: 18, offset: 4848},
 														val:        "[a-f]i",
 														ranges:     []rune{'a', 'f'},
 														ignoreCase: true,
@@ -13876,19 +13924,19 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 323, col: 19, offset: 8634},
+									pos: position{line: 382, col: 19, offset: 10764},
 									exprs: []interface{}{
 										&charClassMatcher{
-											pos:        position{line: 323, col: 19, offset: 8634},
+											pos:        position{line: 382, col: 19, offset: 10764},
 											val:        "[1-9]",
 											ranges:     []rune{'1', '9'},
 											ignoreCase: false,
 											inverted:   false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 323, col: 25, offset: 8640},
+											pos: position{line: 382, col: 25, offset: 10770},
 											expr: &charClassMatcher{
-												pos:        position{line: 116, col: 9, offset: 2692},
+												pos:        position{line: 175, col: 9, offset: 4822},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -13901,21 +13949,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 328, col: 5, offset: 8776},
+						pos: position{line: 387, col: 5, offset: 10906},
 						run: (*parser).callonPrimitiveExpression45,
 						expr: &seqExpr{
-							pos: position{line: 328, col: 5, offset: 8776},
+							pos: position{line: 387, col: 5, offset: 10906},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 328, col: 5, offset: 8776},
+									pos:        position{line: 387, col: 5, offset: 10906},
 									val:        "0",
 									ignoreCase: false,
 									want:       "\"0\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 328, col: 9, offset: 8780},
+									pos: position{line: 387, col: 9, offset: 10910},
 									expr: &charClassMatcher{
-										pos:        position{line: 116, col: 9, offset: 2692},
+										pos:        position{line: 175, col: 9, offset: 4822},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -13926,62 +13974,62 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 329, col: 5, offset: 8865},
+						pos: position{line: 388, col: 5, offset: 10995},
 						run: (*parser).callonPrimitiveExpression50,
 						expr: &litMatcher{
-							pos:        position{line: 329, col: 5, offset: 8865},
+							pos:        position{line: 388, col: 5, offset: 10995},
 							val:        "0",
 							ignoreCase: false,
 							want:       "\"0\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 332, col: 5, offset: 8923},
+						pos: position{line: 391, col: 5, offset: 11053},
 						run: (*parser).callonPrimitiveExpression52,
 						expr: &seqExpr{
-							pos: position{line: 332, col: 5, offset: 8923},
+							pos: position{line: 391, col: 5, offset: 11053},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 332, col: 5, offset: 8923},
+									pos:        position{line: 391, col: 5, offset: 11053},
 									val:        "+",
 									ignoreCase: false,
 									want:       "\"+\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 332, col: 9, offset: 8927},
+									pos:   position{line: 391, col: 9, offset: 11057},
 									label: "n",
 									expr: &choiceExpr{
-										pos: position{line: 323, col: 3, offset: 8618},
+										pos: position{line: 382, col: 3, offset: 10748},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 323, col: 3, offset: 8618},
+												pos: position{line: 382, col: 3, offset: 10748},
 												run: (*parser).callonPrimitiveExpression57,
 												expr: &choiceExpr{
-													pos: position{line: 323, col: 4, offset: 8619},
+													pos: position{line: 382, col: 4, offset: 10749},
 													alternatives: []interface{}{
 														&seqExpr{
-															pos: position{line: 323, col: 4, offset: 8619},
+															pos: position{line: 382, col: 4, offset: 10749},
 															exprs: []interface{}{
 																&litMatcher{
-																	pos:        position{line: 323, col: 4, offset: 8619},
+																	pos:        position{line: 382, col: 4, offset: 10749},
 																	val:        "0x",
 																	ignoreCase: false,
 																	want:       "\"0x\"",
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 323, col: 9, offset: 8624},
+																	pos: position{line: 382, col: 9, offset: 10754},
 																	expr: &choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -13993,19 +14041,19 @@ var g = &grammar{
 															},
 														},
 														&seqExpr{
-															pos: position{line: 323, col: 19, offset: 8634},
+															pos: position{line: 382, col: 19, offset: 10764},
 															exprs: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 323, col: 19, offset: 8634},
+																	pos:        position{line: 382, col: 19, offset: 10764},
 																	val:        "[1-9]",
 																	ranges:     []rune{'1', '9'},
 																	ignoreCase: false,
 																	inverted:   false,
 																},
 																&zeroOrMoreExpr{
-																	pos: position{line: 323, col: 25, offset: 8640},
+																	pos: position{line: 382, col: 25, offset: 10770},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 116, col: 9, offset: 2692},
+																		pos:        position{line: 175, col: 9, offset: 4822},
 																		val:        "[0-9]",
 																		ranges:     []rune{'0', '9'},
 																		ignoreCase: false,
@@ -14018,21 +14066,21 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 328, col: 5, offset: 8776},
+												pos: position{line: 387, col: 5, offset: 10906},
 												run: (*parser).callonPrimitiveExpression69,
 												expr: &seqExpr{
-													pos: position{line: 328, col: 5, offset: 8776},
+													pos: position{line: 387, col: 5, offset: 10906},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 328, col: 5, offset: 8776},
+															pos:        position{line: 387, col: 5, offset: 10906},
 															val:        "0",
 															ignoreCase: false,
 															want:       "\"0\"",
 														},
 														&oneOrMoreExpr{
-															pos: position{line: 328, col: 9, offset: 8780},
+															pos: position{line: 387, col: 9, offset: 10910},
 															expr: &charClassMatcher{
-																pos:        position{line: 116, col: 9, offset: 2692},
+																pos:        position{line: 175, col: 9, offset: 4822},
 																val:        "[0-9]",
 																ranges:     []rune{'0', '9'},
 																ignoreCase: false,
@@ -14043,10 +14091,10 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 329, col: 5, offset: 8865},
+												pos: position{line: 388, col: 5, offset: 10995},
 												run: (*parser).callonPrimitiveExpression74,
 												expr: &litMatcher{
-													pos:        position{line: 329, col: 5, offset: 8865},
+													pos:        position{line: 388, col: 5, offset: 10995},
 													val:        "0",
 													ignoreCase: false,
 													want:       "\"0\"",
@@ -14059,52 +14107,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 333, col: 5, offset: 8991},
+						pos: position{line: 392, col: 5, offset: 11121},
 						run: (*parser).callonPrimitiveExpression76,
 						expr: &seqExpr{
-							pos: position{line: 333, col: 5, offset: 8991},
+							pos: position{line: 392, col: 5, offset: 11121},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 333, col: 5, offset: 8991},
+									pos:        position{line: 392, col: 5, offset: 11121},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 333, col: 9, offset: 8995},
+									pos:   position{line: 392, col: 9, offset: 11125},
 									label: "n",
 									expr: &choiceExpr{
-										pos: position{line: 323, col: 3, offset: 8618},
+										pos: position{line: 382, col: 3, offset: 10748},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 323, col: 3, offset: 8618},
+												pos: position{line: 382, col: 3, offset: 10748},
 												run: (*parser).callonPrimitiveExpression81,
 												expr: &choiceExpr{
-													pos: position{line: 323, col: 4, offset: 8619},
+													pos: position{line: 382, col: 4, offset: 10749},
 													alternatives: []interface{}{
 														&seqExpr{
-															pos: position{line: 323, col: 4, offset: 8619},
+															pos: position{line: 382, col: 4, offset: 10749},
 															exprs: []interface{}{
 																&litMatcher{
-																	pos:        position{line: 323, col: 4, offset: 8619},
+																	pos:        position{line: 382, col: 4, offset: 10749},
 																	val:        "0x",
 																	ignoreCase: false,
 																	want:       "\"0x\"",
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 323, col: 9, offset: 8624},
+																	pos: position{line: 382, col: 9, offset: 10754},
 																	expr: &choiceExpr{
-																		pos: position{line: 118, col: 10, offset: 2710},
+																		pos: position{line: 177, col: 10, offset: 4840},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 116, col: 9, offset: 2692},
+																				pos:        position{line: 175, col: 9, offset: 4822},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 118, col: 18, offset: 2718},
+																				pos:        position{line: 177, col: 18, offset: 4848},
 																				val:        "[a-f]i",
 																				ranges:     []rune{'a', 'f'},
 																				ignoreCase: true,
@@ -14116,19 +14164,19 @@ var g = &grammar{
 															},
 														},
 														&seqExpr{
-															pos: position{line: 323, col: 19, offset: 8634},
+															pos: position{line: 382, col: 19, offset: 10764},
 															exprs: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 323, col: 19, offset: 8634},
+																	pos:        position{line: 382, col: 19, offset: 10764},
 																	val:        "[1-9]",
 																	ranges:     []rune{'1', '9'},
 																	ignoreCase: false,
 																	inverted:   false,
 																},
 																&zeroOrMoreExpr{
-																	pos: position{line: 323, col: 25, offset: 8640},
+																	pos: position{line: 382, col: 25, offset: 10770},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 116, col: 9, offset: 2692},
+																		pos:        position{line: 175, col: 9, offset: 4822},
 																		val:        "[0-9]",
 																		ranges:     []rune{'0', '9'},
 																		ignoreCase: false,
@@ -14141,21 +14189,21 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 328, col: 5, offset: 8776},
+												pos: position{line: 387, col: 5, offset: 10906},
 												run: (*parser).callonPrimitiveExpression93,
 												expr: &seqExpr{
-													pos: position{line: 328, col: 5, offset: 8776},
+													pos: position{line: 387, col: 5, offset: 10906},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 328, col: 5, offset: 8776},
+															pos:        position{line: 387, col: 5, offset: 10906},
 															val:        "0",
 															ignoreCase: false,
 															want:       "\"0\"",
 														},
 														&oneOrMoreExpr{
-															pos: position{line: 328, col: 9, offset: 8780},
+															pos: position{line: 387, col: 9, offset: 10910},
 															expr: &charClassMatcher{
-																pos:        position{line: 116, col: 9, offset: 2692},
+																pos:        position{line: 175, col: 9, offset: 4822},
 																val:        "[0-9]",
 																ranges:     []rune{'0', '9'},
 																ignoreCase: false,
@@ -14166,10 +14214,10 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 329, col: 5, offset: 8865},
+												pos: position{line: 388, col: 5, offset: 10995},
 												run: (*parser).callonPrimitiveExpression98,
 												expr: &litMatcher{
-													pos:        position{line: 329, col: 5, offset: 8865},
+													pos:        position{line: 388, col: 5, offset: 10995},
 													val:        "0",
 													ignoreCase: false,
 													want:       "\"0\"",
@@ -14182,57 +14230,57 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 669, col: 7, offset: 20962},
+						pos:  position{line: 733, col: 7, offset: 23381},
 						name: "TextLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 670, col: 7, offset: 20980},
+						pos: position{line: 734, col: 7, offset: 23399},
 						run: (*parser).callonPrimitiveExpression101,
 						expr: &seqExpr{
-							pos: position{line: 670, col: 7, offset: 20980},
+							pos: position{line: 734, col: 7, offset: 23399},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 670, col: 7, offset: 20980},
+									pos:        position{line: 734, col: 7, offset: 23399},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 670, col: 11, offset: 20984},
+									pos:  position{line: 734, col: 11, offset: 23403},
 									name: "_",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 670, col: 13, offset: 20986},
+									pos: position{line: 734, col: 13, offset: 23405},
 									expr: &seqExpr{
-										pos: position{line: 670, col: 14, offset: 20987},
+										pos: position{line: 734, col: 14, offset: 23406},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 670, col: 14, offset: 20987},
+												pos:        position{line: 734, col: 14, offset: 23406},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 670, col: 18, offset: 20991},
+												pos:  position{line: 734, col: 18, offset: 23410},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 670, col: 22, offset: 20995},
+									pos:   position{line: 734, col: 22, offset: 23414},
 									label: "r",
 									expr: &ruleRefExpr{
-										pos:  position{line: 670, col: 24, offset: 20997},
+										pos:  position{line: 734, col: 24, offset: 23416},
 										name: "RecordTypeOrLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 670, col: 44, offset: 21017},
+									pos:  position{line: 734, col: 44, offset: 23436},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 670, col: 46, offset: 21019},
+									pos:        position{line: 734, col: 46, offset: 23438},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -14241,53 +14289,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 671, col: 7, offset: 21047},
+						pos: position{line: 735, col: 7, offset: 23466},
 						run: (*parser).callonPrimitiveExpression113,
 						expr: &seqExpr{
-							pos: position{line: 671, col: 7, offset: 21047},
+							pos: position{line: 735, col: 7, offset: 23466},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 671, col: 7, offset: 21047},
+									pos:        position{line: 735, col: 7, offset: 23466},
 									val:        "<",
 									ignoreCase: false,
 									want:       "\"<\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 671, col: 11, offset: 21051},
+									pos:  position{line: 735, col: 11, offset: 23470},
 									name: "_",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 671, col: 13, offset: 21053},
+									pos: position{line: 735, col: 13, offset: 23472},
 									expr: &seqExpr{
-										pos: position{line: 671, col: 14, offset: 21054},
+										pos: position{line: 735, col: 14, offset: 23473},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 671, col: 14, offset: 21054},
+												pos:        position{line: 735, col: 14, offset: 23473},
 												val:        "|",
 												ignoreCase: false,
 												want:       "\"|\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 671, col: 18, offset: 21058},
+												pos:  position{line: 735, col: 18, offset: 23477},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 671, col: 22, offset: 21062},
+									pos:   position{line: 735, col: 22, offset: 23481},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 671, col: 24, offset: 21064},
+										pos:  position{line: 735, col: 24, offset: 23483},
 										name: "UnionType",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 671, col: 34, offset: 21074},
+									pos:  position{line: 735, col: 34, offset: 23493},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 671, col: 36, offset: 21076},
+									pos:        position{line: 735, col: 36, offset: 23495},
 									val:        ">",
 									ignoreCase: false,
 									want:       "\">\"",
@@ -14296,61 +14344,61 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 672, col: 7, offset: 21104},
+						pos:  position{line: 736, col: 7, offset: 23523},
 						name: "NonEmptyListLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 673, col: 7, offset: 21130},
+						pos:  position{line: 737, col: 7, offset: 23549},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 674, col: 7, offset: 21147},
+						pos: position{line: 738, col: 7, offset: 23566},
 						run: (*parser).callonPrimitiveExpression127,
 						expr: &seqExpr{
-							pos: position{line: 674, col: 7, offset: 21147},
+							pos: position{line: 738, col: 7, offset: 23566},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 674, col: 7, offset: 21147},
+									pos:        position{line: 738, col: 7, offset: 23566},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 674, col: 11, offset: 21151},
+									pos:  position{line: 738, col: 11, offset: 23570},
 									name: "_",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 674, col: 13, offset: 21153},
+									pos: position{line: 738, col: 13, offset: 23572},
 									expr: &seqExpr{
-										pos: position{line: 674, col: 14, offset: 21154},
+										pos: position{line: 738, col: 14, offset: 23573},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 674, col: 14, offset: 21154},
+												pos:        position{line: 738, col: 14, offset: 23573},
 												val:        "|",
 												ignoreCase: false,
 												want:       "\"|\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 674, col: 18, offset: 21158},
+												pos:  position{line: 738, col: 18, offset: 23577},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 674, col: 22, offset: 21162},
+									pos:   position{line: 738, col: 22, offset: 23581},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 674, col: 24, offset: 21164},
+										pos:  position{line: 738, col: 24, offset: 23583},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 674, col: 35, offset: 21175},
+									pos:  position{line: 738, col: 35, offset: 23594},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 674, col: 37, offset: 21177},
+									pos:        position{line: 738, col: 37, offset: 23596},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -14363,27 +14411,27 @@ var g = &grammar{
 		},
 		{
 			name: "RecordTypeOrLiteral",
-			pos:  position{line: 676, col: 1, offset: 21200},
+			pos:  position{line: 740, col: 1, offset: 23619},
 			expr: &choiceExpr{
-				pos: position{line: 677, col: 7, offset: 21230},
+				pos: position{line: 741, col: 7, offset: 23649},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 677, col: 7, offset: 21230},
+						pos:  position{line: 741, col: 7, offset: 23649},
 						name: "EmptyRecordLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 678, col: 7, offset: 21255},
+						pos:  position{line: 742, col: 7, offset: 23674},
 						name: "NonEmptyRecordType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 679, col: 7, offset: 21280},
+						pos:  position{line: 743, col: 7, offset: 23699},
 						name: "NonEmptyRecordLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 680, col: 7, offset: 21308},
+						pos: position{line: 744, col: 7, offset: 23727},
 						run: (*parser).callonRecordTypeOrLiteral5,
 						expr: &litMatcher{
-							pos:        position{line: 680, col: 7, offset: 21308},
+							pos:        position{line: 744, col: 7, offset: 23727},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14394,30 +14442,30 @@ var g = &grammar{
 		},
 		{
 			name: "EmptyRecordLiteral",
-			pos:  position{line: 682, col: 1, offset: 21341},
+			pos:  position{line: 746, col: 1, offset: 23760},
 			expr: &actionExpr{
-				pos: position{line: 682, col: 22, offset: 21364},
+				pos: position{line: 746, col: 22, offset: 23783},
 				run: (*parser).callonEmptyRecordLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 682, col: 22, offset: 21364},
+					pos: position{line: 746, col: 22, offset: 23783},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 682, col: 22, offset: 21364},
+							pos:        position{line: 746, col: 22, offset: 23783},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 682, col: 26, offset: 21368},
+							pos: position{line: 746, col: 26, offset: 23787},
 							expr: &seqExpr{
-								pos: position{line: 682, col: 28, offset: 21370},
+								pos: position{line: 746, col: 28, offset: 23789},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 682, col: 28, offset: 21370},
+										pos:  position{line: 746, col: 28, offset: 23789},
 										name: "_",
 									},
 									&litMatcher{
-										pos:        position{line: 682, col: 30, offset: 21372},
+										pos:        position{line: 746, col: 30, offset: 23791},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
@@ -14431,32 +14479,32 @@ var g = &grammar{
 		},
 		{
 			name: "MoreRecordType",
-			pos:  position{line: 684, col: 1, offset: 21408},
+			pos:  position{line: 748, col: 1, offset: 23827},
 			expr: &actionExpr{
-				pos: position{line: 684, col: 18, offset: 21427},
+				pos: position{line: 748, col: 18, offset: 23846},
 				run: (*parser).callonMoreRecordType1,
 				expr: &seqExpr{
-					pos: position{line: 684, col: 18, offset: 21427},
+					pos: position{line: 748, col: 18, offset: 23846},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 684, col: 18, offset: 21427},
+							pos:  position{line: 748, col: 18, offset: 23846},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 684, col: 20, offset: 21429},
+							pos:        position{line: 748, col: 20, offset: 23848},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 684, col: 24, offset: 21433},
+							pos:  position{line: 748, col: 24, offset: 23852},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 684, col: 26, offset: 21435},
+							pos:   position{line: 748, col: 26, offset: 23854},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 684, col: 28, offset: 21437},
+								pos:  position{line: 748, col: 28, offset: 23856},
 								name: "RecordTypeEntry",
 							},
 						},
@@ -14466,45 +14514,45 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyRecordType",
-			pos:  position{line: 685, col: 1, offset: 21469},
+			pos:  position{line: 749, col: 1, offset: 23888},
 			expr: &actionExpr{
-				pos: position{line: 686, col: 7, offset: 21498},
+				pos: position{line: 750, col: 7, offset: 23917},
 				run: (*parser).callonNonEmptyRecordType1,
 				expr: &seqExpr{
-					pos: position{line: 686, col: 7, offset: 21498},
+					pos: position{line: 750, col: 7, offset: 23917},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 686, col: 7, offset: 21498},
+							pos:   position{line: 750, col: 7, offset: 23917},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 686, col: 13, offset: 21504},
+								pos:  position{line: 750, col: 13, offset: 23923},
 								name: "RecordTypeEntry",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 686, col: 29, offset: 21520},
+							pos:   position{line: 750, col: 29, offset: 23939},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 686, col: 34, offset: 21525},
+								pos: position{line: 750, col: 34, offset: 23944},
 								expr: &ruleRefExpr{
-									pos:  position{line: 686, col: 34, offset: 21525},
+									pos:  position{line: 750, col: 34, offset: 23944},
 									name: "MoreRecordType",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 686, col: 50, offset: 21541},
+							pos: position{line: 750, col: 50, offset: 23960},
 							expr: &seqExpr{
-								pos: position{line: 686, col: 51, offset: 21542},
+								pos: position{line: 750, col: 51, offset: 23961},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 686, col: 51, offset: 21542},
+										pos:        position{line: 750, col: 51, offset: 23961},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 686, col: 55, offset: 21546},
+										pos:  position{line: 750, col: 55, offset: 23965},
 										name: "_",
 									},
 								},
@@ -14516,41 +14564,41 @@ var g = &grammar{
 		},
 		{
 			name: "RecordTypeEntry",
-			pos:  position{line: 700, col: 1, offset: 21974},
+			pos:  position{line: 772, col: 1, offset: 24779},
 			expr: &actionExpr{
-				pos: position{line: 700, col: 19, offset: 21994},
+				pos: position{line: 772, col: 19, offset: 24799},
 				run: (*parser).callonRecordTypeEntry1,
 				expr: &seqExpr{
-					pos: position{line: 700, col: 19, offset: 21994},
+					pos: position{line: 772, col: 19, offset: 24799},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 700, col: 19, offset: 21994},
+							pos:   position{line: 772, col: 19, offset: 24799},
 							label: "name",
 							expr: &choiceExpr{
-								pos: position{line: 139, col: 18, offset: 3403},
+								pos: position{line: 198, col: 18, offset: 5533},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 131, col: 9, offset: 3119},
+										pos: position{line: 190, col: 9, offset: 5249},
 										run: (*parser).callonRecordTypeEntry5,
 										expr: &seqExpr{
-											pos: position{line: 131, col: 9, offset: 3119},
+											pos: position{line: 190, col: 9, offset: 5249},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 131, col: 9, offset: 3119},
+													pos:        position{line: 190, col: 9, offset: 5249},
 													val:        "`",
 													ignoreCase: false,
 													want:       "\"`\"",
 												},
 												&labeledExpr{
-													pos:   position{line: 131, col: 13, offset: 3123},
+													pos:   position{line: 190, col: 13, offset: 5253},
 													label: "label",
 													expr: &actionExpr{
-														pos: position{line: 129, col: 15, offset: 3060},
+														pos: position{line: 188, col: 15, offset: 5190},
 														run: (*parser).callonRecordTypeEntry9,
 														expr: &zeroOrMoreExpr{
-															pos: position{line: 129, col: 15, offset: 3060},
+															pos: position{line: 188, col: 15, offset: 5190},
 															expr: &charClassMatcher{
-																pos:        position{line: 128, col: 19, offset: 3023},
+																pos:        position{line: 187, col: 19, offset: 5153},
 																val:        "[ -_a-~]",
 																ranges:     []rune{' ', '_', 'a', '~'},
 																ignoreCase: false,
@@ -14560,7 +14608,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 131, col: 31, offset: 3141},
+													pos:        position{line: 190, col: 31, offset: 5271},
 													val:        "`",
 													ignoreCase: false,
 													want:       "\"`\"",
@@ -14569,75 +14617,75 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 132, col: 9, offset: 3175},
+										pos: position{line: 191, col: 9, offset: 5305},
 										run: (*parser).callonRecordTypeEntry13,
 										expr: &labeledExpr{
-											pos:   position{line: 132, col: 9, offset: 3175},
+											pos:   position{line: 191, col: 9, offset: 5305},
 											label: "label",
 											expr: &choiceExpr{
-												pos: position{line: 122, col: 15, offset: 2816},
+												pos: position{line: 181, col: 15, offset: 4946},
 												alternatives: []interface{}{
 													&actionExpr{
-														pos: position{line: 122, col: 15, offset: 2816},
+														pos: position{line: 181, col: 15, offset: 4946},
 														run: (*parser).callonRecordTypeEntry16,
 														expr: &seqExpr{
-															pos: position{line: 122, col: 15, offset: 2816},
+															pos: position{line: 181, col: 15, offset: 4946},
 															exprs: []interface{}{
 																&choiceExpr{
-																	pos: position{line: 249, col: 5, offset: 6269},
+																	pos: position{line: 308, col: 5, offset: 8399},
 																	alternatives: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 231, col: 6, offset: 5936},
+																			pos:        position{line: 290, col: 6, offset: 8066},
 																			val:        "if",
 																			ignoreCase: false,
 																			want:       "\"if\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 232, col: 8, offset: 5950},
+																			pos:        position{line: 291, col: 8, offset: 8080},
 																			val:        "then",
 																			ignoreCase: false,
 																			want:       "\"then\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 233, col: 8, offset: 5966},
+																			pos:        position{line: 292, col: 8, offset: 8096},
 																			val:        "else",
 																			ignoreCase: false,
 																			want:       "\"else\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 234, col: 7, offset: 5981},
+																			pos:        position{line: 293, col: 7, offset: 8111},
 																			val:        "let",
 																			ignoreCase: false,
 																			want:       "\"let\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 235, col: 6, offset: 5994},
+																			pos:        position{line: 294, col: 6, offset: 8124},
 																			val:        "in",
 																			ignoreCase: false,
 																			want:       "\"in\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 237, col: 9, offset: 6021},
+																			pos:        position{line: 296, col: 9, offset: 8151},
 																			val:        "using",
 																			ignoreCase: false,
 																			want:       "\"using\"",
 																		},
 																		&actionExpr{
-																			pos: position{line: 239, col: 11, offset: 6059},
+																			pos: position{line: 298, col: 11, offset: 8189},
 																			run: (*parser).callonRecordTypeEntry25,
 																			expr: &seqExpr{
-																				pos: position{line: 239, col: 11, offset: 6059},
+																				pos: position{line: 298, col: 11, offset: 8189},
 																				exprs: []interface{}{
 																					&litMatcher{
-																						pos:        position{line: 239, col: 11, offset: 6059},
+																						pos:        position{line: 298, col: 11, offset: 8189},
 																						val:        "missing",
 																						ignoreCase: false,
 																						want:       "\"missing\"",
 																					},
 																					&notExpr{
-																						pos: position{line: 239, col: 21, offset: 6069},
+																						pos: position{line: 298, col: 21, offset: 8199},
 																						expr: &charClassMatcher{
-																							pos:        position{line: 121, col: 23, offset: 2785},
+																							pos:        position{line: 180, col: 23, offset: 4915},
 																							val:        "[_/-A-Za-z0-9]",
 																							chars:      []rune{'_', '/', '-'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -14649,61 +14697,61 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 244, col: 10, offset: 6199},
+																			pos:        position{line: 303, col: 10, offset: 8329},
 																			val:        "assert",
 																			ignoreCase: false,
 																			want:       "\"assert\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 236, col: 6, offset: 6006},
+																			pos:        position{line: 295, col: 6, offset: 8136},
 																			val:        "as",
 																			ignoreCase: false,
 																			want:       "\"as\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 240, col: 12, offset: 6129},
+																			pos:        position{line: 299, col: 12, offset: 8259},
 																			val:        "Infinity",
 																			ignoreCase: false,
 																			want:       "\"Infinity\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 241, col: 7, offset: 6148},
+																			pos:        position{line: 300, col: 7, offset: 8278},
 																			val:        "NaN",
 																			ignoreCase: false,
 																			want:       "\"NaN\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 238, col: 9, offset: 6039},
+																			pos:        position{line: 297, col: 9, offset: 8169},
 																			val:        "merge",
 																			ignoreCase: false,
 																			want:       "\"merge\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 242, col: 8, offset: 6163},
+																			pos:        position{line: 301, col: 8, offset: 8293},
 																			val:        "Some",
 																			ignoreCase: false,
 																			want:       "\"Some\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 243, col: 9, offset: 6180},
+																			pos:        position{line: 302, col: 9, offset: 8310},
 																			val:        "toMap",
 																			ignoreCase: false,
 																			want:       "\"toMap\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 245, col: 10, offset: 6219},
+																			pos:        position{line: 304, col: 10, offset: 8349},
 																			val:        "forall",
 																			ignoreCase: false,
 																			want:       "\"forall\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 245, col: 21, offset: 6230},
+																			pos:        position{line: 304, col: 21, offset: 8360},
 																			val:        "∀",
 																			ignoreCase: false,
 																			want:       "\"∀\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 246, col: 8, offset: 6245},
+																			pos:        position{line: 305, col: 8, offset: 8375},
 																			val:        "with",
 																			ignoreCase: false,
 																			want:       "\"with\"",
@@ -14711,9 +14759,9 @@ var g = &grammar{
 																	},
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 122, col: 23, offset: 2824},
+																	pos: position{line: 181, col: 23, offset: 4954},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -14725,68 +14773,68 @@ var g = &grammar{
 														},
 													},
 													&actionExpr{
-														pos: position{line: 123, col: 13, offset: 2888},
+														pos: position{line: 182, col: 13, offset: 5018},
 														run: (*parser).callonRecordTypeEntry42,
 														expr: &seqExpr{
-															pos: position{line: 123, col: 13, offset: 2888},
+															pos: position{line: 182, col: 13, offset: 5018},
 															exprs: []interface{}{
 																&notExpr{
-																	pos: position{line: 123, col: 13, offset: 2888},
+																	pos: position{line: 182, col: 13, offset: 5018},
 																	expr: &choiceExpr{
-																		pos: position{line: 249, col: 5, offset: 6269},
+																		pos: position{line: 308, col: 5, offset: 8399},
 																		alternatives: []interface{}{
 																			&litMatcher{
-																				pos:        position{line: 231, col: 6, offset: 5936},
+																				pos:        position{line: 290, col: 6, offset: 8066},
 																				val:        "if",
 																				ignoreCase: false,
 																				want:       "\"if\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 232, col: 8, offset: 5950},
+																				pos:        position{line: 291, col: 8, offset: 8080},
 																				val:        "then",
 																				ignoreCase: false,
 																				want:       "\"then\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 233, col: 8, offset: 5966},
+																				pos:        position{line: 292, col: 8, offset: 8096},
 																				val:        "else",
 																				ignoreCase: false,
 																				want:       "\"else\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 234, col: 7, offset: 5981},
+																				pos:        position{line: 293, col: 7, offset: 8111},
 																				val:        "let",
 																				ignoreCase: false,
 																				want:       "\"let\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 235, col: 6, offset: 5994},
+																				pos:        position{line: 294, col: 6, offset: 8124},
 																				val:        "in",
 																				ignoreCase: false,
 																				want:       "\"in\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 237, col: 9, offset: 6021},
+																				pos:        position{line: 296, col: 9, offset: 8151},
 																				val:        "using",
 																				ignoreCase: false,
 																				want:       "\"using\"",
 																			},
 																			&actionExpr{
-																				pos: position{line: 239, col: 11, offset: 6059},
+																				pos: position{line: 298, col: 11, offset: 8189},
 																				run: (*parser).callonRecordTypeEntry52,
 																				expr: &seqExpr{
-																					pos: position{line: 239, col: 11, offset: 6059},
+																					pos: position{line: 298, col: 11, offset: 8189},
 																					exprs: []interface{}{
 																						&litMatcher{
-																							pos:        position{line: 239, col: 11, offset: 6059},
+																							pos:        position{line: 298, col: 11, offset: 8189},
 																							val:        "missing",
 																							ignoreCase: false,
 																							want:       "\"missing\"",
 																						},
 																						&notExpr{
-																							pos: position{line: 239, col: 21, offset: 6069},
+																							pos: position{line: 298, col: 21, offset: 8199},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -14798,61 +14846,61 @@ var g = &grammar{
 																				},
 																			},
 																			&litMatcher{
-																				pos:        position{line: 244, col: 10, offset: 6199},
+																				pos:        position{line: 303, col: 10, offset: 8329},
 																				val:        "assert",
 																				ignoreCase: false,
 																				want:       "\"assert\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 236, col: 6, offset: 6006},
+																				pos:        position{line: 295, col: 6, offset: 8136},
 																				val:        "as",
 																				ignoreCase: false,
 																				want:       "\"as\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 240, col: 12, offset: 6129},
+																				pos:        position{line: 299, col: 12, offset: 8259},
 																				val:        "Infinity",
 																				ignoreCase: false,
 																				want:       "\"Infinity\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 241, col: 7, offset: 6148},
+																				pos:        position{line: 300, col: 7, offset: 8278},
 																				val:        "NaN",
 																				ignoreCase: false,
 																				want:       "\"NaN\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 238, col: 9, offset: 6039},
+																				pos:        position{line: 297, col: 9, offset: 8169},
 																				val:        "merge",
 																				ignoreCase: false,
 																				want:       "\"merge\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 242, col: 8, offset: 6163},
+																				pos:        position{line: 301, col: 8, offset: 8293},
 																				val:        "Some",
 																				ignoreCase: false,
 																				want:       "\"Some\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 243, col: 9, offset: 6180},
+																				pos:        position{line: 302, col: 9, offset: 8310},
 																				val:        "toMap",
 																				ignoreCase: false,
 																				want:       "\"toMap\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 245, col: 10, offset: 6219},
+																				pos:        position{line: 304, col: 10, offset: 8349},
 																				val:        "forall",
 																				ignoreCase: false,
 																				want:       "\"forall\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 245, col: 21, offset: 6230},
+																				pos:        position{line: 304, col: 21, offset: 8360},
 																				val:        "∀",
 																				ignoreCase: false,
 																				want:       "\"∀\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 246, col: 8, offset: 6245},
+																				pos:        position{line: 305, col: 8, offset: 8375},
 																				val:        "with",
 																				ignoreCase: false,
 																				want:       "\"with\"",
@@ -14861,7 +14909,7 @@ var g = &grammar{
 																	},
 																},
 																&charClassMatcher{
-																	pos:        position{line: 120, col: 24, offset: 2751},
+																	pos:        position{line: 179, col: 24, offset: 4881},
 																	val:        "[_A-Za-z]",
 																	chars:      []rune{'_'},
 																	ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -14869,9 +14917,9 @@ var g = &grammar{
 																	inverted:   false,
 																},
 																&zeroOrMoreExpr{
-																	pos: position{line: 123, col: 43, offset: 2918},
+																	pos: position{line: 182, col: 43, offset: 5048},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -14887,10 +14935,10 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 139, col: 29, offset: 3414},
+										pos: position{line: 198, col: 29, offset: 5544},
 										run: (*parser).callonRecordTypeEntry70,
 										expr: &litMatcher{
-											pos:        position{line: 242, col: 8, offset: 6163},
+											pos:        position{line: 301, col: 8, offset: 8293},
 											val:        "Some",
 											ignoreCase: false,
 											want:       "\"Some\"",
@@ -14900,24 +14948,24 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 700, col: 39, offset: 22014},
+							pos:  position{line: 772, col: 39, offset: 24819},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 700, col: 41, offset: 22016},
+							pos:        position{line: 772, col: 41, offset: 24821},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 700, col: 45, offset: 22020},
+							pos:  position{line: 772, col: 45, offset: 24825},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 700, col: 48, offset: 22023},
+							pos:   position{line: 772, col: 48, offset: 24828},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 700, col: 53, offset: 22028},
+								pos:  position{line: 772, col: 53, offset: 24833},
 								name: "Expression",
 							},
 						},
@@ -14927,32 +14975,32 @@ var g = &grammar{
 		},
 		{
 			name: "MoreRecordLiteral",
-			pos:  position{line: 704, col: 1, offset: 22099},
+			pos:  position{line: 776, col: 1, offset: 24930},
 			expr: &actionExpr{
-				pos: position{line: 704, col: 21, offset: 22121},
+				pos: position{line: 776, col: 21, offset: 24952},
 				run: (*parser).callonMoreRecordLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 704, col: 21, offset: 22121},
+					pos: position{line: 776, col: 21, offset: 24952},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 704, col: 21, offset: 22121},
+							pos:  position{line: 776, col: 21, offset: 24952},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 704, col: 23, offset: 22123},
+							pos:        position{line: 776, col: 23, offset: 24954},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 704, col: 27, offset: 22127},
+							pos:  position{line: 776, col: 27, offset: 24958},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 704, col: 29, offset: 22129},
+							pos:   position{line: 776, col: 29, offset: 24960},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 704, col: 31, offset: 22131},
+								pos:  position{line: 776, col: 31, offset: 24962},
 								name: "RecordLiteralEntry",
 							},
 						},
@@ -14962,45 +15010,45 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyRecordLiteral",
-			pos:  position{line: 705, col: 1, offset: 22166},
+			pos:  position{line: 777, col: 1, offset: 24997},
 			expr: &actionExpr{
-				pos: position{line: 706, col: 7, offset: 22198},
+				pos: position{line: 778, col: 7, offset: 25029},
 				run: (*parser).callonNonEmptyRecordLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 706, col: 7, offset: 22198},
+					pos: position{line: 778, col: 7, offset: 25029},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 706, col: 7, offset: 22198},
+							pos:   position{line: 778, col: 7, offset: 25029},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 706, col: 13, offset: 22204},
+								pos:  position{line: 778, col: 13, offset: 25035},
 								name: "RecordLiteralEntry",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 706, col: 32, offset: 22223},
+							pos:   position{line: 778, col: 32, offset: 25054},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 706, col: 37, offset: 22228},
+								pos: position{line: 778, col: 37, offset: 25059},
 								expr: &ruleRefExpr{
-									pos:  position{line: 706, col: 37, offset: 22228},
+									pos:  position{line: 778, col: 37, offset: 25059},
 									name: "MoreRecordLiteral",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 706, col: 56, offset: 22247},
+							pos: position{line: 778, col: 56, offset: 25078},
 							expr: &seqExpr{
-								pos: position{line: 706, col: 57, offset: 22248},
+								pos: position{line: 778, col: 57, offset: 25079},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 706, col: 57, offset: 22248},
+										pos:        position{line: 778, col: 57, offset: 25079},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 706, col: 61, offset: 22252},
+										pos:  position{line: 778, col: 61, offset: 25083},
 										name: "_",
 									},
 								},
@@ -15012,41 +15060,41 @@ var g = &grammar{
 		},
 		{
 			name: "RecordLiteralEntry",
-			pos:  position{line: 725, col: 1, offset: 22814},
+			pos:  position{line: 807, col: 1, offset: 26075},
 			expr: &actionExpr{
-				pos: position{line: 725, col: 22, offset: 22837},
+				pos: position{line: 807, col: 22, offset: 26098},
 				run: (*parser).callonRecordLiteralEntry1,
 				expr: &seqExpr{
-					pos: position{line: 725, col: 22, offset: 22837},
+					pos: position{line: 807, col: 22, offset: 26098},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 725, col: 22, offset: 22837},
+							pos:   position{line: 807, col: 22, offset: 26098},
 							label: "name",
 							expr: &choiceExpr{
-								pos: position{line: 139, col: 18, offset: 3403},
+								pos: position{line: 198, col: 18, offset: 5533},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 131, col: 9, offset: 3119},
+										pos: position{line: 190, col: 9, offset: 5249},
 										run: (*parser).callonRecordLiteralEntry5,
 										expr: &seqExpr{
-											pos: position{line: 131, col: 9, offset: 3119},
+											pos: position{line: 190, col: 9, offset: 5249},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 131, col: 9, offset: 3119},
+													pos:        position{line: 190, col: 9, offset: 5249},
 													val:        "`",
 													ignoreCase: false,
 													want:       "\"`\"",
 												},
 												&labeledExpr{
-													pos:   position{line: 131, col: 13, offset: 3123},
+													pos:   position{line: 190, col: 13, offset: 5253},
 													label: "label",
 													expr: &actionExpr{
-														pos: position{line: 129, col: 15, offset: 3060},
+														pos: position{line: 188, col: 15, offset: 5190},
 														run: (*parser).callonRecordLiteralEntry9,
 														expr: &zeroOrMoreExpr{
-															pos: position{line: 129, col: 15, offset: 3060},
+															pos: position{line: 188, col: 15, offset: 5190},
 															expr: &charClassMatcher{
-																pos:        position{line: 128, col: 19, offset: 3023},
+																pos:        position{line: 187, col: 19, offset: 5153},
 																val:        "[ -_a-~]",
 																ranges:     []rune{' ', '_', 'a', '~'},
 																ignoreCase: false,
@@ -15056,7 +15104,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 131, col: 31, offset: 3141},
+													pos:        position{line: 190, col: 31, offset: 5271},
 													val:        "`",
 													ignoreCase: false,
 													want:       "\"`\"",
@@ -15065,75 +15113,75 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 132, col: 9, offset: 3175},
+										pos: position{line: 191, col: 9, offset: 5305},
 										run: (*parser).callonRecordLiteralEntry13,
 										expr: &labeledExpr{
-											pos:   position{line: 132, col: 9, offset: 3175},
+											pos:   position{line: 191, col: 9, offset: 5305},
 											label: "label",
 											expr: &choiceExpr{
-												pos: position{line: 122, col: 15, offset: 2816},
+												pos: position{line: 181, col: 15, offset: 4946},
 												alternatives: []interface{}{
 													&actionExpr{
-														pos: position{line: 122, col: 15, offset: 2816},
+														pos: position{line: 181, col: 15, offset: 4946},
 														run: (*parser).callonRecordLiteralEntry16,
 														expr: &seqExpr{
-															pos: position{line: 122, col: 15, offset: 2816},
+															pos: position{line: 181, col: 15, offset: 4946},
 															exprs: []interface{}{
 																&choiceExpr{
-																	pos: position{line: 249, col: 5, offset: 6269},
+																	pos: position{line: 308, col: 5, offset: 8399},
 																	alternatives: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 231, col: 6, offset: 5936},
+																			pos:        position{line: 290, col: 6, offset: 8066},
 																			val:        "if",
 																			ignoreCase: false,
 																			want:       "\"if\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 232, col: 8, offset: 5950},
+																			pos:        position{line: 291, col: 8, offset: 8080},
 																			val:        "then",
 																			ignoreCase: false,
 																			want:       "\"then\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 233, col: 8, offset: 5966},
+																			pos:        position{line: 292, col: 8, offset: 8096},
 																			val:        "else",
 																			ignoreCase: false,
 																			want:       "\"else\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 234, col: 7, offset: 5981},
+																			pos:        position{line: 293, col: 7, offset: 8111},
 																			val:        "let",
 																			ignoreCase: false,
 																			want:       "\"let\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 235, col: 6, offset: 5994},
+																			pos:        position{line: 294, col: 6, offset: 8124},
 																			val:        "in",
 																			ignoreCase: false,
 																			want:       "\"in\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 237, col: 9, offset: 6021},
+																			pos:        position{line: 296, col: 9, offset: 8151},
 																			val:        "using",
 																			ignoreCase: false,
 																			want:       "\"using\"",
 																		},
 																		&actionExpr{
-																			pos: position{line: 239, col: 11, offset: 6059},
+																			pos: position{line: 298, col: 11, offset: 8189},
 																			run: (*parser).callonRecordLiteralEntry25,
 																			expr: &seqExpr{
-																				pos: position{line: 239, col: 11, offset: 6059},
+																				pos: position{line: 298, col: 11, offset: 8189},
 																				exprs: []interface{}{
 																					&litMatcher{
-																						pos:        position{line: 239, col: 11, offset: 6059},
+																						pos:        position{line: 298, col: 11, offset: 8189},
 																						val:        "missing",
 																						ignoreCase: false,
 																						want:       "\"missing\"",
 																					},
 																					&notExpr{
-																						pos: position{line: 239, col: 21, offset: 6069},
+																						pos: position{line: 298, col: 21, offset: 8199},
 																						expr: &charClassMatcher{
-																							pos:        position{line: 121, col: 23, offset: 2785},
+																							pos:        position{line: 180, col: 23, offset: 4915},
 																							val:        "[_/-A-Za-z0-9]",
 																							chars:      []rune{'_', '/', '-'},
 																							ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15145,61 +15193,61 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 244, col: 10, offset: 6199},
+																			pos:        position{line: 303, col: 10, offset: 8329},
 																			val:        "assert",
 																			ignoreCase: false,
 																			want:       "\"assert\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 236, col: 6, offset: 6006},
+																			pos:        position{line: 295, col: 6, offset: 8136},
 																			val:        "as",
 																			ignoreCase: false,
 																			want:       "\"as\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 240, col: 12, offset: 6129},
+																			pos:        position{line: 299, col: 12, offset: 8259},
 																			val:        "Infinity",
 																			ignoreCase: false,
 																			want:       "\"Infinity\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 241, col: 7, offset: 6148},
+																			pos:        position{line: 300, col: 7, offset: 8278},
 																			val:        "NaN",
 																			ignoreCase: false,
 																			want:       "\"NaN\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 238, col: 9, offset: 6039},
+																			pos:        position{line: 297, col: 9, offset: 8169},
 																			val:        "merge",
 																			ignoreCase: false,
 																			want:       "\"merge\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 242, col: 8, offset: 6163},
+																			pos:        position{line: 301, col: 8, offset: 8293},
 																			val:        "Some",
 																			ignoreCase: false,
 																			want:       "\"Some\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 243, col: 9, offset: 6180},
+																			pos:        position{line: 302, col: 9, offset: 8310},
 																			val:        "toMap",
 																			ignoreCase: false,
 																			want:       "\"toMap\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 245, col: 10, offset: 6219},
+																			pos:        position{line: 304, col: 10, offset: 8349},
 																			val:        "forall",
 																			ignoreCase: false,
 																			want:       "\"forall\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 245, col: 21, offset: 6230},
+																			pos:        position{line: 304, col: 21, offset: 8360},
 																			val:        "∀",
 																			ignoreCase: false,
 																			want:       "\"∀\"",
 																		},
 																		&litMatcher{
-																			pos:        position{line: 246, col: 8, offset: 6245},
+																			pos:        position{line: 305, col: 8, offset: 8375},
 																			val:        "with",
 																			ignoreCase: false,
 																			want:       "\"with\"",
@@ -15207,9 +15255,9 @@ var g = &grammar{
 																	},
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 122, col: 23, offset: 2824},
+																	pos: position{line: 181, col: 23, offset: 4954},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15221,68 +15269,68 @@ var g = &grammar{
 														},
 													},
 													&actionExpr{
-														pos: position{line: 123, col: 13, offset: 2888},
+														pos: position{line: 182, col: 13, offset: 5018},
 														run: (*parser).callonRecordLiteralEntry42,
 														expr: &seqExpr{
-															pos: position{line: 123, col: 13, offset: 2888},
+															pos: position{line: 182, col: 13, offset: 5018},
 															exprs: []interface{}{
 																&notExpr{
-																	pos: position{line: 123, col: 13, offset: 2888},
+																	pos: position{line: 182, col: 13, offset: 5018},
 																	expr: &choiceExpr{
-																		pos: position{line: 249, col: 5, offset: 6269},
+																		pos: position{line: 308, col: 5, offset: 8399},
 																		alternatives: []interface{}{
 																			&litMatcher{
-																				pos:        position{line: 231, col: 6, offset: 5936},
+																				pos:        position{line: 290, col: 6, offset: 8066},
 																				val:        "if",
 																				ignoreCase: false,
 																				want:       "\"if\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 232, col: 8, offset: 5950},
+																				pos:        position{line: 291, col: 8, offset: 8080},
 																				val:        "then",
 																				ignoreCase: false,
 																				want:       "\"then\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 233, col: 8, offset: 5966},
+																				pos:        position{line: 292, col: 8, offset: 8096},
 																				val:        "else",
 																				ignoreCase: false,
 																				want:       "\"else\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 234, col: 7, offset: 5981},
+																				pos:        position{line: 293, col: 7, offset: 8111},
 																				val:        "let",
 																				ignoreCase: false,
 																				want:       "\"let\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 235, col: 6, offset: 5994},
+																				pos:        position{line: 294, col: 6, offset: 8124},
 																				val:        "in",
 																				ignoreCase: false,
 																				want:       "\"in\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 237, col: 9, offset: 6021},
+																				pos:        position{line: 296, col: 9, offset: 8151},
 																				val:        "using",
 																				ignoreCase: false,
 																				want:       "\"using\"",
 																			},
 																			&actionExpr{
-																				pos: position{line: 239, col: 11, offset: 6059},
+																				pos: position{line: 298, col: 11, offset: 8189},
 																				run: (*parser).callonRecordLiteralEntry52,
 																				expr: &seqExpr{
-																					pos: position{line: 239, col: 11, offset: 6059},
+																					pos: position{line: 298, col: 11, offset: 8189},
 																					exprs: []interface{}{
 																						&litMatcher{
-																							pos:        position{line: 239, col: 11, offset: 6059},
+																							pos:        position{line: 298, col: 11, offset: 8189},
 																							val:        "missing",
 																							ignoreCase: false,
 																							want:       "\"missing\"",
 																						},
 																						&notExpr{
-																							pos: position{line: 239, col: 21, offset: 6069},
+																							pos: position{line: 298, col: 21, offset: 8199},
 																							expr: &charClassMatcher{
-																								pos:        position{line: 121, col: 23, offset: 2785},
+																								pos:        position{line: 180, col: 23, offset: 4915},
 																								val:        "[_/-A-Za-z0-9]",
 																								chars:      []rune{'_', '/', '-'},
 																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15294,61 +15342,61 @@ var g = &grammar{
 																				},
 																			},
 																			&litMatcher{
-																				pos:        position{line: 244, col: 10, offset: 6199},
+																				pos:        position{line: 303, col: 10, offset: 8329},
 																				val:        "assert",
 																				ignoreCase: false,
 																				want:       "\"assert\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 236, col: 6, offset: 6006},
+																				pos:        position{line: 295, col: 6, offset: 8136},
 																				val:        "as",
 																				ignoreCase: false,
 																				want:       "\"as\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 240, col: 12, offset: 6129},
+																				pos:        position{line: 299, col: 12, offset: 8259},
 																				val:        "Infinity",
 																				ignoreCase: false,
 																				want:       "\"Infinity\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 241, col: 7, offset: 6148},
+																				pos:        position{line: 300, col: 7, offset: 8278},
 																				val:        "NaN",
 																				ignoreCase: false,
 																				want:       "\"NaN\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 238, col: 9, offset: 6039},
+																				pos:        position{line: 297, col: 9, offset: 8169},
 																				val:        "merge",
 																				ignoreCase: false,
 																				want:       "\"merge\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 242, col: 8, offset: 6163},
+																				pos:        position{line: 301, col: 8, offset: 8293},
 																				val:        "Some",
 																				ignoreCase: false,
 																				want:       "\"Some\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 243, col: 9, offset: 6180},
+																				pos:        position{line: 302, col: 9, offset: 8310},
 																				val:        "toMap",
 																				ignoreCase: false,
 																				want:       "\"toMap\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 245, col: 10, offset: 6219},
+																				pos:        position{line: 304, col: 10, offset: 8349},
 																				val:        "forall",
 																				ignoreCase: false,
 																				want:       "\"forall\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 245, col: 21, offset: 6230},
+																				pos:        position{line: 304, col: 21, offset: 8360},
 																				val:        "∀",
 																				ignoreCase: false,
 																				want:       "\"∀\"",
 																			},
 																			&litMatcher{
-																				pos:        position{line: 246, col: 8, offset: 6245},
+																				pos:        position{line: 305, col: 8, offset: 8375},
 																				val:        "with",
 																				ignoreCase: false,
 																				want:       "\"with\"",
@@ -15357,7 +15405,7 @@ var g = &grammar{
 																	},
 																},
 																&charClassMatcher{
-																	pos:        position{line: 120, col: 24, offset: 2751},
+																	pos:        position{line: 179, col: 24, offset: 4881},
 																	val:        "[_A-Za-z]",
 																	chars:      []rune{'_'},
 																	ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -15365,9 +15413,9 @@ var g = &grammar{
 																	inverted:   false,
 																},
 																&zeroOrMoreExpr{
-																	pos: position{line: 123, col: 43, offset: 2918},
+																	pos: position{line: 182, col: 43, offset: 5048},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 121, col: 23, offset: 2785},
+																		pos:        position{line: 180, col: 23, offset: 4915},
 																		val:        "[_/-A-Za-z0-9]",
 																		chars:      []rune{'_', '/', '-'},
 																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15383,10 +15431,10 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 139, col: 29, offset: 3414},
+										pos: position{line: 198, col: 29, offset: 5544},
 										run: (*parser).callonRecordLiteralEntry70,
 										expr: &litMatcher{
-											pos:        position{line: 242, col: 8, offset: 6163},
+											pos:        position{line: 301, col: 8, offset: 8293},
 											val:        "Some",
 											ignoreCase: false,
 											want:       "\"Some\"",
@@ -15396,17 +15444,17 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 725, col: 42, offset: 22857},
+							pos:   position{line: 807, col: 42, offset: 26118},
 							label: "val",
 							expr: &choiceExpr{
-								pos: position{line: 725, col: 47, offset: 22862},
+								pos: position{line: 807, col: 47, offset: 26123},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 725, col: 47, offset: 22862},
+										pos:  position{line: 807, col: 47, offset: 26123},
 										name: "RecordLiteralNormalEntry",
 									},
 									&litMatcher{
-										pos:        position{line: 742, col: 28, offset: 23451},
+										pos:        position{line: 825, col: 28, offset: 26809},
 										val:        "",
 										ignoreCase: false,
 										want:       "\"\"",
@@ -15420,60 +15468,60 @@ var g = &grammar{
 		},
 		{
 			name: "RecordLiteralNormalEntry",
-			pos:  position{line: 733, col: 1, offset: 23109},
+			pos:  position{line: 815, col: 1, offset: 26422},
 			expr: &actionExpr{
-				pos: position{line: 733, col: 28, offset: 23138},
+				pos: position{line: 815, col: 28, offset: 26451},
 				run: (*parser).callonRecordLiteralNormalEntry1,
 				expr: &seqExpr{
-					pos: position{line: 733, col: 28, offset: 23138},
+					pos: position{line: 815, col: 28, offset: 26451},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 733, col: 28, offset: 23138},
+							pos:   position{line: 815, col: 28, offset: 26451},
 							label: "children",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 733, col: 37, offset: 23147},
+								pos: position{line: 815, col: 37, offset: 26460},
 								expr: &seqExpr{
-									pos: position{line: 733, col: 38, offset: 23148},
+									pos: position{line: 815, col: 38, offset: 26461},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 733, col: 38, offset: 23148},
+											pos:  position{line: 815, col: 38, offset: 26461},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 733, col: 40, offset: 23150},
+											pos:        position{line: 815, col: 40, offset: 26463},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 733, col: 44, offset: 23154},
+											pos:  position{line: 815, col: 44, offset: 26467},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 139, col: 18, offset: 3403},
+											pos: position{line: 198, col: 18, offset: 5533},
 											alternatives: []interface{}{
 												&actionExpr{
-													pos: position{line: 131, col: 9, offset: 3119},
+													pos: position{line: 190, col: 9, offset: 5249},
 													run: (*parser).callonRecordLiteralNormalEntry10,
 													expr: &seqExpr{
-														pos: position{line: 131, col: 9, offset: 3119},
+														pos: position{line: 190, col: 9, offset: 5249},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 131, col: 9, offset: 3119},
+																pos:        position{line: 190, col: 9, offset: 5249},
 																val:        "`",
 																ignoreCase: false,
 																want:       "\"`\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 131, col: 13, offset: 3123},
+																pos:   position{line: 190, col: 13, offset: 5253},
 																label: "label",
 																expr: &actionExpr{
-																	pos: position{line: 129, col: 15, offset: 3060},
+																	pos: position{line: 188, col: 15, offset: 5190},
 																	run: (*parser).callonRecordLiteralNormalEntry14,
 																	expr: &zeroOrMoreExpr{
-																		pos: position{line: 129, col: 15, offset: 3060},
+																		pos: position{line: 188, col: 15, offset: 5190},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 128, col: 19, offset: 3023},
+																			pos:        position{line: 187, col: 19, offset: 5153},
 																			val:        "[ -_a-~]",
 																			ranges:     []rune{' ', '_', 'a', '~'},
 																			ignoreCase: false,
@@ -15483,7 +15531,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 131, col: 31, offset: 3141},
+																pos:        position{line: 190, col: 31, offset: 5271},
 																val:        "`",
 																ignoreCase: false,
 																want:       "\"`\"",
@@ -15492,75 +15540,75 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 132, col: 9, offset: 3175},
+													pos: position{line: 191, col: 9, offset: 5305},
 													run: (*parser).callonRecordLiteralNormalEntry18,
 													expr: &labeledExpr{
-														pos:   position{line: 132, col: 9, offset: 3175},
+														pos:   position{line: 191, col: 9, offset: 5305},
 														label: "label",
 														expr: &choiceExpr{
-															pos: position{line: 122, col: 15, offset: 2816},
+															pos: position{line: 181, col: 15, offset: 4946},
 															alternatives: []interface{}{
 																&actionExpr{
-																	pos: position{line: 122, col: 15, offset: 2816},
+																	pos: position{line: 181, col: 15, offset: 4946},
 																	run: (*parser).callonRecordLiteralNormalEntry21,
 																	expr: &seqExpr{
-																		pos: position{line: 122, col: 15, offset: 2816},
+																		pos: position{line: 181, col: 15, offset: 4946},
 																		exprs: []interface{}{
 																			&choiceExpr{
-																				pos: position{line: 249, col: 5, offset: 6269},
+																				pos: position{line: 308, col: 5, offset: 8399},
 																				alternatives: []interface{}{
 																					&litMatcher{
-																						pos:        position{line: 231, col: 6, offset: 5936},
+																						pos:        position{line: 290, col: 6, offset: 8066},
 																						val:        "if",
 																						ignoreCase: false,
 																						want:       "\"if\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 232, col: 8, offset: 5950},
+																						pos:        position{line: 291, col: 8, offset: 8080},
 																						val:        "then",
 																						ignoreCase: false,
 																						want:       "\"then\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 233, col: 8, offset: 5966},
+																						pos:        position{line: 292, col: 8, offset: 8096},
 																						val:        "else",
 																						ignoreCase: false,
 																						want:       "\"else\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 234, col: 7, offset: 5981},
+																						pos:        position{line: 293, col: 7, offset: 8111},
 																						val:        "let",
 																						ignoreCase: false,
 																						want:       "\"let\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 235, col: 6, offset: 5994},
+																						pos:        position{line: 294, col: 6, offset: 8124},
 																						val:        "in",
 																						ignoreCase: false,
 																						want:       "\"in\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 237, col: 9, offset: 6021},
+																						pos:        position{line: 296, col: 9, offset: 8151},
 																						val:        "using",
 																						ignoreCase: false,
 																						want:       "\"using\"",
 																					},
 																					&actionExpr{
-																						pos: position{line: 239, col: 11, offset: 6059},
+																						pos: position{line: 298, col: 11, offset: 8189},
 																						run: (*parser).callonRecordLiteralNormalEntry30,
 																						expr: &seqExpr{
-																							pos: position{line: 239, col: 11, offset: 6059},
+																							pos: position{line: 298, col: 11, offset: 8189},
 																							exprs: []interface{}{
 																								&litMatcher{
-																									pos:        position{line: 239, col: 11, offset: 6059},
+																									pos:        position{line: 298, col: 11, offset: 8189},
 																									val:        "missing",
 																									ignoreCase: false,
 																									want:       "\"missing\"",
 																								},
 																								&notExpr{
-																									pos: position{line: 239, col: 21, offset: 6069},
+																									pos: position{line: 298, col: 21, offset: 8199},
 																									expr: &charClassMatcher{
-																										pos:        position{line: 121, col: 23, offset: 2785},
+																										pos:        position{line: 180, col: 23, offset: 4915},
 																										val:        "[_/-A-Za-z0-9]",
 																										chars:      []rune{'_', '/', '-'},
 																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15572,61 +15620,61 @@ var g = &grammar{
 																						},
 																					},
 																					&litMatcher{
-																						pos:        position{line: 244, col: 10, offset: 6199},
+																						pos:        position{line: 303, col: 10, offset: 8329},
 																						val:        "assert",
 																						ignoreCase: false,
 																						want:       "\"assert\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 236, col: 6, offset: 6006},
+																						pos:        position{line: 295, col: 6, offset: 8136},
 																						val:        "as",
 																						ignoreCase: false,
 																						want:       "\"as\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 240, col: 12, offset: 6129},
+																						pos:        position{line: 299, col: 12, offset: 8259},
 																						val:        "Infinity",
 																						ignoreCase: false,
 																						want:       "\"Infinity\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 241, col: 7, offset: 6148},
+																						pos:        position{line: 300, col: 7, offset: 8278},
 																						val:        "NaN",
 																						ignoreCase: false,
 																						want:       "\"NaN\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 238, col: 9, offset: 6039},
+																						pos:        position{line: 297, col: 9, offset: 8169},
 																						val:        "merge",
 																						ignoreCase: false,
 																						want:       "\"merge\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 242, col: 8, offset: 6163},
+																						pos:        position{line: 301, col: 8, offset: 8293},
 																						val:        "Some",
 																						ignoreCase: false,
 																						want:       "\"Some\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 243, col: 9, offset: 6180},
+																						pos:        position{line: 302, col: 9, offset: 8310},
 																						val:        "toMap",
 																						ignoreCase: false,
 																						want:       "\"toMap\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 245, col: 10, offset: 6219},
+																						pos:        position{line: 304, col: 10, offset: 8349},
 																						val:        "forall",
 																						ignoreCase: false,
 																						want:       "\"forall\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 245, col: 21, offset: 6230},
+																						pos:        position{line: 304, col: 21, offset: 8360},
 																						val:        "∀",
 																						ignoreCase: false,
 																						want:       "\"∀\"",
 																					},
 																					&litMatcher{
-																						pos:        position{line: 246, col: 8, offset: 6245},
+																						pos:        position{line: 305, col: 8, offset: 8375},
 																						val:        "with",
 																						ignoreCase: false,
 																						want:       "\"with\"",
@@ -15634,9 +15682,9 @@ var g = &grammar{
 																				},
 																			},
 																			&oneOrMoreExpr{
-																				pos: position{line: 122, col: 23, offset: 2824},
+																				pos: position{line: 181, col: 23, offset: 4954},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15648,68 +15696,68 @@ var g = &grammar{
 																	},
 																},
 																&actionExpr{
-																	pos: position{line: 123, col: 13, offset: 2888},
+																	pos: position{line: 182, col: 13, offset: 5018},
 																	run: (*parser).callonRecordLiteralNormalEntry47,
 																	expr: &seqExpr{
-																		pos: position{line: 123, col: 13, offset: 2888},
+																		pos: position{line: 182, col: 13, offset: 5018},
 																		exprs: []interface{}{
 																			&notExpr{
-																				pos: position{line: 123, col: 13, offset: 2888},
+																				pos: position{line: 182, col: 13, offset: 5018},
 																				expr: &choiceExpr{
-																					pos: position{line: 249, col: 5, offset: 6269},
+																					pos: position{line: 308, col: 5, offset: 8399},
 																					alternatives: []interface{}{
 																						&litMatcher{
-																							pos:        position{line: 231, col: 6, offset: 5936},
+																							pos:        position{line: 290, col: 6, offset: 8066},
 																							val:        "if",
 																							ignoreCase: false,
 																							want:       "\"if\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 232, col: 8, offset: 5950},
+																							pos:        position{line: 291, col: 8, offset: 8080},
 																							val:        "then",
 																							ignoreCase: false,
 																							want:       "\"then\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 233, col: 8, offset: 5966},
+																							pos:        position{line: 292, col: 8, offset: 8096},
 																							val:        "else",
 																							ignoreCase: false,
 																							want:       "\"else\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 234, col: 7, offset: 5981},
+																							pos:        position{line: 293, col: 7, offset: 8111},
 																							val:        "let",
 																							ignoreCase: false,
 																							want:       "\"let\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 235, col: 6, offset: 5994},
+																							pos:        position{line: 294, col: 6, offset: 8124},
 																							val:        "in",
 																							ignoreCase: false,
 																							want:       "\"in\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 237, col: 9, offset: 6021},
+																							pos:        position{line: 296, col: 9, offset: 8151},
 																							val:        "using",
 																							ignoreCase: false,
 																							want:       "\"using\"",
 																						},
 																						&actionExpr{
-																							pos: position{line: 239, col: 11, offset: 6059},
+																							pos: position{line: 298, col: 11, offset: 8189},
 																							run: (*parser).callonRecordLiteralNormalEntry57,
 																							expr: &seqExpr{
-																								pos: position{line: 239, col: 11, offset: 6059},
+																								pos: position{line: 298, col: 11, offset: 8189},
 																								exprs: []interface{}{
 																									&litMatcher{
-																										pos:        position{line: 239, col: 11, offset: 6059},
+																										pos:        position{line: 298, col: 11, offset: 8189},
 																										val:        "missing",
 																										ignoreCase: false,
 																										want:       "\"missing\"",
 																									},
 																									&notExpr{
-																										pos: position{line: 239, col: 21, offset: 6069},
+																										pos: position{line: 298, col: 21, offset: 8199},
 																										expr: &charClassMatcher{
-																											pos:        position{line: 121, col: 23, offset: 2785},
+																											pos:        position{line: 180, col: 23, offset: 4915},
 																											val:        "[_/-A-Za-z0-9]",
 																											chars:      []rune{'_', '/', '-'},
 																											ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15721,61 +15769,61 @@ var g = &grammar{
 																							},
 																						},
 																						&litMatcher{
-																							pos:        position{line: 244, col: 10, offset: 6199},
+																							pos:        position{line: 303, col: 10, offset: 8329},
 																							val:        "assert",
 																							ignoreCase: false,
 																							want:       "\"assert\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 236, col: 6, offset: 6006},
+																							pos:        position{line: 295, col: 6, offset: 8136},
 																							val:        "as",
 																							ignoreCase: false,
 																							want:       "\"as\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 240, col: 12, offset: 6129},
+																							pos:        position{line: 299, col: 12, offset: 8259},
 																							val:        "Infinity",
 																							ignoreCase: false,
 																							want:       "\"Infinity\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 241, col: 7, offset: 6148},
+																							pos:        position{line: 300, col: 7, offset: 8278},
 																							val:        "NaN",
 																							ignoreCase: false,
 																							want:       "\"NaN\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 238, col: 9, offset: 6039},
+																							pos:        position{line: 297, col: 9, offset: 8169},
 																							val:        "merge",
 																							ignoreCase: false,
 																							want:       "\"merge\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 242, col: 8, offset: 6163},
+																							pos:        position{line: 301, col: 8, offset: 8293},
 																							val:        "Some",
 																							ignoreCase: false,
 																							want:       "\"Some\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 243, col: 9, offset: 6180},
+																							pos:        position{line: 302, col: 9, offset: 8310},
 																							val:        "toMap",
 																							ignoreCase: false,
 																							want:       "\"toMap\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 245, col: 10, offset: 6219},
+																							pos:        position{line: 304, col: 10, offset: 8349},
 																							val:        "forall",
 																							ignoreCase: false,
 																							want:       "\"forall\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 245, col: 21, offset: 6230},
+																							pos:        position{line: 304, col: 21, offset: 8360},
 																							val:        "∀",
 																							ignoreCase: false,
 																							want:       "\"∀\"",
 																						},
 																						&litMatcher{
-																							pos:        position{line: 246, col: 8, offset: 6245},
+																							pos:        position{line: 305, col: 8, offset: 8375},
 																							val:        "with",
 																							ignoreCase: false,
 																							want:       "\"with\"",
@@ -15784,7 +15832,7 @@ var g = &grammar{
 																				},
 																			},
 																			&charClassMatcher{
-																				pos:        position{line: 120, col: 24, offset: 2751},
+																				pos:        position{line: 179, col: 24, offset: 4881},
 																				val:        "[_A-Za-z]",
 																				chars:      []rune{'_'},
 																				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -15792,9 +15840,9 @@ var g = &grammar{
 																				inverted:   false,
 																			},
 																			&zeroOrMoreExpr{
-																				pos: position{line: 123, col: 43, offset: 2918},
+																				pos: position{line: 182, col: 43, offset: 5048},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -15810,10 +15858,10 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 139, col: 29, offset: 3414},
+													pos: position{line: 198, col: 29, offset: 5544},
 													run: (*parser).callonRecordLiteralNormalEntry75,
 													expr: &litMatcher{
-														pos:        position{line: 242, col: 8, offset: 6163},
+														pos:        position{line: 301, col: 8, offset: 8293},
 														val:        "Some",
 														ignoreCase: false,
 														want:       "\"Some\"",
@@ -15826,24 +15874,24 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 733, col: 63, offset: 23173},
+							pos:  position{line: 815, col: 63, offset: 26486},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 733, col: 65, offset: 23175},
+							pos:        position{line: 815, col: 65, offset: 26488},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 733, col: 69, offset: 23179},
+							pos:  position{line: 815, col: 69, offset: 26492},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 733, col: 71, offset: 23181},
+							pos:   position{line: 815, col: 71, offset: 26494},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 733, col: 76, offset: 23186},
+								pos:  position{line: 815, col: 76, offset: 26499},
 								name: "Expression",
 							},
 						},
@@ -15853,19 +15901,19 @@ var g = &grammar{
 		},
 		{
 			name: "UnionType",
-			pos:  position{line: 744, col: 1, offset: 23455},
+			pos:  position{line: 827, col: 1, offset: 26813},
 			expr: &choiceExpr{
-				pos: position{line: 744, col: 13, offset: 23469},
+				pos: position{line: 827, col: 13, offset: 26827},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 744, col: 13, offset: 23469},
+						pos:  position{line: 827, col: 13, offset: 26827},
 						name: "NonEmptyUnionType",
 					},
 					&actionExpr{
-						pos: position{line: 746, col: 18, offset: 23524},
+						pos: position{line: 829, col: 18, offset: 26882},
 						run: (*parser).callonUnionType3,
 						expr: &litMatcher{
-							pos:        position{line: 746, col: 18, offset: 23524},
+							pos:        position{line: 829, col: 18, offset: 26882},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15876,45 +15924,45 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyUnionType",
-			pos:  position{line: 748, col: 1, offset: 23556},
+			pos:  position{line: 831, col: 1, offset: 26914},
 			expr: &actionExpr{
-				pos: position{line: 748, col: 21, offset: 23578},
+				pos: position{line: 831, col: 21, offset: 26936},
 				run: (*parser).callonNonEmptyUnionType1,
 				expr: &seqExpr{
-					pos: position{line: 748, col: 21, offset: 23578},
+					pos: position{line: 831, col: 21, offset: 26936},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 748, col: 21, offset: 23578},
+							pos:   position{line: 831, col: 21, offset: 26936},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 748, col: 27, offset: 23584},
+								pos:  position{line: 831, col: 27, offset: 26942},
 								name: "UnionTypeEntry",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 748, col: 42, offset: 23599},
+							pos:   position{line: 831, col: 42, offset: 26957},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 748, col: 47, offset: 23604},
+								pos: position{line: 831, col: 47, offset: 26962},
 								expr: &seqExpr{
-									pos: position{line: 748, col: 48, offset: 23605},
+									pos: position{line: 831, col: 48, offset: 26963},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 748, col: 48, offset: 23605},
+											pos:  position{line: 831, col: 48, offset: 26963},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 748, col: 50, offset: 23607},
+											pos:        position{line: 831, col: 50, offset: 26965},
 											val:        "|",
 											ignoreCase: false,
 											want:       "\"|\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 748, col: 54, offset: 23611},
+											pos:  position{line: 831, col: 54, offset: 26969},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 748, col: 56, offset: 23613},
+											pos:  position{line: 831, col: 56, offset: 26971},
 											name: "UnionTypeEntry",
 										},
 									},
@@ -15922,16 +15970,16 @@ var g = &grammar{
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 748, col: 73, offset: 23630},
+							pos: position{line: 831, col: 73, offset: 26988},
 							expr: &seqExpr{
-								pos: position{line: 748, col: 74, offset: 23631},
+								pos: position{line: 831, col: 74, offset: 26989},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 748, col: 74, offset: 23631},
+										pos:  position{line: 831, col: 74, offset: 26989},
 										name: "_",
 									},
 									&litMatcher{
-										pos:        position{line: 748, col: 76, offset: 23633},
+										pos:        position{line: 831, col: 76, offset: 26991},
 										val:        "|",
 										ignoreCase: false,
 										want:       "\"|\"",
@@ -15945,35 +15993,35 @@ var g = &grammar{
 		},
 		{
 			name: "UnionTypeEntry",
-			pos:  position{line: 773, col: 1, offset: 24465},
+			pos:  position{line: 862, col: 1, offset: 28032},
 			expr: &seqExpr{
-				pos: position{line: 773, col: 18, offset: 24484},
+				pos: position{line: 862, col: 18, offset: 28051},
 				exprs: []interface{}{
 					&choiceExpr{
-						pos: position{line: 139, col: 18, offset: 3403},
+						pos: position{line: 198, col: 18, offset: 5533},
 						alternatives: []interface{}{
 							&actionExpr{
-								pos: position{line: 131, col: 9, offset: 3119},
+								pos: position{line: 190, col: 9, offset: 5249},
 								run: (*parser).callonUnionTypeEntry3,
 								expr: &seqExpr{
-									pos: position{line: 131, col: 9, offset: 3119},
+									pos: position{line: 190, col: 9, offset: 5249},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 131, col: 9, offset: 3119},
+											pos:        position{line: 190, col: 9, offset: 5249},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 131, col: 13, offset: 3123},
+											pos:   position{line: 190, col: 13, offset: 5253},
 											label: "label",
 											expr: &actionExpr{
-												pos: position{line: 129, col: 15, offset: 3060},
+												pos: position{line: 188, col: 15, offset: 5190},
 												run: (*parser).callonUnionTypeEntry7,
 												expr: &zeroOrMoreExpr{
-													pos: position{line: 129, col: 15, offset: 3060},
+													pos: position{line: 188, col: 15, offset: 5190},
 													expr: &charClassMatcher{
-														pos:        position{line: 128, col: 19, offset: 3023},
+														pos:        position{line: 187, col: 19, offset: 5153},
 														val:        "[ -_a-~]",
 														ranges:     []rune{' ', '_', 'a', '~'},
 														ignoreCase: false,
@@ -15983,7 +16031,7 @@ var g = &grammar{
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 131, col: 31, offset: 3141},
+											pos:        position{line: 190, col: 31, offset: 5271},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
@@ -15992,75 +16040,75 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 132, col: 9, offset: 3175},
+								pos: position{line: 191, col: 9, offset: 5305},
 								run: (*parser).callonUnionTypeEntry11,
 								expr: &labeledExpr{
-									pos:   position{line: 132, col: 9, offset: 3175},
+									pos:   position{line: 191, col: 9, offset: 5305},
 									label: "label",
 									expr: &choiceExpr{
-										pos: position{line: 122, col: 15, offset: 2816},
+										pos: position{line: 181, col: 15, offset: 4946},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 122, col: 15, offset: 2816},
+												pos: position{line: 181, col: 15, offset: 4946},
 												run: (*parser).callonUnionTypeEntry14,
 												expr: &seqExpr{
-													pos: position{line: 122, col: 15, offset: 2816},
+													pos: position{line: 181, col: 15, offset: 4946},
 													exprs: []interface{}{
 														&choiceExpr{
-															pos: position{line: 249, col: 5, offset: 6269},
+															pos: position{line: 308, col: 5, offset: 8399},
 															alternatives: []interface{}{
 																&litMatcher{
-																	pos:        position{line: 231, col: 6, offset: 5936},
+																	pos:        position{line: 290, col: 6, offset: 8066},
 																	val:        "if",
 																	ignoreCase: false,
 																	want:       "\"if\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 232, col: 8, offset: 5950},
+																	pos:        position{line: 291, col: 8, offset: 8080},
 																	val:        "then",
 																	ignoreCase: false,
 																	want:       "\"then\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 233, col: 8, offset: 5966},
+																	pos:        position{line: 292, col: 8, offset: 8096},
 																	val:        "else",
 																	ignoreCase: false,
 																	want:       "\"else\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 234, col: 7, offset: 5981},
+																	pos:        position{line: 293, col: 7, offset: 8111},
 																	val:        "let",
 																	ignoreCase: false,
 																	want:       "\"let\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 235, col: 6, offset: 5994},
+																	pos:        position{line: 294, col: 6, offset: 8124},
 																	val:        "in",
 																	ignoreCase: false,
 																	want:       "\"in\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 237, col: 9, offset: 6021},
+																	pos:        position{line: 296, col: 9, offset: 8151},
 																	val:        "using",
 																	ignoreCase: false,
 																	want:       "\"using\"",
 																},
 																&actionExpr{
-																	pos: position{line: 239, col: 11, offset: 6059},
+																	pos: position{line: 298, col: 11, offset: 8189},
 																	run: (*parser).callonUnionTypeEntry23,
 																	expr: &seqExpr{
-																		pos: position{line: 239, col: 11, offset: 6059},
+																		pos: position{line: 298, col: 11, offset: 8189},
 																		exprs: []interface{}{
 																			&litMatcher{
-																				pos:        position{line: 239, col: 11, offset: 6059},
+																				pos:        position{line: 298, col: 11, offset: 8189},
 																				val:        "missing",
 																				ignoreCase: false,
 																				want:       "\"missing\"",
 																			},
 																			&notExpr{
-																				pos: position{line: 239, col: 21, offset: 6069},
+																				pos: position{line: 298, col: 21, offset: 8199},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 121, col: 23, offset: 2785},
+																					pos:        position{line: 180, col: 23, offset: 4915},
 																					val:        "[_/-A-Za-z0-9]",
 																					chars:      []rune{'_', '/', '-'},
 																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -16072,61 +16120,61 @@ var g = &grammar{
 																	},
 																},
 																&litMatcher{
-																	pos:        position{line: 244, col: 10, offset: 6199},
+																	pos:        position{line: 303, col: 10, offset: 8329},
 																	val:        "assert",
 																	ignoreCase: false,
 																	want:       "\"assert\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 236, col: 6, offset: 6006},
+																	pos:        position{line: 295, col: 6, offset: 8136},
 																	val:        "as",
 																	ignoreCase: false,
 																	want:       "\"as\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 240, col: 12, offset: 6129},
+																	pos:        position{line: 299, col: 12, offset: 8259},
 																	val:        "Infinity",
 																	ignoreCase: false,
 																	want:       "\"Infinity\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 241, col: 7, offset: 6148},
+																	pos:        position{line: 300, col: 7, offset: 8278},
 																	val:        "NaN",
 																	ignoreCase: false,
 																	want:       "\"NaN\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 238, col: 9, offset: 6039},
+																	pos:        position{line: 297, col: 9, offset: 8169},
 																	val:        "merge",
 																	ignoreCase: false,
 																	want:       "\"merge\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 242, col: 8, offset: 6163},
+																	pos:        position{line: 301, col: 8, offset: 8293},
 																	val:        "Some",
 																	ignoreCase: false,
 																	want:       "\"Some\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 243, col: 9, offset: 6180},
+																	pos:        position{line: 302, col: 9, offset: 8310},
 																	val:        "toMap",
 																	ignoreCase: false,
 																	want:       "\"toMap\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 245, col: 10, offset: 6219},
+																	pos:        position{line: 304, col: 10, offset: 8349},
 																	val:        "forall",
 																	ignoreCase: false,
 																	want:       "\"forall\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 245, col: 21, offset: 6230},
+																	pos:        position{line: 304, col: 21, offset: 8360},
 																	val:        "∀",
 																	ignoreCase: false,
 																	want:       "\"∀\"",
 																},
 																&litMatcher{
-																	pos:        position{line: 246, col: 8, offset: 6245},
+																	pos:        position{line: 305, col: 8, offset: 8375},
 																	val:        "with",
 																	ignoreCase: false,
 																	want:       "\"with\"",
@@ -16134,9 +16182,9 @@ var g = &grammar{
 															},
 														},
 														&oneOrMoreExpr{
-															pos: position{line: 122, col: 23, offset: 2824},
+															pos: position{line: 181, col: 23, offset: 4954},
 															expr: &charClassMatcher{
-																pos:        position{line: 121, col: 23, offset: 2785},
+																pos:        position{line: 180, col: 23, offset: 4915},
 																val:        "[_/-A-Za-z0-9]",
 																chars:      []rune{'_', '/', '-'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -16148,68 +16196,68 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 123, col: 13, offset: 2888},
+												pos: position{line: 182, col: 13, offset: 5018},
 												run: (*parser).callonUnionTypeEntry40,
 												expr: &seqExpr{
-													pos: position{line: 123, col: 13, offset: 2888},
+													pos: position{line: 182, col: 13, offset: 5018},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 123, col: 13, offset: 2888},
+															pos: position{line: 182, col: 13, offset: 5018},
 															expr: &choiceExpr{
-																pos: position{line: 249, col: 5, offset: 6269},
+																pos: position{line: 308, col: 5, offset: 8399},
 																alternatives: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 231, col: 6, offset: 5936},
+																		pos:        position{line: 290, col: 6, offset: 8066},
 																		val:        "if",
 																		ignoreCase: false,
 																		want:       "\"if\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 232, col: 8, offset: 5950},
+																		pos:        position{line: 291, col: 8, offset: 8080},
 																		val:        "then",
 																		ignoreCase: false,
 																		want:       "\"then\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 233, col: 8, offset: 5966},
+																		pos:        position{line: 292, col: 8, offset: 8096},
 																		val:        "else",
 																		ignoreCase: false,
 																		want:       "\"else\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 234, col: 7, offset: 5981},
+																		pos:        position{line: 293, col: 7, offset: 8111},
 																		val:        "let",
 																		ignoreCase: false,
 																		want:       "\"let\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 235, col: 6, offset: 5994},
+																		pos:        position{line: 294, col: 6, offset: 8124},
 																		val:        "in",
 																		ignoreCase: false,
 																		want:       "\"in\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 237, col: 9, offset: 6021},
+																		pos:        position{line: 296, col: 9, offset: 8151},
 																		val:        "using",
 																		ignoreCase: false,
 																		want:       "\"using\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 239, col: 11, offset: 6059},
+																		pos: position{line: 298, col: 11, offset: 8189},
 																		run: (*parser).callonUnionTypeEntry50,
 																		expr: &seqExpr{
-																			pos: position{line: 239, col: 11, offset: 6059},
+																			pos: position{line: 298, col: 11, offset: 8189},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 239, col: 11, offset: 6059},
+																					pos:        position{line: 298, col: 11, offset: 8189},
 																					val:        "missing",
 																					ignoreCase: false,
 																					want:       "\"missing\"",
 																				},
 																				&notExpr{
-																					pos: position{line: 239, col: 21, offset: 6069},
+																					pos: position{line: 298, col: 21, offset: 8199},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 121, col: 23, offset: 2785},
+																						pos:        position{line: 180, col: 23, offset: 4915},
 																						val:        "[_/-A-Za-z0-9]",
 																						chars:      []rune{'_', '/', '-'},
 																						ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -16221,61 +16269,61 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 244, col: 10, offset: 6199},
+																		pos:        position{line: 303, col: 10, offset: 8329},
 																		val:        "assert",
 																		ignoreCase: false,
 																		want:       "\"assert\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 236, col: 6, offset: 6006},
+																		pos:        position{line: 295, col: 6, offset: 8136},
 																		val:        "as",
 																		ignoreCase: false,
 																		want:       "\"as\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 240, col: 12, offset: 6129},
+																		pos:        position{line: 299, col: 12, offset: 8259},
 																		val:        "Infinity",
 																		ignoreCase: false,
 																		want:       "\"Infinity\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 241, col: 7, offset: 6148},
+																		pos:        position{line: 300, col: 7, offset: 8278},
 																		val:        "NaN",
 																		ignoreCase: false,
 																		want:       "\"NaN\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 238, col: 9, offset: 6039},
+																		pos:        position{line: 297, col: 9, offset: 8169},
 																		val:        "merge",
 																		ignoreCase: false,
 																		want:       "\"merge\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 242, col: 8, offset: 6163},
+																		pos:        position{line: 301, col: 8, offset: 8293},
 																		val:        "Some",
 																		ignoreCase: false,
 																		want:       "\"Some\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 243, col: 9, offset: 6180},
+																		pos:        position{line: 302, col: 9, offset: 8310},
 																		val:        "toMap",
 																		ignoreCase: false,
 																		want:       "\"toMap\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 245, col: 10, offset: 6219},
+																		pos:        position{line: 304, col: 10, offset: 8349},
 																		val:        "forall",
 																		ignoreCase: false,
 																		want:       "\"forall\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 245, col: 21, offset: 6230},
+																		pos:        position{line: 304, col: 21, offset: 8360},
 																		val:        "∀",
 																		ignoreCase: false,
 																		want:       "\"∀\"",
 																	},
 																	&litMatcher{
-																		pos:        position{line: 246, col: 8, offset: 6245},
+																		pos:        position{line: 305, col: 8, offset: 8375},
 																		val:        "with",
 																		ignoreCase: false,
 																		want:       "\"with\"",
@@ -16284,7 +16332,7 @@ var g = &grammar{
 															},
 														},
 														&charClassMatcher{
-															pos:        position{line: 120, col: 24, offset: 2751},
+															pos:        position{line: 179, col: 24, offset: 4881},
 															val:        "[_A-Za-z]",
 															chars:      []rune{'_'},
 															ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -16292,9 +16340,9 @@ var g = &grammar{
 															inverted:   false,
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 123, col: 43, offset: 2918},
+															pos: position{line: 182, col: 43, offset: 5048},
 															expr: &charClassMatcher{
-																pos:        position{line: 121, col: 23, offset: 2785},
+																pos:        position{line: 180, col: 23, offset: 4915},
 																val:        "[_/-A-Za-z0-9]",
 																chars:      []rune{'_', '/', '-'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -16310,10 +16358,10 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 139, col: 29, offset: 3414},
+								pos: position{line: 198, col: 29, offset: 5544},
 								run: (*parser).callonUnionTypeEntry68,
 								expr: &litMatcher{
-									pos:        position{line: 242, col: 8, offset: 6163},
+									pos:        position{line: 301, col: 8, offset: 8293},
 									val:        "Some",
 									ignoreCase: false,
 									want:       "\"Some\"",
@@ -16322,26 +16370,26 @@ var g = &grammar{
 						},
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 773, col: 33, offset: 24499},
+						pos: position{line: 862, col: 33, offset: 28066},
 						expr: &seqExpr{
-							pos: position{line: 773, col: 34, offset: 24500},
+							pos: position{line: 862, col: 34, offset: 28067},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 773, col: 34, offset: 24500},
+									pos:  position{line: 862, col: 34, offset: 28067},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 773, col: 36, offset: 24502},
+									pos:        position{line: 862, col: 36, offset: 28069},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 773, col: 40, offset: 24506},
+									pos:  position{line: 862, col: 40, offset: 28073},
 									name: "_1",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 773, col: 43, offset: 24509},
+									pos:  position{line: 862, col: 43, offset: 28076},
 									name: "Expression",
 								},
 							},
@@ -16352,33 +16400,33 @@ var g = &grammar{
 		},
 		{
 			name: "MoreList",
-			pos:  position{line: 775, col: 1, offset: 24523},
+			pos:  position{line: 864, col: 1, offset: 28090},
 			expr: &actionExpr{
-				pos: position{line: 775, col: 12, offset: 24536},
+				pos: position{line: 864, col: 12, offset: 28103},
 				run: (*parser).callonMoreList1,
 				expr: &seqExpr{
-					pos: position{line: 775, col: 12, offset: 24536},
+					pos: position{line: 864, col: 12, offset: 28103},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 775, col: 12, offset: 24536},
+							pos:        position{line: 864, col: 12, offset: 28103},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 775, col: 16, offset: 24540},
+							pos:  position{line: 864, col: 16, offset: 28107},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 775, col: 18, offset: 24542},
+							pos:   position{line: 864, col: 18, offset: 28109},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 775, col: 20, offset: 24544},
+								pos:  position{line: 864, col: 20, offset: 28111},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 775, col: 31, offset: 24555},
+							pos:  position{line: 864, col: 31, offset: 28122},
 							name: "_",
 						},
 					},
@@ -16387,84 +16435,84 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyListLiteral",
-			pos:  position{line: 777, col: 1, offset: 24574},
+			pos:  position{line: 866, col: 1, offset: 28141},
 			expr: &actionExpr{
-				pos: position{line: 778, col: 7, offset: 24604},
+				pos: position{line: 867, col: 7, offset: 28171},
 				run: (*parser).callonNonEmptyListLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 778, col: 7, offset: 24604},
+					pos: position{line: 867, col: 7, offset: 28171},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 778, col: 7, offset: 24604},
+							pos:        position{line: 867, col: 7, offset: 28171},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 778, col: 11, offset: 24608},
+							pos:  position{line: 867, col: 11, offset: 28175},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 778, col: 13, offset: 24610},
+							pos: position{line: 867, col: 13, offset: 28177},
 							expr: &seqExpr{
-								pos: position{line: 778, col: 14, offset: 24611},
+								pos: position{line: 867, col: 14, offset: 28178},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 778, col: 14, offset: 24611},
+										pos:        position{line: 867, col: 14, offset: 28178},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 778, col: 18, offset: 24615},
+										pos:  position{line: 867, col: 18, offset: 28182},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 778, col: 22, offset: 24619},
+							pos:   position{line: 867, col: 22, offset: 28186},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 778, col: 28, offset: 24625},
+								pos:  position{line: 867, col: 28, offset: 28192},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 778, col: 39, offset: 24636},
+							pos:  position{line: 867, col: 39, offset: 28203},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 778, col: 41, offset: 24638},
+							pos:   position{line: 867, col: 41, offset: 28205},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 778, col: 46, offset: 24643},
+								pos: position{line: 867, col: 46, offset: 28210},
 								expr: &ruleRefExpr{
-									pos:  position{line: 778, col: 46, offset: 24643},
+									pos:  position{line: 867, col: 46, offset: 28210},
 									name: "MoreList",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 778, col: 56, offset: 24653},
+							pos: position{line: 867, col: 56, offset: 28220},
 							expr: &seqExpr{
-								pos: position{line: 778, col: 57, offset: 24654},
+								pos: position{line: 867, col: 57, offset: 28221},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 778, col: 57, offset: 24654},
+										pos:        position{line: 867, col: 57, offset: 28221},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 778, col: 61, offset: 24658},
+										pos:  position{line: 867, col: 61, offset: 28225},
 										name: "_",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 778, col: 65, offset: 24662},
+							pos:        position{line: 867, col: 65, offset: 28229},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -16475,27 +16523,27 @@ var g = &grammar{
 		},
 		{
 			name: "CompleteExpression",
-			pos:  position{line: 788, col: 1, offset: 24929},
+			pos:  position{line: 877, col: 1, offset: 28496},
 			expr: &actionExpr{
-				pos: position{line: 788, col: 22, offset: 24952},
+				pos: position{line: 877, col: 22, offset: 28519},
 				run: (*parser).callonCompleteExpression1,
 				expr: &seqExpr{
-					pos: position{line: 788, col: 22, offset: 24952},
+					pos: position{line: 877, col: 22, offset: 28519},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 788, col: 22, offset: 24952},
+							pos:  position{line: 877, col: 22, offset: 28519},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 788, col: 24, offset: 24954},
+							pos:   position{line: 877, col: 24, offset: 28521},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 788, col: 26, offset: 24956},
+								pos:  position{line: 877, col: 26, offset: 28523},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 788, col: 37, offset: 24967},
+							pos:  position{line: 877, col: 37, offset: 28534},
 							name: "_",
 						},
 					},
@@ -16506,6 +16554,9 @@ var g = &grammar{
 }
 
 func (c *current) onDhallFile1(e interface{}) (interface{}, error) {
+	if recorder := commentRecorder(c); recorder != nil {
+		recorder.Source(c.text)
+	}
 	return e, nil
 }
 
@@ -16515,6 +16566,19 @@ func (p *parser) callonDhallFile1() (interface{}, error) {
 	return p.cur.onDhallFile1(stack["e"])
 }
 
+func (c *current) onBlockComment1() (interface{}, error) {
+	if recorder := commentRecorder(c); recorder != nil {
+		recorder.Comment(c.pos.offset, string(c.text))
+	}
+	return c.text, nil
+}
+
+func (p *parser) callonBlockComment1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlockComment1()
+}
+
 func (c *current) onBlockCommentContinue9() (interface{}, error) {
 	return []byte{'\n'}, nil
 }
@@ -16556,6 +16620,9 @@ func (p *parser) callonWhitespaceChunk14() (interface{}, error) {
 }
 
 func (c *current) onWhitespaceChunk5(content interface{}) (interface{}, error) {
+	if recorder := commentRecorder(c); recorder != nil {
+		recorder.Comment(c.pos.offset, "--"+content.(string))
+	}
 	return content, nil
 }
 
@@ -19399,16 +19466,16 @@ func (p *parser) callonLetBinding150() (interface{}, error) {
 
 func (c *current) onLetBinding1(label, a, v interface{}) (interface{}, error) {
 	if a != nil {
-		return Binding{
+		return positioned{Binding{
 			Variable:   label.(string),
 			Annotation: a.([]interface{})[0].(Term),
 			Value:      v.(Term),
-		}, nil
+		}, c.pos.offset}, nil
 	} else {
-		return Binding{
+		return positioned{Binding{
 			Variable: label.(string),
 			Value:    v.(Term),
-		}, nil
+		}, c.pos.offset}, nil
 	}
 }
 
@@ -20305,7 +20372,12 @@ func (p *parser) callonExpression308() (interface{}, error) {
 func (c *current) onExpression324(bindings, b interface{}) (interface{}, error) {
 	bs := make([]Binding, len(bindings.([]interface{})))
 	for i, binding := range bindings.([]interface{}) {
-		bs[i] = binding.(Binding)
+		bs[i] = binding.(positioned).value.(Binding)
+	}
+	if recorder := commentRecorder(c); recorder != nil {
+		for i, binding := range bindings.([]interface{}) {
+			recorder.Binding(binding.(positioned).offset, &bs[i])
+		}
 	}
 	return NewLet(b.(Term), bs...), nil
 
@@ -22165,15 +22237,23 @@ func (p *parser) callonMoreRecordType1() (interface{}, error) {
 }
 
 func (c *current) onNonEmptyRecordType1(first, rest interface{}) (interface{}, error) {
-	fields := rest.([]interface{})
-	content := first.(RecordType)
-	for _, field := range fields {
-		for k, v := range field.(RecordType) {
+	entries := append([]interface{}{first}, rest.([]interface{})...)
+	content := RecordType{}
+	var labels []string
+	for _, entry := range entries {
+		for k, v := range entry.(positioned).value.(RecordType) {
 			if _, ok := content[k]; ok {
 				return nil, fmt.Errorf("Duplicate field %s in record", k)
 			}
 			content[k] = v
+			labels = append(labels, k)
+		}
+	}
+	if recorder := commentRecorder(c); recorder != nil {
+		for i, entry := range entries {
+			recorder.Field(entry.(positioned).offset, content, labels[i])
 		}
+		recorder.Order(content, labels)
 	}
 	return content, nil
 
@@ -22267,7 +22347,7 @@ func (p *parser) callonRecordTypeEntry70() (interface{}, error) {
 }
 
 func (c *current) onRecordTypeEntry1(name, expr interface{}) (interface{}, error) {
-	return RecordType{name.(string): expr.(Term)}, nil
+	return positioned{RecordType{name.(string): expr.(Term)}, c.pos.offset}, nil
 }
 
 func (p *parser) callonRecordTypeEntry1() (interface{}, error) {
@@ -22287,10 +22367,12 @@ func (p *parser) callonMoreRecordLiteral1() (interface{}, error) {
 }
 
 func (c *current) onNonEmptyRecordLiteral1(first, rest interface{}) (interface{}, error) {
-	fields := rest.([]interface{})
-	content := first.(RecordLit)
-	for _, field := range fields {
-		for k, v := range field.(RecordLit) {
+	entries := append([]interface{}{first}, rest.([]interface{})...)
+	content := RecordLit{}
+	var labels []string
+	recorder := commentRecorder(c)
+	for _, entry := range entries {
+		for k, v := range entry.(positioned).value.(RecordLit) {
 			if _, ok := content[k]; ok {
 				content[k] = Op{
 					OpCode: RecordMergeOp,
@@ -22299,9 +22381,17 @@ func (c *current) onNonEmptyRecordLiteral1(first, rest interface{}) (interface{}
 				}
 			} else {
 				content[k] = v
+				labels = append(labels, k)
+			}
+			if recorder != nil {
+				recorder.Field(entry.(positioned).offset, content, k)
 			}
 		}
 	}
+	recordFieldOrder(c, labels)
+	if recorder != nil {
+		recorder.Order(content, labels)
+	}
 	return content, nil
 
 }
@@ -22396,9 +22486,9 @@ func (p *parser) callonRecordLiteralEntry70() (interface{}, error) {
 func (c *current) onRecordLiteralEntry1(name, val interface{}) (interface{}, error) {
 	if _, ok := val.([]byte); ok {
 		// punned entry
-		return RecordLit{name.(string): Var{Name: name.(string)}}, nil
+		return positioned{RecordLit{name.(string): Var{Name: name.(string)}}, c.pos.offset}, nil
 	}
-	return RecordLit{name.(string): val.(Term)}, nil
+	return positioned{RecordLit{name.(string): val.(Term)}, c.pos.offset}, nil
 }
 
 func (p *parser) callonRecordLiteralEntry1() (interface{}, error) {
@@ -22493,6 +22583,7 @@ func (c *current) onRecordLiteralNormalEntry1(children, expr interface{}) (inter
 	for i := len(children.([]interface{})) - 1; i >= 0; i-- {
 		child := children.([]interface{})[i].([]interface{})[3].(string)
 		rest = RecordLit{child: rest}
+		recordFieldOrder(c, []string{child})
 	}
 	return rest, nil
 }
@@ -22516,27 +22607,31 @@ func (p *parser) callonUnionType3() (interface{}, error) {
 func (c *current) onNonEmptyUnionType1(first, rest interface{}) (interface{}, error) {
 	alternatives := make(UnionType)
 	first2 := first.([]interface{})
+	labels := []string{first2[0].(string)}
 	if first2[1] == nil {
 		alternatives[first2[0].(string)] = nil
 	} else {
 		alternatives[first2[0].(string)] = first2[1].([]interface{})[3].(Term)
 	}
-	if rest == nil {
-		return UnionType(alternatives), nil
-	}
-	for _, alternativeSyntax := range rest.([]interface{}) {
-		alternative := alternativeSyntax.([]interface{})[3].([]interface{})
-		name := alternative[0].(string)
-		if _, ok := alternatives[name]; ok {
-			return nil, fmt.Errorf("Duplicate alternative %s in union", name)
-		}
+	if rest != nil {
+		for _, alternativeSyntax := range rest.([]interface{}) {
+			alternative := alternativeSyntax.([]interface{})[3].([]interface{})
+			name := alternative[0].(string)
+			if _, ok := alternatives[name]; ok {
+				return nil, fmt.Errorf("Duplicate alternative %s in union", name)
+			}
 
-		if alternative[1] == nil {
-			alternatives[name] = nil
-		} else {
-			alternatives[name] = alternative[1].([]interface{})[3].(Term)
+			if alternative[1] == nil {
+				alternatives[name] = nil
+			} else {
+				alternatives[name] = alternative[1].([]interface{})[3].(Term)
+			}
+			labels = append(labels, name)
 		}
 	}
+	if recorder := commentRecorder(c); recorder != nil {
+		recorder.Order(alternatives, labels)
+	}
 	return alternatives, nil
 }
 
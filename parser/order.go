@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+)
+
+// A FieldOrder records the order in which the fields of record
+// literals appear in Dhall source, so that records can be output with
+// their fields in the same order as the source.  Record it with the
+// RecordFieldOrder Option.  The same FieldOrder can record the
+// fields of several parses, such as a file and its imports.
+type FieldOrder struct {
+	// ranks holds the position at which each label first appeared
+	ranks map[string]int
+	// orders holds the order of each record literal's labels, keyed
+	// by orderKey of the labels
+	orders map[string][]string
+}
+
+// NewFieldOrder returns an empty FieldOrder.
+func NewFieldOrder() *FieldOrder {
+	return &FieldOrder{
+		ranks:  map[string]int{},
+		orders: map[string][]string{},
+	}
+}
+
+func (o *FieldOrder) record(labels []string) {
+	for _, label := range labels {
+		if _, ok := o.ranks[label]; !ok {
+			o.ranks[label] = len(o.ranks)
+		}
+	}
+	key := orderKey(labels)
+	if _, ok := o.orders[key]; !ok {
+		o.orders[key] = append([]string(nil), labels...)
+	}
+}
+
+// Sort sorts labels, the field names of a record, into source order.
+// If a record literal with exactly these fields was recorded, its
+// order is used.  Otherwise, such as for a record made by merging
+// other records, fields are sorted by where they first appeared in
+// any record literal, and fields which never appeared come last in
+// alphabetical order.  A nil FieldOrder sorts labels alphabetically.
+func (o *FieldOrder) Sort(labels []string) {
+	if o == nil {
+		sort.Strings(labels)
+		return
+	}
+	if order, ok := o.orders[orderKey(labels)]; ok {
+		copy(labels, order)
+		return
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		ri, iok := o.ranks[labels[i]]
+		rj, jok := o.ranks[labels[j]]
+		switch {
+		case iok && jok:
+			return ri < rj
+		case iok || jok:
+			return iok
+		default:
+			return labels[i] < labels[j]
+		}
+	})
+}
+
+// orderKey returns a key identifying a set of labels, regardless of
+// their order.
+func orderKey(labels []string) string {
+	sorted := append([]string(nil), labels...)
+	sort.Strings(sorted)
+	// labels can contain almost anything, but not NUL
+	return strings.Join(sorted, "\x00")
+}
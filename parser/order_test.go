@@ -0,0 +1,40 @@
+package parser_test
+
+import (
+	"github.com/philandstuff/dhall-golang/v6/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("FieldOrder",
+	func(source string, labels, expected []string) {
+		order := parser.NewFieldOrder()
+		_, err := parser.Parse("test", []byte(source), parser.RecordFieldOrder(order))
+		Expect(err).ToNot(HaveOccurred())
+		order.Sort(labels)
+		Expect(labels).To(Equal(expected))
+	},
+	Entry("record literal", `{ kind = 1, apiVersion = 2 }`,
+		[]string{"apiVersion", "kind"}, []string{"kind", "apiVersion"}),
+	Entry("nested record literal", `{ z = { y = 1, x = 2 }, a = 3 }`,
+		[]string{"x", "y"}, []string{"y", "x"}),
+	Entry("dotted fields", `{ a.z = 1, a.y = 2 }`,
+		[]string{"y", "z"}, []string{"z", "y"}),
+	Entry("merged record literals", `{ c = 1, b = 2 } // { a = 3 }`,
+		[]string{"a", "b", "c"}, []string{"c", "b", "a"}),
+	Entry("exact match before first appearance", `[ { b = 1, a = 2 }, { c = 3, a = 4 } ]`,
+		[]string{"a", "c"}, []string{"c", "a"}),
+	Entry("unknown fields last", `{ c = 1, b = 2 } // x`,
+		[]string{"y", "b", "x", "c"}, []string{"c", "b", "x", "y"}),
+)
+
+var _ = Describe("FieldOrder", func() {
+	It("Sorts alphabetically when nil", func() {
+		var order *parser.FieldOrder
+		labels := []string{"b", "c", "a"}
+		order.Sort(labels)
+		Expect(labels).To(Equal([]string{"a", "b", "c"}))
+	})
+})
@@ -10,10 +10,29 @@ import (
 
 //go:generate pigeon -optimize-grammar -optimize-parser -o internal/dhall.go internal/dhall.peg
 
+// An Option configures parsing.
+type Option struct {
+	opt internal.Option
+}
+
+// RecordFieldOrder is an Option which records the order of the fields
+// of each record literal parsed in order.
+func RecordFieldOrder(order *FieldOrder) Option {
+	return Option{internal.GlobalStore("fieldOrder", order.record)}
+}
+
+func internalOptions(opts []Option) []internal.Option {
+	var internalOpts []internal.Option
+	for _, opt := range opts {
+		internalOpts = append(internalOpts, opt.opt)
+	}
+	return internalOpts
+}
+
 // Parse parses the data from b using filename as information in the
 // error messages.
-func Parse(filename string, b []byte) (term.Term, error) {
-	result, err := internal.Parse(filename, b)
+func Parse(filename string, b []byte, opts ...Option) (term.Term, error) {
+	result, err := internal.Parse(filename, b, internalOptions(opts)...)
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +45,8 @@ func Parse(filename string, b []byte) (term.Term, error) {
 }
 
 // ParseFile parses the file identified by filename.
-func ParseFile(filename string) (term.Term, error) {
-	result, err := internal.ParseFile(filename)
+func ParseFile(filename string, opts ...Option) (term.Term, error) {
+	result, err := internal.ParseFile(filename, internalOptions(opts)...)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +60,8 @@ func ParseFile(filename string) (term.Term, error) {
 
 // ParseReader parses the data from r using filename as information in
 // the error messages.
-func ParseReader(filename string, r io.Reader) (term.Term, error) {
-	result, err := internal.ParseReader(filename, r)
+func ParseReader(filename string, r io.Reader, opts ...Option) (term.Term, error) {
+	result, err := internal.ParseReader(filename, r, internalOptions(opts)...)
 	if err != nil {
 		return nil, err
 	}
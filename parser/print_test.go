@@ -0,0 +1,86 @@
+package parser_test
+
+import (
+	"fmt"
+
+	. "github.com/philandstuff/dhall-golang/v6/internal"
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	. "github.com/philandstuff/dhall-golang/v6/term"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// PrintAndParse checks that t prints as expected, and that what it
+// prints parses back as t.
+func PrintAndParse(t Term, expected string) {
+	Expect(fmt.Sprint(t)).To(Equal(expected))
+	Expect(parser.Parse("test", []byte(expected))).To(Equal(t))
+}
+
+var _ = Describe("Printing", func() {
+	x, y, z := NewVar("x"), NewVar("y"), NewVar("z")
+	DescribeTable("Terms print as Dhall which parses back", PrintAndParse,
+		Entry("Var with index", Var{"x", 1}, `x@1`),
+		Entry("keyword label", Field{x, "if"}, "x.`if`"),
+		Entry("quoted Var", NewVar("a b"), "`a b`"),
+		Entry("builtin label", Lambda{"Bool", Type, NewVar("Bool")}, "λ(`Bool` : Type) → `Bool`"),
+		Entry("builtin let label", NewLet(NewVar("Natural/fold"), Binding{Variable: "Natural/fold", Value: NaturalLit(1)}),
+			"let `Natural/fold` = 1 in `Natural/fold`"),
+		Entry("reserved field labels", Field{RecordLit{"True": x, "Type": y}, "Type"}, "{ `True` = x, `Type` = y }.`Type`"),
+		Entry("lambda", Lambda{"x", Natural, NaturalPlus(x, NaturalLit(1))}, `λ(x : Natural) → x + 1`),
+		Entry("pi", Pi{"a", Type, NewAnonPi(NewVar("a"), NewVar("a"))}, `∀(a : Type) → a → a`),
+		Entry("function domain", NewAnonPi(NewAnonPi(Natural, Bool), Bool), `(Natural → Bool) → Bool`),
+		Entry("lambda argument", Apply(Lambda{"x", Natural, x}, NaturalLit(1)), `(λ(x : Natural) → x) 1`),
+		Entry("application", Apply(x, y, Apply(z, x)), `x y (z x)`),
+		Entry("application of merge", Apply(Merge{Handler: x, Union: y}, z), `merge x y z`),
+		Entry("merge with annotation", Merge{x, y, Apply(List, Natural)}, `merge x y : List Natural`),
+		Entry("left associative operators", NaturalPlus(NaturalPlus(x, y), z), `x + y + z`),
+		Entry("right operand", NaturalPlus(x, NaturalPlus(y, z)), `x + (y + z)`),
+		Entry("mixed operators", NaturalTimes(NaturalPlus(x, y), Apply(z, x)), `(x + y) * z x`),
+		Entry("equivalence", Equivalent(NaturalPlus(x, y), NaturalLit(1)), `x + y ≡ 1`),
+		Entry("operand of equivalence", NaturalPlus(Equivalent(x, y), z), `(x ≡ y) + z`),
+		Entry("completion", Op{CompleteOp, Field{x, "Type"}, RecordLit{"a": y}}, "x.`Type`::{ a = y }"),
+		Entry("annotation", Annot{NaturalPlus(x, y), Natural}, `x + y : Natural`),
+		Entry("annotated annotation", Annot{Annot{x, Natural}, Natural}, `(x : Natural) : Natural`),
+		Entry("let", NewLet(z,
+			Binding{Variable: "x", Annotation: Natural, Value: NaturalLit(1)},
+			Binding{Variable: "y", Value: x},
+		), `let x : Natural = 1 let y = x in z`),
+		Entry("nested let", NewLet(NewLet(y, Binding{Variable: "y", Value: x}), Binding{Variable: "x", Value: z}),
+			`let x = z in let y = x in y`),
+		Entry("if", If{x, y, Lambda{"z", Bool, z}}, `if x then y else λ(z : Bool) → z`),
+		Entry("empty list", EmptyList{Apply(List, Natural)}, `[] : List Natural`),
+		Entry("list", NewList(EmptyList{Apply(List, Natural)}, x), `[ [] : List Natural, x ]`),
+		Entry("Some", Some{Apply(x, y)}, `Some (x y)`),
+		Entry("text", TextLit{Chunks: Chunks{{Prefix: "a$", Expr: NaturalPlus(x, y)}}, Suffix: "\"b\n"}, `"a\u0024${x + y}\"b\n"`),
+		Entry("record type", RecordType{"b": Natural, "a": Apply(List, Text)}, `{ a : List Text, b : Natural }`),
+		Entry("record literal", RecordLit{"a": NaturalLit(1), "Some": x}, "{ `Some` = x, a = 1 }"),
+		Entry("union type", UnionType{"A": Natural, "B": nil}, `< A : Natural | B >`),
+		Entry("field of application", Field{Apply(x, y), "a"}, `(x y).a`),
+		Entry("field of field", Field{Field{x, "a"}, "b"}, `x.a.b`),
+		Entry("projection", Project{x, []string{"b", "a"}}, `x.{ b, a }`),
+		Entry("empty projection", Project{x, []string{}}, `x.{}`),
+		Entry("projection by type", ProjectType{x, RecordType{"a": Natural}}, `x.({ a : Natural })`),
+		Entry("toMap", ToMap{Record: x}, `toMap x`),
+		Entry("toMap with annotation", ToMap{x, Apply(List, y)}, `toMap x : List y`),
+		Entry("assert", Assert{Equivalent(x, y)}, `assert : x ≡ y`),
+		Entry("with", With{x, []string{"a", "b"}, NaturalPlus(y, z)}, `x with a.b = y + z`),
+		Entry("chained with", With{With{x, []string{"a"}, y}, []string{"b"}, z}, `x with a = y with b = z`),
+		Entry("with of a record", With{RecordLit{"a": y}, []string{"a"}, z}, `{ a = y } with a = z`),
+		Entry("import", NewLocalImport("foo.dhall", Code), `./foo.dhall`),
+		Entry("text import", NewEnvVarImport("FOO", RawText), `env:FOO as Text`),
+		Entry("location import", NewRemoteImport("https://example.com/foo", Location), `https://example.com/foo as Location`),
+		Entry("import argument", Apply(x, NewLocalImport("foo.dhall", Code)), `x ./foo.dhall`),
+		Entry("field of an import", Field{NewLocalImport("foo.dhall", Code), "a"}, `(./foo.dhall).a`),
+		Entry("import alternative", Op{ImportAltOp, NewEnvVarImport("FOO", Code), Missing{}.AsLocation()},
+			`env:FOO ? < Environment : Text | Local : Text | Missing | Remote : Text >.Missing`),
+	)
+	It("Prints import hashes", func() {
+		src := `./foo.dhall sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef`
+		expr, err := parser.Parse("test", []byte(src))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fmt.Sprint(expr)).To(Equal(src))
+	})
+})
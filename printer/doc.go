@@ -0,0 +1,172 @@
+package printer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// A doc is a document to be laid out, in the style of Wadler's "A
+// prettier printer".  Each group in a doc is laid out flat, on one
+// line, if it fits within the line width, and broken over several
+// lines if not.
+type doc interface{}
+
+type (
+	// text is a string containing no newlines.
+	text string
+
+	// line is a newline, or flat if its group is laid out flat.
+	line struct{ flat string }
+
+	// hardline is always a newline.  A group containing one can't
+	// be laid out flat.
+	hardline struct{}
+
+	// cat is a sequence of docs.
+	cat []doc
+
+	// nest indents the lines of a doc by a further n columns.
+	nest struct {
+		n int
+		d doc
+	}
+
+	// align indents the lines of a doc to the column it starts at.
+	align struct{ d doc }
+
+	// group is laid out flat if it fits, or broken otherwise.
+	group struct{ d doc }
+
+	// alt is flat if its group is laid out flat, or broken
+	// otherwise.
+	alt struct{ flat, broken doc }
+)
+
+var (
+	space     = line{flat: " "}
+	lineBreak = line{flat: ""}
+)
+
+// An item is a doc waiting to be laid out, with its indentation and
+// whether it is flat.
+type item struct {
+	indent int
+	flat   bool
+	d      doc
+}
+
+// A renderer lays out docs within a line width.
+type renderer struct {
+	width int
+	out   strings.Builder
+	col   int
+	// pending is the indentation of the current line, which is only
+	// written out before some text, so that blank lines have no
+	// trailing whitespace.
+	pending int
+}
+
+func (r *renderer) render(d doc, indent int) {
+	r.pending = indent
+	r.col = indent
+	stack := []item{{indent: indent, d: d}}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		switch d := it.d.(type) {
+		case text:
+			r.write(string(d))
+		case line:
+			if it.flat {
+				r.write(d.flat)
+			} else {
+				r.newline(it.indent)
+			}
+		case hardline:
+			r.newline(it.indent)
+		case cat:
+			for i := len(d) - 1; i >= 0; i-- {
+				stack = append(stack, item{it.indent, it.flat, d[i]})
+			}
+		case nest:
+			stack = append(stack, item{it.indent + d.n, it.flat, d.d})
+		case align:
+			stack = append(stack, item{r.col, it.flat, d.d})
+		case group:
+			flat := it.flat || r.fits(item{it.indent, true, d.d}, stack)
+			stack = append(stack, item{it.indent, flat, d.d})
+		case alt:
+			if it.flat {
+				stack = append(stack, item{it.indent, true, d.flat})
+			} else {
+				stack = append(stack, item{it.indent, false, d.broken})
+			}
+		default:
+			panic("unknown doc")
+		}
+	}
+}
+
+func (r *renderer) write(s string) {
+	if s == "" {
+		return
+	}
+	if r.pending > 0 {
+		r.out.WriteString(strings.Repeat(" ", r.pending))
+		r.pending = 0
+	}
+	r.out.WriteString(s)
+	r.col += utf8.RuneCountInString(s)
+}
+
+func (r *renderer) newline(indent int) {
+	r.out.WriteByte('\n')
+	r.pending = indent
+	r.col = indent
+}
+
+// fits reports whether next, followed by rest up to its first
+// newline, fits in what is left of the current line.
+func (r *renderer) fits(next item, rest []item) bool {
+	remaining := r.width - r.col
+	stack := []item{next}
+	for remaining >= 0 {
+		if len(stack) == 0 {
+			if len(rest) == 0 {
+				return true
+			}
+			stack = append(stack, rest[len(rest)-1])
+			rest = rest[:len(rest)-1]
+		}
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		switch d := it.d.(type) {
+		case text:
+			remaining -= utf8.RuneCountInString(string(d))
+		case line:
+			if !it.flat {
+				return true
+			}
+			remaining -= utf8.RuneCountInString(d.flat)
+		case hardline:
+			return !it.flat
+		case cat:
+			for i := len(d) - 1; i >= 0; i-- {
+				stack = append(stack, item{it.indent, it.flat, d[i]})
+			}
+		case nest:
+			stack = append(stack, item{it.indent, it.flat, d.d})
+		case align:
+			stack = append(stack, item{it.indent, it.flat, d.d})
+		case group:
+			stack = append(stack, item{it.indent, it.flat, d.d})
+		case alt:
+			if it.flat {
+				stack = append(stack, item{it.indent, true, d.flat})
+			} else {
+				stack = append(stack, item{it.indent, false, d.broken})
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,455 @@
+/*
+Package printer prints Terms as Dhall source, in the standard layout
+of the `dhall format` tool.
+
+Expressions which fit within the line width are printed on one line;
+longer ones are broken over several lines, with records and lists in
+the leading-comma style, and Text containing newlines printed as
+multi-line literals.  The printed source always parses back as the
+Term it was printed from.
+*/
+package printer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// DefaultWidth is the line width used by the standard format.
+const DefaultWidth = 80
+
+// A Config controls the layout of printed Dhall source.
+type Config struct {
+	// Width is the line width the printer tries to fit within.  If
+	// it is zero, DefaultWidth is used.
+	Width int
+	// Indent is the number of columns by which every line is
+	// indented, for printing an expression to be embedded in other
+	// text.
+	Indent int
+	// Comments, if set, holds the comments recorded when the Term
+	// being printed was parsed.  They are printed in place, with
+	// the footer after the Term, and the fields of records and
+	// unions are kept in source order rather than sorted.
+	Comments *parser.Comments
+}
+
+// A printer builds the docs for Terms.
+type printer struct {
+	comments *parser.Comments
+}
+
+// Fprint prints t to w in the standard format.
+func (c *Config) Fprint(w io.Writer, t term.Term) error {
+	_, err := io.WriteString(w, c.Sprint(t))
+	return err
+}
+
+// Sprint returns t printed in the standard format.
+func (c *Config) Sprint(t term.Term) string {
+	r := renderer{width: c.Width}
+	if r.width == 0 {
+		r.width = DefaultWidth
+	}
+	p := printer{comments: c.Comments}
+	var header cat
+	if h := c.Comments.Header(); h != "" {
+		for _, l := range strings.Split(strings.TrimSuffix(h, "\n"), "\n") {
+			header = append(header, text(l), hardline{})
+		}
+	}
+	var footer cat
+	for _, comment := range c.Comments.Footer() {
+		for _, l := range strings.Split(comment, "\n") {
+			footer = append(footer, hardline{}, text(l))
+		}
+	}
+	r.render(cat{header, p.termDoc(t), footer}, c.Indent)
+	return r.out.String()
+}
+
+// Fprint prints t to w in the standard format, with the default
+// Config.
+func Fprint(w io.Writer, t term.Term) error {
+	return (&Config{}).Fprint(w, t)
+}
+
+// Sprint returns t printed in the standard format, with the default
+// Config.
+func Sprint(t term.Term) string {
+	return (&Config{}).Sprint(t)
+}
+
+// label returns l as it must be written in Dhall source, quoted if
+// necessary.
+func label(l string) text {
+	return text(term.QuoteLabel(l))
+}
+
+// parens returns the doc for t, in parentheses if t would otherwise
+// not parse at grammar level lvl.  Broken parentheses go on their own
+// lines, as in
+//
+//  ( λ(x : Natural) →
+//      x
+//  )
+func (p *printer) parens(t term.Term, lvl int) doc {
+	if term.Level(t) >= lvl {
+		return p.termDoc(t)
+	}
+	return group{align{cat{
+		text("("), alt{text(""), text(" ")},
+		align{p.termDoc(t)},
+		lineBreak, text(")"),
+	}}}
+}
+
+// termDoc returns the doc for t, at t's own grammar level.
+func (p *printer) termDoc(t term.Term) doc {
+	switch t := t.(type) {
+	case term.Lambda, term.Pi:
+		return p.arrowsDoc(t)
+	case term.App:
+		return p.applicationDoc(t)
+	case term.Some, term.Merge, term.ToMap:
+		return p.applicationDoc(t)
+	case term.Let:
+		return p.letDoc(t)
+	case term.Annot:
+		return annotated(p.parens(t.Expr, term.OperatorLevel), p.termDoc(t.Annotation))
+	case term.If:
+		return group{align{cat{
+			text("if "), align{p.termDoc(t.Cond)}, space,
+			text("then "), align{p.termDoc(t.T)}, space,
+			text("else "), align{p.termDoc(t.F)},
+		}}}
+	case term.Op:
+		return p.operatorDoc(t)
+	case term.TextLit:
+		if multiLine(t) {
+			return p.multiLineDoc(t)
+		}
+		return p.singleLineDoc(t)
+	case term.EmptyList:
+		return cat{text("[] : "), p.parens(t.Type, term.ApplicationLevel)}
+	case term.NonEmptyList:
+		items := make([]doc, len(t))
+		for i, item := range t {
+			items[i] = p.termDoc(item)
+		}
+		return brackets("[", "]", items)
+	case term.RecordType:
+		if len(t) == 0 {
+			return text("{}")
+		}
+		return brackets("{", "}", p.fieldDocs(t))
+	case term.RecordLit:
+		if len(t) == 0 {
+			return text("{=}")
+		}
+		return brackets("{", "}", p.fieldDocs(t))
+	case term.UnionType:
+		if len(t) == 0 {
+			return text("<>")
+		}
+		return p.unionDoc(t)
+	case term.Field:
+		return cat{p.parens(t.Record, term.SelectorLevel), text("."), label(t.FieldName)}
+	case term.Project:
+		if len(t.FieldNames) == 0 {
+			return cat{p.parens(t.Record, term.SelectorLevel), text(".{}")}
+		}
+		labels := make([]string, len(t.FieldNames))
+		for i, name := range t.FieldNames {
+			labels[i] = string(label(name))
+		}
+		return cat{p.parens(t.Record, term.SelectorLevel), text(".{ " + strings.Join(labels, ", ") + " }")}
+	case term.ProjectType:
+		return cat{p.parens(t.Record, term.SelectorLevel), text(".("), align{p.termDoc(t.Selector)}, text(")")}
+	case term.Assert:
+		return cat{text("assert : "), align{p.termDoc(t.Annotation)}}
+	case term.With:
+		return p.withDoc(t)
+	}
+	// everything else is atomic, and prints itself correctly
+	return text(fmt.Sprint(t))
+}
+
+// arrowsDoc returns the doc for a chain of lambdas and pis.  Broken,
+// each binder goes on its own line, and the final body is indented:
+//
+//  λ(a : Type) →
+//  λ(x : a) →
+//    x
+func (p *printer) arrowsDoc(t term.Term) doc {
+	var binders cat
+	for {
+		switch fn := t.(type) {
+		case term.Lambda:
+			binders = append(binders, cat{
+				text("λ("), label(fn.Label), text(" : "), align{p.termDoc(fn.Type)}, text(") →"), space,
+			})
+			t = fn.Body
+			continue
+		case term.Pi:
+			if fn.Label == "_" {
+				binders = append(binders, cat{p.parens(fn.Type, term.OperatorLevel), text(" →"), space})
+			} else {
+				binders = append(binders, cat{
+					text("∀("), label(fn.Label), text(" : "), align{p.termDoc(fn.Type)}, text(") →"), space,
+				})
+			}
+			t = fn.Body
+			continue
+		}
+		break
+	}
+	// the last binder's line break belongs to the indented body
+	last := binders[len(binders)-1].(cat)
+	binders[len(binders)-1] = last[:len(last)-1]
+	return group{align{cat{binders, nest{2, cat{space, p.termDoc(t)}}}}}
+}
+
+// applicationDoc returns the doc for a function applied to its
+// arguments.  Broken, each argument goes on its own indented line.
+func (p *printer) applicationDoc(t term.Term) doc {
+	var args []term.Term
+	var head doc
+	for head == nil {
+		switch app := t.(type) {
+		case term.App:
+			args = append([]term.Term{app.Arg}, args...)
+			t = app.Fn
+		case term.Some:
+			head = text("Some")
+			args = append([]term.Term{app.Val}, args...)
+		case term.Merge:
+			if app.Annotation != nil && len(args) > 0 {
+				// an annotated head applied to arguments
+				head = p.parens(app, term.ImportLevel)
+			} else if app.Annotation != nil {
+				// only the outermost term can be annotated
+				return annotated(
+					p.applicationDoc(term.Merge{Handler: app.Handler, Union: app.Union}),
+					p.parens(app.Annotation, term.ApplicationLevel))
+			} else {
+				head = text("merge")
+				args = append([]term.Term{app.Handler, app.Union}, args...)
+			}
+		case term.ToMap:
+			if app.Type != nil && len(args) > 0 {
+				head = p.parens(app, term.ImportLevel)
+			} else if app.Type != nil {
+				return annotated(
+					p.applicationDoc(term.ToMap{Record: app.Record}),
+					p.parens(app.Type, term.ApplicationLevel))
+			} else {
+				head = text("toMap")
+				args = append([]term.Term{app.Record}, args...)
+			}
+		default:
+			head = p.parens(t, term.ImportLevel)
+		}
+	}
+	var argDocs cat
+	for _, arg := range args {
+		argDocs = append(argDocs, space, p.parens(arg, term.ImportLevel))
+	}
+	return group{align{cat{head, nest{2, argDocs}}}}
+}
+
+// annotated returns the doc for expr : annotation.
+func annotated(expr, annotation doc) doc {
+	return group{align{cat{expr, space, text(": "), align{annotation}}}}
+}
+
+// letDoc returns the doc for a let expression.  Broken, the bindings
+// are separated by blank lines:
+//
+//  let x = 1
+//
+//  let y = 2
+//
+//  in  x + y
+func (p *printer) letDoc(l term.Let) doc {
+	blankLine := cat{space, lineBreak}
+	var out cat
+	for i, b := range l.Bindings {
+		out = append(out, commentsDoc(p.comments.Binding(&l.Bindings[i])))
+		if b.Annotation != nil {
+			out = append(out, group{cat{
+				text("let "), label(b.Variable),
+				nest{4, cat{
+					space, text(": "), align{p.termDoc(b.Annotation)},
+					space, text("= "), align{p.termDoc(b.Value)},
+				}},
+			}})
+		} else {
+			out = append(out, group{cat{
+				text("let "), label(b.Variable), text(" ="),
+				nest{6, cat{space, p.termDoc(b.Value)}},
+			}})
+		}
+		out = append(out, blankLine)
+	}
+	out = append(out, text("in"), alt{text(" "), text("  ")}, align{p.termDoc(l.Body)})
+	return group{align{out}}
+}
+
+// operatorDoc returns the doc for a chain of the same left-associative
+// operator.  Broken, each operator leads its operand's line:
+//
+//    x
+//  + y
+//  + z
+func (p *printer) operatorDoc(op term.Op) doc {
+	sym := op.OpCode.Symbol()
+	if op.OpCode == term.CompleteOp {
+		// both sides of :: are selector expressions
+		return cat{p.parens(op.L, term.SelectorLevel), text(sym), p.parens(op.R, term.SelectorLevel)}
+	}
+	lvl := term.Level(op)
+	operands := []term.Term{op.R}
+	l := op.L
+	for {
+		lop, ok := l.(term.Op)
+		if !ok || lop.OpCode != op.OpCode {
+			break
+		}
+		operands = append([]term.Term{lop.R}, operands...)
+		l = lop.L
+	}
+	out := cat{
+		alt{text(""), text(strings.Repeat(" ", len([]rune(sym))+1))},
+		align{p.parens(l, lvl)},
+	}
+	for _, operand := range operands {
+		out = append(out, space, text(sym+" "), align{p.parens(operand, lvl+1)})
+	}
+	return group{align{out}}
+}
+
+// brackets returns the doc for a list, record type or record literal
+// with the given items.  Broken, it uses leading commas:
+//
+//  { a = 1
+//  , b = 2
+//  }
+func brackets(open, close string, items []doc) doc {
+	out := cat{text(open + " ")}
+	for i, item := range items {
+		if i > 0 {
+			out = append(out, lineBreak, text(", "))
+		}
+		out = append(out, align{item})
+	}
+	out = append(out, space, text(close))
+	return group{align{out}}
+}
+
+// fieldDocs returns the docs for the fields of a record type or
+// literal, each preceded by its comments.  A field's value is
+// indented on the next line if it doesn't fit.
+func (p *printer) fieldDocs(record term.Term) []doc {
+	var fields map[string]term.Term
+	var sep string
+	switch record := record.(type) {
+	case term.RecordType:
+		fields, sep = record, " :"
+	case term.RecordLit:
+		fields, sep = record, " ="
+	}
+	var docs []doc
+	for _, name := range p.labels(record, fields) {
+		docs = append(docs, cat{
+			commentsDoc(p.comments.Field(record, name)),
+			group{cat{
+				label(name), text(sep),
+				nest{2, cat{space, p.termDoc(fields[name])}},
+			}},
+		})
+	}
+	return docs
+}
+
+func (p *printer) unionDoc(u term.UnionType) doc {
+	out := cat{text("< ")}
+	for i, name := range p.labels(u, u) {
+		if i > 0 {
+			out = append(out, space, text("| "))
+		}
+		if u[name] == nil {
+			out = append(out, label(name))
+			continue
+		}
+		out = append(out, align{group{cat{
+			label(name), text(" :"),
+			nest{2, cat{space, p.termDoc(u[name])}},
+		}}})
+	}
+	out = append(out, space, text(">"))
+	return group{align{out}}
+}
+
+// withDoc returns the doc for a chain of with expressions.  Broken,
+// each update goes on its own indented line.
+func (p *printer) withDoc(w term.With) doc {
+	var updates []term.With
+	var record term.Term = w
+	for {
+		next, ok := record.(term.With)
+		if !ok {
+			break
+		}
+		updates = append([]term.With{next}, updates...)
+		record = next.Record
+	}
+	var updateDocs cat
+	for _, update := range updates {
+		path := make([]string, len(update.Path))
+		for i, l := range update.Path {
+			path[i] = string(label(l))
+		}
+		updateDocs = append(updateDocs, space,
+			text("with "+strings.Join(path, ".")+" = "),
+			align{p.parens(update.Value, term.OperatorLevel)})
+	}
+	return group{align{cat{p.parens(record, term.ImportLevel), nest{2, updateDocs}}}}
+}
+
+// labels returns the labels of a record or union, whose fields are
+// given, in source order if its Comments were recorded, or in sorted
+// order otherwise.
+func (p *printer) labels(record term.Term, fields map[string]term.Term) []string {
+	order := p.comments.Order(record)
+	if len(order) == len(fields) {
+		return order
+	}
+	labels := make([]string, 0, len(fields))
+	for l := range fields {
+		labels = append(labels, l)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// commentsDoc returns the doc for comments, each on its own lines
+// before whatever follows them.
+func commentsDoc(comments []string) doc {
+	var out cat
+	for _, comment := range comments {
+		for i, l := range strings.Split(comment, "\n") {
+			if i > 0 {
+				out = append(out, hardline{})
+			}
+			out = append(out, text(l))
+		}
+		out = append(out, hardline{})
+	}
+	return out
+}
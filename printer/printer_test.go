@@ -0,0 +1,183 @@
+package printer_test
+
+import (
+	"bytes"
+
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/printer"
+	. "github.com/philandstuff/dhall-golang/v6/term"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// PrintAndParse checks that source printed at the given width is
+// expected, and that it parses back as the same Term.
+func PrintAndParse(width int, source, expected string) {
+	t, err := parser.Parse("test", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	printed := (&printer.Config{Width: width}).Sprint(t)
+	Expect(printed).To(Equal(expected))
+	Expect(parser.Parse("test", []byte(printed))).To(Equal(t))
+}
+
+var _ = Describe("Sprint", func() {
+	DescribeTable("Layout", PrintAndParse,
+		Entry("short record", 80, `{b=2,a=[1,2]}`, `{ a = [ 1, 2 ], b = 2 }`),
+		Entry("long record", 20, `{b=2,a=[1,2]}`, `{ a = [ 1, 2 ]
+, b = 2
+}`),
+		Entry("long field", 20, `{ a = [ 100000, 200000, 300000 ] }`, `{ a =
+    [ 100000
+    , 200000
+    , 300000
+    ]
+}`),
+		Entry("long union", 15, `< A : Natural | B >`, `< A : Natural
+| B
+>`),
+		Entry("short let", 80, `let x = 1 let y = 2 in x + y`, `let x = 1 let y = 2 in x + y`),
+		Entry("long let", 20, `let x = 1 let y : Natural = 2 in x + y`, `let x = 1
+
+let y : Natural = 2
+
+in  x + y`),
+		Entry("long let binding", 20, `let xs = [ 100000, 200000 ] in xs`, `let xs =
+      [ 100000
+      , 200000
+      ]
+
+in  xs`),
+		Entry("long annotated let binding", 30,
+			`let f : Natural → Natural = λ(x : Natural) → x + 1 in f`, `let f
+    : Natural → Natural
+    = λ(x : Natural) → x + 1
+
+in  f`),
+		Entry("long lambdas", 30, `λ(a : Type) → λ(x : a) → { result = x }`, `λ(a : Type) →
+λ(x : a) →
+  { result = x }`),
+		Entry("long function type", 30, `∀(a : Type) → Optional a → List a`, `∀(a : Type) →
+Optional a →
+  List a`),
+		Entry("long application", 20, `List/fold Natural xs Natural f 0`, `List/fold
+  Natural
+  xs
+  Natural
+  f
+  0`),
+		Entry("long argument", 25, `f (λ(x : Natural) → x + 1000000)`, `f
+  ( λ(x : Natural) →
+      x + 1000000
+  )`),
+		Entry("long operators", 20, `"aaaaaaa" ++ "bbbbbbb" ++ "ccc"`, `   "aaaaaaa"
+++ "bbbbbbb"
+++ "ccc"`),
+		Entry("long if", 20, `if True then "aaaaa" else "bbbbb"`, `if True
+then "aaaaa"
+else "bbbbb"`),
+		Entry("multi-line text", 80, `{ a = "x\n  y\n\${z}${"w"}\n" }`, `{ a =
+    ''
+    x
+      y
+    ''${z}${"w"}
+    ''
+}`),
+		Entry("multi-line text with quotes", 80, `"''\n'a'\n"`, `''
+'''
+'a'
+''`),
+		Entry("text with only indented lines", 80, `"  x\n  y"`, `"  x\n  y"`),
+		Entry("text ending in a quote", 80, `"x\ny'"`, `"x\ny'"`),
+		Entry("text with an indented interpolated line", 80, `"\ta${x}\nb"`, `"\ta${x}\nb"`),
+		Entry("text with a space before an interpolation", 80, `" a${x}\nb"`, `" a${x}\nb"`),
+		Entry("text ending in a line of spaces", 80, `"a${x}\nb\n "`, `''
+a${x}
+b
+ ''`),
+		Entry("text with interpolated expressions", 20, `"a${{b=1}}\n${"c${ [1,2] }"}\n"`, `''
+a${{ b = 1 }}
+${"c${[ 1, 2 ]}"}
+''`),
+		Entry("text with long interpolated expressions", 15, `"a\n${f 100000 200000}\n"`, `''
+a
+${f
+    100000
+    200000}
+''`),
+		Entry("text with control characters", 80, `"x\n\u0000"`, `"x\n\u0000"`),
+		Entry("precedence", 80, `(1 + 2) * 3 + (4 ≡ 5) + [ 1 ] # [ 2 ]`, `(1 + 2) * 3 + (4 ≡ 5) + [ 1 ] # [ 2 ]`),
+		Entry("selectors", 80, `(f x).a.{ b, c }.(T)`, `(f x).a.{ b, c }.(T)`),
+		Entry("merge and toMap", 80, `merge (toMap x : T) (Some y z) : A`, `merge (toMap x : T) (Some y z) : A`),
+		Entry("annotated merge applied to arguments", 80, `(merge x y : T) z`, `(merge x y : T) z`),
+		Entry("annotated toMap applied to arguments", 80, `(toMap x : T) y z`, `(toMap x : T) y z`),
+		Entry("with", 80, `({ a = 1 } with a = 2) with b.c = 3`, `{ a = 1 } with a = 2 with b.c = 3`),
+		Entry("imports", 80, `./a.dhall ? env:B as Text`, `./a.dhall ? env:B as Text`),
+	)
+	It("Indents every line by Indent", func() {
+		t, err := parser.Parse("test", []byte(`{ a = 1, b = 2 }`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect((&printer.Config{Width: 15, Indent: 4}).Sprint(t)).To(Equal(`    { a = 1
+    , b = 2
+    }`))
+	})
+	It("Prints comments in place, with fields in source order", func() {
+		source := `-- header
+
+let x = 1 -- about y
+let y = { b = x,
+  {- about a
+     and more -}
+  a = < Z | Y > }
+in  y`
+		comments := parser.NewComments()
+		t, err := parser.Parse("test", []byte(source), parser.RecordComments(comments))
+		Expect(err).ToNot(HaveOccurred())
+		Expect((&printer.Config{Comments: comments}).Sprint(t)).To(Equal(`-- header
+
+let x = 1
+
+-- about y
+let y =
+      { b = x
+      , {- about a
+           and more -}
+        a = < Z | Y >
+      }
+
+in  y`))
+	})
+	It("Prints comments before and after an expression without bindings or fields", func() {
+		source := "-- header\n[ 1 -- one\n, 2 ] -- trailing\n{- more -}\n"
+		comments := parser.NewComments()
+		t, err := parser.Parse("test", []byte(source), parser.RecordComments(comments))
+		Expect(err).ToNot(HaveOccurred())
+		Expect((&printer.Config{Comments: comments}).Sprint(t)).To(Equal(`-- header
+[ 1, 2 ]
+-- one
+-- trailing
+{- more -}`))
+	})
+	It("Prints comments before the next binding or field", func() {
+		source := "let x = 1 in -- about y\nlet y = { a = f -- about b\n x, b = 2 } in y"
+		comments := parser.NewComments()
+		t, err := parser.Parse("test", []byte(source), parser.RecordComments(comments))
+		Expect(err).ToNot(HaveOccurred())
+		Expect((&printer.Config{Comments: comments}).Sprint(t)).To(Equal(`let x = 1
+
+in  -- about y
+    let y =
+          { a = f x
+          , -- about b
+            b = 2
+          }
+
+    in  y`))
+	})
+	It("Prints with the default width", func() {
+		var buf bytes.Buffer
+		Expect(printer.Fprint(&buf, NewList(NaturalLit(1), NaturalLit(2)))).To(Succeed())
+		Expect(buf.String()).To(Equal(`[ 1, 2 ]`))
+	})
+})
@@ -0,0 +1,138 @@
+package printer
+
+import (
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/term"
+)
+
+// multiLine reports whether t should be printed as a multi-line
+// literal: whether it contains a newline, and can be written as one
+// so that it parses back as itself.  Parsing a multi-line literal
+// strips the indentation common to all its lines, so t is checked by
+// printing and parsing it, with its interpolations replaced, both at
+// the margin and indented.
+func multiLine(t term.TextLit) bool {
+	placeholder := term.TextLit{Suffix: t.Suffix}
+	parts := []string{t.Suffix}
+	for _, chunk := range t.Chunks {
+		placeholder.Chunks = append(placeholder.Chunks, term.Chunk{Prefix: chunk.Prefix, Expr: term.NewVar("x")})
+		parts = append(parts, chunk.Prefix)
+	}
+	if !strings.Contains(strings.Join(parts, ""), "\n") {
+		return false
+	}
+	for _, part := range parts {
+		for _, r := range part {
+			if !multiLineChar(r) {
+				return false
+			}
+		}
+	}
+	d := (&printer{}).multiLineDoc(placeholder)
+	for _, indent := range []int{0, 2} {
+		r := renderer{width: DefaultWidth}
+		r.render(d, indent)
+		parsed, err := parser.Parse("-", []byte(r.out.String()))
+		if err != nil || !sameText(parsed, placeholder) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameText reports whether t is the TextLit want.
+func sameText(t term.Term, want term.TextLit) bool {
+	got, ok := t.(term.TextLit)
+	if !ok || got.Suffix != want.Suffix || len(got.Chunks) != len(want.Chunks) {
+		return false
+	}
+	for i, chunk := range got.Chunks {
+		if chunk != want.Chunks[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// multiLineChar reports whether r can appear unescaped in a
+// multi-line literal.
+func multiLineChar(r rune) bool {
+	switch {
+	case r == '\n' || r == '\t':
+		return true
+	case r < 0x20 || r == 0x7f:
+		return false
+	case r >= 0xd800 && r <= 0xdfff:
+		return false
+	}
+	// noncharacters, such as U+FFFE, can't appear in source
+	return r&0xfffe != 0xfffe
+}
+
+// multiLineDoc returns the doc for t as a multi-line literal, whose
+// lines are aligned with its opening quotes:
+//
+//  ''
+//  first line
+//  second line
+//  ''
+func (p *printer) multiLineDoc(t term.TextLit) doc {
+	out := cat{text("''"), hardline{}}
+	addText := func(s string) {
+		for i, l := range strings.Split(s, "\n") {
+			if i > 0 {
+				out = append(out, hardline{})
+			}
+			out = append(out, text(escapeMultiLine(l)))
+		}
+	}
+	for _, chunk := range t.Chunks {
+		addText(chunk.Prefix)
+		out = append(out, p.interpolationDoc(chunk.Expr))
+	}
+	addText(t.Suffix)
+	out = append(out, text("''"))
+	return align{out}
+}
+
+// singleLineDoc returns the doc for t as a double-quoted literal.
+func (p *printer) singleLineDoc(t term.TextLit) doc {
+	out := cat{text(`"`)}
+	for _, chunk := range t.Chunks {
+		out = append(out, text(escapeSingleLine(chunk.Prefix)), p.interpolationDoc(chunk.Expr))
+	}
+	return append(out, text(escapeSingleLine(t.Suffix)), text(`"`))
+}
+
+// interpolationDoc returns the doc for e interpolated into a literal.
+func (p *printer) interpolationDoc(e term.Term) doc {
+	return cat{text("${"), align{p.termDoc(e)}, text("}")}
+}
+
+// escapeSingleLine escapes s so that it can appear between the quotes
+// of a double-quoted literal.
+func escapeSingleLine(s string) string {
+	quoted := term.TextLit{Suffix: s}.String()
+	return quoted[1 : len(quoted)-1]
+}
+
+// escapeMultiLine escapes '' and ${ in s, which is part of a line of
+// a multi-line literal.
+func escapeMultiLine(s string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "''"):
+			out.WriteString("'''")
+			i++
+		case strings.HasPrefix(s[i:], "${"):
+			out.WriteString("''${")
+			i++
+		default:
+			out.WriteByte(s[i])
+		}
+	}
+	return out.String()
+}
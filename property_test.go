@@ -12,6 +12,7 @@ import (
 
 	"github.com/philandstuff/dhall-golang/v6/core"
 	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/printer"
 	"github.com/philandstuff/dhall-golang/v6/term"
 )
 
@@ -26,8 +27,19 @@ var (
 		func(i term.Term) int { return int(i.(term.IntegerLit)) },
 		gen.Int()).WithLabel("IntegerLit")
 	LeafExpr = gen.OneGenOf(NaturalLit, IntegerLit, BasicType).WithLabel("LeafExpr")
+	// TextString generates short strings of the characters which
+	// matter most when printing Text literals.
+	TextString = sized(8, gen.SliceOf(gen.OneConstOf(' ', '\t', '\n', 'a', '$', '{', '\'', '"')).
+			Map(func(rs []rune) string { return string(rs) }))
 )
 
+// sized limits the size of the slices and strings generated by g.
+func sized(size int, g gopter.Gen) gopter.Gen {
+	return func(params *gopter.GenParameters) *gopter.GenResult {
+		return g(params.WithSize(size))
+	}
+}
+
 func PlusOf(inner gopter.Gen) gopter.Gen {
 	return gen.Struct(reflect.TypeOf(term.Op{}), map[string]gopter.Gen{
 		"OpCode": gen.Const(term.PlusOp),
@@ -44,12 +56,31 @@ func TimesOf(inner gopter.Gen) gopter.Gen {
 	}).WithLabel("NaturalTimes")
 }
 
+func TextOf(inner gopter.Gen) gopter.Gen {
+	return gopter.CombineGens(
+		gen.IntRange(0, 2), TextString, inner, TextString, inner, TextString,
+	).Map(func(parts []interface{}) term.Term {
+		// parts holds the number of interpolations, then
+		// alternate strings and expressions
+		var t term.TextLit
+		for i := 0; i < parts[0].(int); i++ {
+			t.Chunks = append(t.Chunks, term.Chunk{
+				Prefix: parts[2*i+1].(string),
+				Expr:   parts[2*i+2].(term.Term),
+			})
+		}
+		t.Suffix = parts[5].(string)
+		return t
+	}).WithLabel("TextLit")
+}
+
 func ExprOf(inner gopter.Gen) gopter.Gen {
 	return gen.OneGenOf(
 		LeafExpr,
 		PlusOf(inner),
 		TimesOf(inner),
 		ListOf(inner),
+		TextOf(inner),
 	).WithLabel("ExprOf")
 }
 
@@ -78,8 +109,9 @@ func TestParseWhatYouWrite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping slow test in short mode")
 	}
-	t.Skip("TODO: This is broken on the nbe branch until we reproduce proper printing")
-	properties := gopter.NewProperties(nil)
+	params := gopter.DefaultTestParameters()
+	params.MinSuccessfulTests = 1000
+	properties := gopter.NewProperties(params)
 
 	properties.Property("written expressions parse back as themselves",
 		prop.ForAll(
@@ -92,6 +124,19 @@ func TestParseWhatYouWrite(t *testing.T) {
 			},
 			ExprOf(ExprOf(LeafExpr)),
 		))
+	properties.Property("printed expressions parse back as themselves",
+		prop.ForAll(
+			func(e term.Term) bool {
+				// a narrow width makes the printer break lines
+				printed := (&printer.Config{Width: 10}).Sprint(e)
+				expr, err := parser.Parse("-", []byte(printed))
+				if err != nil {
+					return false
+				}
+				return reflect.DeepEqual(e, expr)
+			},
+			ExprOf(ExprOf(LeafExpr)),
+		))
 
 	properties.TestingRun(t)
 }
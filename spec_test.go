@@ -16,12 +16,14 @@ import (
 	"github.com/philandstuff/dhall-golang/v6/core"
 	"github.com/philandstuff/dhall-golang/v6/imports"
 	"github.com/philandstuff/dhall-golang/v6/parser"
+	"github.com/philandstuff/dhall-golang/v6/printer"
 	"github.com/philandstuff/dhall-golang/v6/term"
 	"github.com/pkg/errors"
 )
 
 var slowTests = []string{
 	"TestParserAccepts/largeExpressionA",
+	"TestPrinterRoundTrip/largeExpressionA",
 	"TestTypeInference/preludeA",
 }
 
@@ -31,6 +33,10 @@ var expectedFailures = []string{
 	"TestParserAccepts/unit/import/inlineUsing",
 	"TestParserAccepts/unit/import/parenthesizeUsing",
 	"TestParserAccepts/usingToMap",
+	"TestPrinterRoundTrip/unit/import/Headers",
+	"TestPrinterRoundTrip/unit/import/inlineUsing",
+	"TestPrinterRoundTrip/unit/import/parenthesizeUsing",
+	"TestPrinterRoundTrip/usingToMap",
 	"TestTypeInferenceFails/customHeadersUsingBoundVariable",
 	"TestImport/customHeadersA.dhall",
 	"TestImport/headerForwardingA.dhall",
@@ -49,6 +55,7 @@ var expectedFailures = []string{
 
 	// other
 	"TestParserAccepts/unit/import/urls/potPourri", // net/url doesn't parse authorities in the way the test expects
+	"TestPrinterRoundTrip/unit/import/urls/potPourri",
 
 	// in dhall-golang, duplicate fields & alternatives are a parse error, not a
 	// type error
@@ -253,6 +260,26 @@ func TestParserAccepts(t *testing.T) {
 		})
 }
 
+func TestPrinterRoundTrip(t *testing.T) {
+	t.Parallel()
+	runTestOnFilePairs(t, "dhall-lang/tests/parser/success/",
+		"A.dhall", "B.dhallb",
+		func(t *testing.T, aPath, _ string) {
+			parsed, err := parser.ParseFile(aPath)
+			expectNoError(t, err)
+
+			printed := printer.Sprint(parsed)
+			reparsed, err := parser.Parse(aPath, []byte(printed))
+			expectNoError(t, err)
+
+			// compare encodings rather than Terms, since NaN != NaN
+			expected, actual := new(bytes.Buffer), new(bytes.Buffer)
+			expectNoError(t, binary.EncodeAsCbor(expected, parsed))
+			expectNoError(t, binary.EncodeAsCbor(actual, reparsed))
+			expectEqualBytes(t, expected.Bytes(), actual.Bytes())
+		})
+}
+
 func BenchmarkParserLargeExpression(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		_, err := parser.ParseFile("dhall-lang/tests/parser/success/largeExpressionA.dhall")
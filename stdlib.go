@@ -0,0 +1,122 @@
+package dhall
+
+import (
+	"encoding"
+	"math"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	urlType             = reflect.TypeOf(url.URL{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// isTextType reports whether values of Go type t are encoded as Dhall
+// Text, because t implements encoding.TextMarshaler, is a slice or
+// array of bytes, or is one of the standard library types we treat
+// specially.
+func isTextType(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface || t.Kind() == reflect.Ptr {
+		return false
+	}
+	return t == urlType || t == durationType || isBytesType(t) ||
+		t.Implements(textMarshalerType) ||
+		reflect.PtrTo(t).Implements(textMarshalerType)
+}
+
+// decodeText decodes the Dhall Text text into v, if v is a
+// url.URL, a time.Duration, or implements encoding.TextUnmarshaler.
+// It reports whether it knew how to decode into v.
+func decodeText(text string, v reflect.Value) (bool, error) {
+	switch v.Type() {
+	case urlType:
+		u, err := url.Parse(text)
+		if err != nil {
+			return true, err
+		}
+		v.Set(reflect.ValueOf(*u))
+		return true, nil
+	case durationType:
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return true, err
+		}
+		v.SetInt(int64(duration))
+		return true, nil
+	}
+	if v.Kind() != reflect.Interface && v.CanAddr() &&
+		reflect.PtrTo(v.Type()).Implements(textUnmarshalerType) {
+		u := v.Addr().Interface().(encoding.TextUnmarshaler)
+		return true, u.UnmarshalText([]byte(text))
+	}
+	return false, nil
+}
+
+// encodeText encodes val as Dhall Text, if val is a url.URL, a
+// time.Duration, or implements encoding.TextMarshaler.  It reports
+// whether it knew how to encode val.
+func encodeText(val reflect.Value) (string, bool, error) {
+	if val.Kind() == reflect.Ptr {
+		return "", false, nil
+	}
+	switch val.Type() {
+	case urlType:
+		u := val.Interface().(url.URL)
+		return u.String(), true, nil
+	case durationType:
+		return time.Duration(val.Int()).String(), true, nil
+	}
+	var m encoding.TextMarshaler
+	if val.Kind() != reflect.Interface && val.Type().Implements(textMarshalerType) {
+		m = val.Interface().(encoding.TextMarshaler)
+	} else if val.CanAddr() && reflect.PtrTo(val.Type()).Implements(textMarshalerType) {
+		m = val.Addr().Interface().(encoding.TextMarshaler)
+	} else {
+		return "", false, nil
+	}
+	text, err := m.MarshalText()
+	return string(text), true, err
+}
+
+// durationRecord is the record form of a time.Duration.
+type durationRecord struct {
+	Seconds int64 `dhall:"seconds"`
+	Nanos   int64 `dhall:"nanos"`
+}
+
+// decodeDuration decodes a `{ seconds, nanos }` record into the
+// time.Duration v, failing if the total overflows it.
+func (d *decoder) decodeDuration(e core.RecordLit, v reflect.Value, path string) error {
+	var parts durationRecord
+	err := d.decode(e, reflect.ValueOf(&parts).Elem(), path)
+	if err != nil {
+		return err
+	}
+	const maxSeconds = math.MaxInt64 / int64(time.Second)
+	if parts.Seconds > maxSeconds || parts.Seconds < -maxSeconds {
+		return d.decodeError(e, v, path, overflowError(e, v))
+	}
+	seconds := parts.Seconds * int64(time.Second)
+	if parts.Nanos > 0 && seconds > math.MaxInt64-parts.Nanos ||
+		parts.Nanos < 0 && seconds < math.MinInt64-parts.Nanos {
+		return d.decodeError(e, v, path, overflowError(e, v))
+	}
+	v.SetInt(seconds + parts.Nanos)
+	return nil
+}
+
+// encodeDuration encodes the time.Duration val as a
+// `{ seconds, nanos }` record of type typ.
+func encodeDuration(val reflect.Value, typ core.RecordType) (core.Value, error) {
+	duration := time.Duration(val.Int())
+	return encode(reflect.ValueOf(durationRecord{
+		Seconds: int64(duration / time.Second),
+		Nanos:   int64(duration % time.Second),
+	}), typ, DecoderConfig{})
+}
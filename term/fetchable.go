@@ -1,6 +1,7 @@
 package term
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -208,7 +209,13 @@ func (r RemoteFile) String() string { return fmt.Sprintf("%v", r.url) }
 // considered a cross-origin request and so appropriate CORS checks
 // are made; if these fail, an error is returned with no content.
 func (r RemoteFile) Fetch(origin string) (string, error) {
-	req, err := http.NewRequest("GET", r.url.String(), nil)
+	return r.FetchContext(context.Background(), origin)
+}
+
+// FetchContext is like Fetch, but the HTTP request is abandoned if
+// ctx is cancelled or its deadline passes.
+func (r RemoteFile) FetchContext(ctx context.Context, origin string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", r.url.String(), nil)
 	if err != nil {
 		return "", err
 	}
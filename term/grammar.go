@@ -0,0 +1,197 @@
+package term
+
+// The levels of the Dhall grammar, from the loosest binding to the
+// tightest.  A Term needs parentheses when it appears somewhere the
+// grammar expects a higher level than its own.  Operators are at
+// OperatorLevel plus their precedence.
+const (
+	ExpressionLevel = iota
+	OperatorLevel
+	ApplicationLevel = OperatorLevel + 13
+	ImportLevel      = ApplicationLevel + 1
+	SelectorLevel    = ImportLevel + 1
+	PrimitiveLevel   = SelectorLevel + 1
+)
+
+// Level returns the grammar level of t: the highest level at which t
+// parses without parentheses.
+func Level(t Term) int {
+	switch t := t.(type) {
+	case Lambda, Pi, Let, Annot, If, EmptyList, Assert, With:
+		return ExpressionLevel
+	case Merge:
+		if t.Annotation != nil {
+			return ExpressionLevel
+		}
+		return ApplicationLevel
+	case ToMap:
+		if t.Type != nil {
+			return ExpressionLevel
+		}
+		return ApplicationLevel
+	case Op:
+		if t.OpCode == CompleteOp {
+			return ImportLevel
+		}
+		return OperatorLevel + t.precedence()
+	case App, Some:
+		return ApplicationLevel
+	case Import:
+		return ImportLevel
+	case Field, Project, ProjectType:
+		return SelectorLevel
+	}
+	return PrimitiveLevel
+}
+
+// higher precedence binds tighter.  These follow the Dhall grammar,
+// in which ≡ binds loosest of all the operators.
+func (op Op) precedence() int {
+	switch op.OpCode {
+	case EquivOp:
+		return 0
+	case ImportAltOp:
+		return 1
+	case OrOp:
+		return 2
+	case PlusOp:
+		return 3
+	case TextAppendOp:
+		return 4
+	case ListAppendOp:
+		return 5
+	case AndOp:
+		return 6
+	case RecordMergeOp:
+		return 7
+	case RightBiasedRecordMergeOp:
+		return 8
+	case RecordTypeMergeOp:
+		return 9
+	case TimesOp:
+		return 10
+	case EqOp:
+		return 11
+	case NeOp:
+		return 12
+	case CompleteOp:
+		return 13
+	default:
+		panic("unknown opcode")
+	}
+}
+
+// Symbol returns the symbol for op, such as "+" or "∧".
+func (op OpCode) Symbol() string {
+	switch op {
+	case ImportAltOp:
+		return "?"
+	case OrOp:
+		return "||"
+	case PlusOp:
+		return "+"
+	case TextAppendOp:
+		return "++"
+	case ListAppendOp:
+		return "#"
+	case AndOp:
+		return "&&"
+	case RecordMergeOp:
+		return "∧"
+	case RightBiasedRecordMergeOp:
+		return "⫽"
+	case RecordTypeMergeOp:
+		return "⩓"
+	case TimesOp:
+		return "*"
+	case EqOp:
+		return "=="
+	case NeOp:
+		return "!="
+	case EquivOp:
+		return "≡"
+	case CompleteOp:
+		return "::"
+	default:
+		panic("unknown opcode")
+	}
+}
+
+// keywords are the reserved words which cannot be used as simple
+// labels.
+var keywords = map[string]bool{
+	"if":       true,
+	"then":     true,
+	"else":     true,
+	"let":      true,
+	"in":       true,
+	"using":    true,
+	"missing":  true,
+	"assert":   true,
+	"as":       true,
+	"Infinity": true,
+	"NaN":      true,
+	"merge":    true,
+	"Some":     true,
+	"toMap":    true,
+	"forall":   true,
+	"with":     true,
+}
+
+// builtins are the reserved identifiers which cannot be used as
+// simple labels.
+var builtins = map[string]bool{
+	"Natural/fold":      true,
+	"Natural/build":     true,
+	"Natural/isZero":    true,
+	"Natural/even":      true,
+	"Natural/odd":       true,
+	"Natural/toInteger": true,
+	"Natural/show":      true,
+	"Natural/subtract":  true,
+	"Integer/toDouble":  true,
+	"Integer/show":      true,
+	"Integer/negate":    true,
+	"Integer/clamp":     true,
+	"Double/show":       true,
+	"List/build":        true,
+	"List/fold":         true,
+	"List/length":       true,
+	"List/head":         true,
+	"List/last":         true,
+	"List/indexed":      true,
+	"List/reverse":      true,
+	"Text/show":         true,
+	"Text/replace":      true,
+	"Bool":              true,
+	"True":              true,
+	"False":             true,
+	"Optional":          true,
+	"None":              true,
+	"Natural":           true,
+	"Integer":           true,
+	"Double":            true,
+	"Text":              true,
+	"List":              true,
+	"Type":              true,
+	"Kind":              true,
+	"Sort":              true,
+}
+
+// QuoteLabel returns label as it must be written in Dhall source:
+// surrounded by backticks if it is a keyword or builtin, or is not a
+// valid simple label.
+func QuoteLabel(label string) string {
+	if label == "" || keywords[label] || builtins[label] {
+		return "`" + label + "`"
+	}
+	for i, r := range label {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r == '_':
+		case i > 0 && (r >= '0' && r <= '9' || r == '/' || r == '-'):
+		default:
+			return "`" + label + "`"
+		}
+	}
+	return label
+}
@@ -378,47 +378,63 @@ func (t TextLit) String() string {
 	var out strings.Builder
 	out.WriteString(`"`)
 	for _, chunk := range t.Chunks {
-		for _, r := range chunk.Prefix {
-			switch r {
-			case '"':
-				out.WriteString(`\"`)
-			case '$':
-				out.WriteString(`\u0024`)
-			case '\\':
-				out.WriteString(`\\`)
-			case '\b':
-				out.WriteString(`\b`)
-			case '\f':
-				out.WriteString(`\f`)
-			case '\n':
-				out.WriteString(`\n`)
-			case '\r':
-				out.WriteString(`\r`)
-			case '\t':
-				out.WriteString(`\t`)
-			default:
-				if r < 0x1f {
-					out.WriteString(fmt.Sprintf(`\u%04x`, r))
-				} else {
-					out.WriteRune(r)
-				}
-			}
-		}
+		writeEscapedText(&out, chunk.Prefix)
 		out.WriteString("${")
 		out.WriteString(fmt.Sprint(chunk.Expr))
 		out.WriteString("}")
 	}
-	// TODO: properly deserialise string here
-	out.WriteString(t.Suffix)
+	writeEscapedText(&out, t.Suffix)
 	out.WriteString(`"`)
 	return out.String()
 }
 
+// writeEscapedText writes s to out, escaped so that it can appear
+// between the quotes of a double-quoted text literal.
+func writeEscapedText(out *strings.Builder, s string) {
+	for _, r := range s {
+		switch r {
+		case '"':
+			out.WriteString(`\"`)
+		case '$':
+			out.WriteString(`\u0024`)
+		case '\\':
+			out.WriteString(`\\`)
+		case '\b':
+			out.WriteString(`\b`)
+		case '\f':
+			out.WriteString(`\f`)
+		case '\n':
+			out.WriteString(`\n`)
+		case '\r':
+			out.WriteString(`\r`)
+		case '\t':
+			out.WriteString(`\t`)
+		default:
+			if r <= 0x1f {
+				out.WriteString(fmt.Sprintf(`\u%04x`, r))
+			} else {
+				out.WriteRune(r)
+			}
+		}
+	}
+}
+
 func (If) isTerm() {}
 
 func (DoubleLit) isTerm()  {}
 func (IntegerLit) isTerm() {}
 
+func (b BoolLit) String() string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
+
+func (i IntegerLit) String() string {
+	return fmt.Sprintf("%+d", int(i))
+}
+
 func (d DoubleLit) String() string {
 	f := float64(d)
 	if math.IsInf(f, 1) {
@@ -488,9 +504,9 @@ func (c Universe) String() string {
 
 func (v Var) String() string {
 	if v.Index == 0 {
-		return v.Name
+		return QuoteLabel(v.Name)
 	}
-	return fmt.Sprint(v.Name, "@", v.Index)
+	return fmt.Sprint(QuoteLabel(v.Name), "@", v.Index)
 }
 
 func (v LocalVar) String() string {
@@ -498,204 +514,168 @@ func (v LocalVar) String() string {
 }
 
 func (lam Lambda) String() string {
-	return fmt.Sprintf("(λ(%s : %v) → %v)", lam.Label, lam.Type, lam.Body)
+	return fmt.Sprintf("λ(%s : %v) → %v", QuoteLabel(lam.Label), lam.Type, lam.Body)
 }
 
 func (pi Pi) String() string {
 	if pi.Label == "_" {
-		return fmt.Sprintf("%v → %v", pi.Type, pi.Body)
+		return fmt.Sprintf("%s → %v", parens(pi.Type, OperatorLevel), pi.Body)
 	}
-	return fmt.Sprintf("∀(%s : %v) → %v", pi.Label, pi.Type, pi.Body)
+	return fmt.Sprintf("∀(%s : %v) → %v", QuoteLabel(pi.Label), pi.Type, pi.Body)
 }
 
 func (app App) String() string {
-	if subApp, ok := app.Fn.(App); ok {
-		return fmt.Sprintf("(%v %v)", subApp.stringNoParens(), app.Arg)
-	}
-	return fmt.Sprintf("(%v %v)", app.Fn, app.Arg)
-}
-
-func (app App) stringNoParens() string {
-	if subApp, ok := app.Fn.(App); ok {
-		return fmt.Sprintf("%v %v", subApp.stringNoParens(), app.Arg)
-	}
-	return fmt.Sprintf("%v %v", app.Fn, app.Arg)
-}
-
-// higher precedence binds tighter
-func (op Op) precedence() int {
-	switch op.OpCode {
-	case ImportAltOp:
-		return 1
-	case OrOp:
-		return 2
-	case PlusOp:
-		return 3
-	case TextAppendOp:
-		return 4
-	case ListAppendOp:
-		return 5
-	case AndOp:
-		return 6
-	case RecordMergeOp:
-		return 7
-	case RightBiasedRecordMergeOp:
-		return 8
-	case RecordTypeMergeOp:
-		return 9
-	case TimesOp:
-		return 10
-	case EqOp:
-		return 11
-	case NeOp:
-		return 12
-	case EquivOp:
-		return 13
-	case CompleteOp:
-		return 14
-	default:
-		panic("unknown opcode")
-	}
-}
-
-func (op Op) operatorStr() string {
-	switch op.OpCode {
-	case ImportAltOp:
-		return " ? "
-	case OrOp:
-		return " || "
-	case PlusOp:
-		return " + "
-	case TextAppendOp:
-		return " ++ "
-	case ListAppendOp:
-		return " # "
-	case AndOp:
-		return " && "
-	case RecordMergeOp:
-		return " ∧ "
-	case RightBiasedRecordMergeOp:
-		return " ⫽ "
-	case RecordTypeMergeOp:
-		return " ⩓ "
-	case TimesOp:
-		return " * "
-	case EqOp:
-		return " == "
-	case NeOp:
-		return " != "
-	case EquivOp:
-		return " ≡ "
-	case CompleteOp:
-		return "::"
-	default:
-		panic("unknown opcode")
-	}
+	return fmt.Sprintf("%s %s", parens(app.Fn, ApplicationLevel), parens(app.Arg, ImportLevel))
 }
 
-func (op Op) String() string {
-	prec := op.precedence()
-	l := fmt.Sprint(op.L)
-	r := fmt.Sprint(op.R)
-
+func (l Let) String() string {
 	var buf strings.Builder
-
-	if lop, ok := op.L.(Op); ok {
-		if prec > lop.precedence() {
-			buf.WriteRune('(')
-		}
-		buf.WriteString(l)
-		if prec > lop.precedence() {
-			buf.WriteRune(')')
+	for _, b := range l.Bindings {
+		buf.WriteString("let ")
+		buf.WriteString(QuoteLabel(b.Variable))
+		if b.Annotation != nil {
+			buf.WriteString(" : ")
+			buf.WriteString(fmt.Sprint(b.Annotation))
 		}
-	} else if _, ok := op.L.(App); ok {
-		buf.WriteString(l)
+		buf.WriteString(" = ")
+		buf.WriteString(fmt.Sprint(b.Value))
+		buf.WriteString(" ")
+	}
+	buf.WriteString("in ")
+	buf.WriteString(fmt.Sprint(l.Body))
+	return buf.String()
+}
 
-	} else {
-		buf.WriteRune('(')
-		buf.WriteString(l)
-		buf.WriteRune(')')
-	}
-	buf.WriteString(op.operatorStr())
-	if rop, ok := op.R.(Op); ok {
-		if prec > rop.precedence() {
-			buf.WriteRune('(')
-		}
-		buf.WriteString(r)
-		if prec > rop.precedence() {
-			buf.WriteRune(')')
-		}
-	} else if _, ok := op.R.(App); ok {
-		buf.WriteString(r)
+func (a Annot) String() string {
+	return fmt.Sprintf("%s : %v", parens(a.Expr, OperatorLevel), a.Annotation)
+}
 
-	} else {
-		buf.WriteRune('(')
-		buf.WriteString(r)
-		buf.WriteRune(')')
+func (i If) String() string {
+	return fmt.Sprintf("if %v then %v else %v", i.Cond, i.T, i.F)
+}
+
+func (i ImportHashed) String() string {
+	if i.Hash == nil {
+		return i.Fetchable.String()
 	}
-	return buf.String()
+	// skip the multihash prefix
+	return fmt.Sprintf("%v sha256:%x", i.Fetchable, i.Hash[2:])
 }
 
-func (e EmptyList) String() string {
-	return fmt.Sprintf("[] : (%v)", e.Type)
+func (i Import) String() string {
+	switch i.ImportMode {
+	case RawText:
+		return i.ImportHashed.String() + " as Text"
+	case Location:
+		return i.ImportHashed.String() + " as Location"
+	}
+	return i.ImportHashed.String()
 }
 
-func (r RecordType) String() string {
-	if len(r) == 0 {
-		return "{}"
+// parens returns the String of t, in parentheses if t would otherwise
+// not parse at grammar level lvl.
+func parens(t Term, lvl int) string {
+	if Level(t) < lvl {
+		return fmt.Sprintf("(%v)", t)
 	}
-	// get keys in sorted order
-	var sortedKeys []string
-	for k := range r {
-		sortedKeys = append(sortedKeys, k)
+	return fmt.Sprint(t)
+}
+
+// String writes op with as few parentheses as it can.  The operators
+// all associate to the left, so the right operand needs parentheses
+// if it is at the same level as op.
+func (op Op) String() string {
+	if op.OpCode == CompleteOp {
+		// both sides of :: are selector expressions
+		return parens(op.L, SelectorLevel) + op.OpCode.Symbol() + parens(op.R, SelectorLevel)
 	}
-	sort.Strings(sortedKeys)
+	lvl := Level(op)
+	return parens(op.L, lvl) + " " + op.OpCode.Symbol() + " " + parens(op.R, lvl+1)
+}
 
+func (e EmptyList) String() string {
+	return fmt.Sprintf("[] : %s", parens(e.Type, ApplicationLevel))
+}
+
+func (l NonEmptyList) String() string {
 	var buf strings.Builder
-	first := true
-	buf.WriteString("{ ")
-	for _, name := range sortedKeys {
-		if !first {
+	buf.WriteString("[ ")
+	for i, item := range l {
+		if i > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(name)
-		buf.WriteString(" : ")
-		buf.WriteString(fmt.Sprintf("%v", r[name]))
-		first = false
+		buf.WriteString(fmt.Sprint(item))
 	}
-	buf.WriteString("}")
+	buf.WriteString(" ]")
 	return buf.String()
 }
 
+func (s Some) String() string {
+	return fmt.Sprintf("Some %s", parens(s.Val, ImportLevel))
+}
+
+func (r RecordType) String() string {
+	if len(r) == 0 {
+		return "{}"
+	}
+	return fieldsString(r, " : ")
+}
+
 func (r RecordLit) String() string {
 	if len(r) == 0 {
 		return "{=}"
 	}
+	return fieldsString(r, " = ")
+}
+
+// fieldsString writes the fields of a non-empty record type or
+// literal in sorted order, with sep between each label and its value.
+func fieldsString(fields map[string]Term, sep string) string {
 	// get keys in sorted order
 	var sortedKeys []string
-	for k := range r {
+	for k := range fields {
 		sortedKeys = append(sortedKeys, k)
 	}
 	sort.Strings(sortedKeys)
 
 	var buf strings.Builder
-	first := true
 	buf.WriteString("{ ")
-	for _, name := range sortedKeys {
-		if !first {
+	for i, name := range sortedKeys {
+		if i > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(name)
-		buf.WriteString(" = ")
-		buf.WriteString(fmt.Sprintf("%v", r[name]))
-		first = false
+		buf.WriteString(QuoteLabel(name))
+		buf.WriteString(sep)
+		buf.WriteString(fmt.Sprint(fields[name]))
 	}
-	buf.WriteString("}")
+	buf.WriteString(" }")
 	return buf.String()
 }
 
+func (t ToMap) String() string {
+	if t.Type != nil {
+		return fmt.Sprintf("toMap %s : %s", parens(t.Record, ImportLevel), parens(t.Type, ApplicationLevel))
+	}
+	return fmt.Sprintf("toMap %s", parens(t.Record, ImportLevel))
+}
+
 func (f Field) String() string {
-	return fmt.Sprintf("(%v).%s", f.Record, f.FieldName)
+	return fmt.Sprintf("%s.%s", parens(f.Record, SelectorLevel), QuoteLabel(f.FieldName))
+}
+
+func (p Project) String() string {
+	if len(p.FieldNames) == 0 {
+		return fmt.Sprintf("%s.{}", parens(p.Record, SelectorLevel))
+	}
+	labels := make([]string, len(p.FieldNames))
+	for i, name := range p.FieldNames {
+		labels[i] = QuoteLabel(name)
+	}
+	return fmt.Sprintf("%s.{ %s }", parens(p.Record, SelectorLevel), strings.Join(labels, ", "))
+}
+
+func (p ProjectType) String() string {
+	return fmt.Sprintf("%s.(%v)", parens(p.Record, SelectorLevel), p.Selector)
 }
 
 func (u UnionType) String() string {
@@ -717,9 +697,11 @@ func (u UnionType) String() string {
 		if !first {
 			buf.WriteString(" | ")
 		}
-		buf.WriteString(name)
-		buf.WriteString(" : ")
-		buf.WriteString(fmt.Sprintf("%v", u[name]))
+		buf.WriteString(QuoteLabel(name))
+		if u[name] != nil {
+			buf.WriteString(" : ")
+			buf.WriteString(fmt.Sprintf("%v", u[name]))
+		}
 		first = false
 	}
 	buf.WriteString(" >")
@@ -727,8 +709,33 @@ func (u UnionType) String() string {
 }
 
 func (m Merge) String() string {
+	merge := fmt.Sprintf("merge %s %s", parens(m.Handler, ImportLevel), parens(m.Union, ImportLevel))
 	if m.Annotation != nil {
-		return fmt.Sprintf("merge (%s) (%s) : (%s)", m.Handler, m.Union, m.Annotation)
+		return fmt.Sprintf("%s : %s", merge, parens(m.Annotation, ApplicationLevel))
 	}
-	return fmt.Sprintf("merge (%s) (%s)", m.Handler, m.Union)
+	return merge
+}
+
+func (a Assert) String() string {
+	return fmt.Sprintf("assert : %v", a.Annotation)
+}
+
+func (w With) String() string {
+	var buf strings.Builder
+	if _, ok := w.Record.(With); ok {
+		// a chain of withs needs no parentheses
+		buf.WriteString(fmt.Sprint(w.Record))
+	} else {
+		buf.WriteString(parens(w.Record, ImportLevel))
+	}
+	buf.WriteString(" with ")
+	for i, label := range w.Path {
+		if i > 0 {
+			buf.WriteString(".")
+		}
+		buf.WriteString(QuoteLabel(label))
+	}
+	buf.WriteString(" = ")
+	buf.WriteString(parens(w.Value, OperatorLevel))
+	return buf.String()
 }
@@ -0,0 +1,205 @@
+package dhall
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/v6/core"
+	"github.com/philandstuff/dhall-golang/v6/internal"
+)
+
+// A TypeMismatchError is returned by Unmarshal when the type of the
+// Dhall expression can't be decoded into the Go type of the target
+// variable.  It lists every part of the Dhall type which doesn't
+// match the Go type.
+type TypeMismatchError struct {
+	Type       core.Value   // the Dhall type of the expression
+	GoType     reflect.Type // the Go type being decoded into
+	Mismatches []TypeMismatch
+}
+
+// A TypeMismatch is a single part of a Dhall type which doesn't match
+// the corresponding part of a Go type.
+type TypeMismatch struct {
+	// Path is the location of the mismatch within the Dhall type,
+	// such as `.services[*].ports[*].number`, where `[*]` stands
+	// for every element of a list.  It is empty if the mismatch is
+	// at the top level.
+	Path   string
+	Type   core.Value   // the Dhall type at Path
+	GoType reflect.Type // the Go type at Path
+	Detail string       // further explanation, if any
+}
+
+func (m TypeMismatch) String() string {
+	msg := fmt.Sprintf("%v can't be decoded into %v", core.Quote(m.Type), m.GoType)
+	if m.Detail != "" {
+		msg += " (" + m.Detail + ")"
+	}
+	if m.Path == "" {
+		return msg
+	}
+	return m.Path + ": " + msg
+}
+
+func (e *TypeMismatchError) Error() string {
+	var mismatches []string
+	for _, m := range e.Mismatches {
+		mismatches = append(mismatches, m.String())
+	}
+	return fmt.Sprintf("Dhall type %v doesn't match Go type %v: %s",
+		core.Quote(e.Type), e.GoType, strings.Join(mismatches, "; "))
+}
+
+// checkType checks that values of Dhall type typ can be decoded into
+// the Go type t, with struct fields named by names, returning a
+// *TypeMismatchError if not.
+//
+// The check follows the same rules as decode, but works on types
+// rather than values, so that we can report every mismatch before
+// decoding anything.  Record fields which are present in only one of
+// the Dhall type and the Go type are not mismatches here; decode
+// deals with those according to the DecoderConfig.
+func checkType(typ core.Value, t reflect.Type, names FieldNamer) error {
+	var mismatches []TypeMismatch
+	compareTypes(typ, t, names, "", &mismatches)
+	if len(mismatches) > 0 {
+		return &TypeMismatchError{Type: typ, GoType: t, Mismatches: mismatches}
+	}
+	return nil
+}
+
+// compareTypes appends to mismatches every place where the Dhall type
+// typ, found at path, can't be decoded into the Go type t.
+func compareTypes(typ core.Value, t reflect.Type, names FieldNamer, path string, mismatches *[]TypeMismatch) {
+	if opt, ok := typ.(core.OptionalOf); ok {
+		// decode strips Somes and ignores Nones
+		typ = opt.Type
+	}
+	if t.Kind() == reflect.Interface ||
+		t.Implements(unmarshalerType) ||
+		reflect.PtrTo(t).Implements(unmarshalerType) {
+		// anything goes
+		return
+	}
+	if t.Kind() == reflect.Ptr {
+		compareTypes(typ, t.Elem(), names, path, mismatches)
+		return
+	}
+	if core.AlphaEquivalent(typ, JSONType) {
+		// JSON values can be decoded into all sorts of things;
+		// we find out when we decode them
+		return
+	}
+	if typeMatches(typ, t, names, path, mismatches) {
+		return
+	}
+	*mismatches = append(*mismatches, TypeMismatch{Path: path, Type: typ, GoType: t})
+}
+
+// typeMatches reports whether the outermost parts of typ and t match,
+// having appended any mismatches between their component types to
+// mismatches.
+func typeMatches(typ core.Value, t reflect.Type, names FieldNamer, path string, mismatches *[]TypeMismatch) bool {
+	switch typ := typ.(type) {
+	case core.Builtin:
+		switch typ {
+		case core.Bool:
+			return t.Kind() == reflect.Bool
+		case core.Natural, core.Integer:
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16,
+				reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16,
+				reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				return true
+			}
+		case core.Double:
+			return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+		case core.Text:
+			return t.Kind() == reflect.String || isBytesType(t) ||
+				t == urlType || t == durationType ||
+				reflect.PtrTo(t).Implements(textUnmarshalerType)
+		}
+	case core.ListOf:
+		if t.Kind() == reflect.Map {
+			entryType, ok := typ.Type.(core.RecordType)
+			if !ok || !internal.IsMapEntryType(entryType) {
+				return false
+			}
+			compareTypes(entryType["mapKey"], t.Key(), names, path+"[*]", mismatches)
+			compareTypes(entryType["mapValue"], t.Elem(), names, path+"[*]", mismatches)
+			return true
+		}
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			compareTypes(typ.Type, t.Elem(), names, path+"[*]", mismatches)
+			return true
+		}
+	case core.RecordType:
+		if t == durationType {
+			return true
+		}
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+		for _, field := range structFields(t, names) {
+			if fieldType, ok := typ[field.name]; ok {
+				compareTypes(fieldType, field.typ, names, path+"."+field.name, mismatches)
+			}
+		}
+		return true
+	case core.UnionType:
+		if t.Kind() == reflect.String {
+			for _, altType := range typ {
+				if altType != nil {
+					return false
+				}
+			}
+			return true
+		}
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+		fields := make(map[string]structField)
+		for _, field := range structFields(t, names) {
+			fields[field.name] = field
+		}
+		var alts []string
+		for alt := range typ {
+			alts = append(alts, alt)
+		}
+		sort.Strings(alts)
+		for _, alt := range alts {
+			altType := typ[alt]
+			field, ok := fields[alt]
+			switch {
+			case !ok:
+				*mismatches = append(*mismatches, TypeMismatch{
+					Path:   path,
+					Type:   typ,
+					GoType: t,
+					Detail: "no field for alternative " + alt,
+				})
+			case altType == nil:
+				if field.typ.Kind() != reflect.Bool {
+					*mismatches = append(*mismatches, TypeMismatch{
+						Path:   path + "." + alt,
+						Type:   typ,
+						GoType: field.typ,
+						Detail: "the field for an empty alternative must be a bool",
+					})
+				}
+			default:
+				compareTypes(altType, field.typ, names, path+"."+alt, mismatches)
+			}
+		}
+		return true
+	case core.Pi:
+		// decode checks functions more thoroughly when it decodes
+		// them
+		return t.Kind() == reflect.Func
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package dhall_test
+
+import (
+	"errors"
+	"reflect"
+
+	. "github.com/philandstuff/dhall-golang/v6"
+	"github.com/philandstuff/dhall-golang/v6/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func ExpectTypeMismatches(source string, ptr interface{}, expected ...string) {
+	err := Unmarshal([]byte(source), ptr)
+	var mismatchErr *TypeMismatchError
+	Expect(errors.As(err, &mismatchErr)).To(BeTrue(), "expected a TypeMismatchError, got %v", err)
+	var actual []string
+	for _, m := range mismatchErr.Mismatches {
+		actual = append(actual, m.String())
+	}
+	Expect(actual).To(Equal(expected))
+}
+
+var _ = Describe("Type checking against Go types", func() {
+	DescribeTable("Compatible types", func(source string, ptr interface{}) {
+		Expect(Unmarshal([]byte(source), ptr)).To(Succeed())
+	},
+		Entry("Natural into int", `1`, new(int)),
+		Entry("Integer into uint", `+1`, new(uint)),
+		Entry("Optional into non-pointer", `Some 1`, new(uint)),
+		Entry("None into pointer", `None Natural`, new(*uint)),
+		Entry("anything into interface{}", `{ a = [ 1 ] }`, new(interface{})),
+		Entry("extra record fields", `{ Foo = 1, Bar = "x", Baz = True }`, new(testStruct)),
+		Entry("missing record fields", `{ Foo = 1 }`, new(testStruct)),
+		Entry("Map into map", `toMap { a = 1 }`, new(map[string]uint)),
+		Entry("enum into string", `< A | B >.A`, new(string)),
+		Entry("union into struct", `< Postgres : { Foo : Natural, Bar : Text } | sqlite : Text | Memory >.Memory`, new(testUnion)),
+		Entry("anything into Unmarshaler", `{ amount = 1, unit = < KB | MB >.KB }`, new(testSize)),
+		Entry("function into func", `λ(x : Natural) → x + 1`, new(func(uint) uint)),
+	)
+	DescribeTable("Incompatible types", ExpectTypeMismatches,
+		Entry("top level",
+			`"foo"`, new(uint),
+			`Text can't be decoded into uint`),
+		Entry("every mismatched record field",
+			`{ services = [ { name = 1, port = "80", host = "a" } ] }`,
+			new(struct {
+				Services []struct {
+					Name string `dhall:"name"`
+					Port uint16 `dhall:"port"`
+					Host string `dhall:"host"`
+				} `dhall:"services"`
+			}),
+			`.services[*].name: Natural can't be decoded into string`,
+			`.services[*].port: Text can't be decoded into uint16`),
+		Entry("map values",
+			`toMap { a = True }`, new(map[string]uint),
+			`[*]: Bool can't be decoded into uint`),
+		Entry("union with values into string",
+			`< A : Natural | B >.B`, new(string),
+			`< A : Natural | B > can't be decoded into string`),
+		Entry("union alternatives",
+			`< Postgres : Text | sqlite : Natural | Memory : Bool | Other >.Other`, new(testUnion),
+			`< Memory : Bool | Other | Postgres : Text | sqlite : Natural > can't be decoded into dhall_test.testUnion (no field for alternative Other)`,
+			`.Postgres: Text can't be decoded into dhall_test.testStruct`,
+			`.sqlite: Natural can't be decoded into string`),
+	)
+	It("Reports the whole types", func() {
+		err := Unmarshal([]byte(`{ Foo = "x" }`), new(testStruct))
+		var mismatchErr *TypeMismatchError
+		Expect(errors.As(err, &mismatchErr)).To(BeTrue())
+		Expect(mismatchErr.Type).To(Equal(core.RecordType{"Foo": core.Text}))
+		Expect(mismatchErr.GoType).To(Equal(reflect.TypeOf(testStruct{})))
+		Expect(err.Error()).To(Equal(
+			"Dhall type { Foo : Text } doesn't match Go type dhall_test.testStruct: .Foo: Text can't be decoded into uint"))
+	})
+})
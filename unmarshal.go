@@ -1,26 +1,21 @@
 package dhall
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
+	"sort"
+	"unicode/utf8"
 
 	"github.com/philandstuff/dhall-golang/v6/core"
-	"github.com/philandstuff/dhall-golang/v6/imports"
+	"github.com/philandstuff/dhall-golang/v6/internal"
 	"github.com/philandstuff/dhall-golang/v6/parser"
 	"github.com/philandstuff/dhall-golang/v6/term"
 )
 
-func isMapEntryType(recordType map[string]core.Value) bool {
-	if _, ok := recordType["mapKey"]; ok {
-		if _, ok := recordType["mapValue"]; ok {
-			return len(recordType) == 2
-		}
-	}
-	return false
-}
-
 var JSONType core.Value = mkJSONType()
 
 var identity core.Value = core.Eval(term.NewLambda("_", term.Type, term.NewVar("_")))
@@ -37,8 +32,26 @@ var jsonConstructors core.Value = core.RecordLit{
 	"string":  identity,
 }
 
+// jsonTypeSource is the source of the Prelude's JSON/Type.  We don't
+// read it from the Prelude, so that we don't depend on having a copy
+// of the Prelude at runtime.
+const jsonTypeSource = `
+  ∀(JSON : Type)
+→ ∀ ( json
+    : { array : List JSON → JSON
+      , bool : Bool → JSON
+      , double : Double → JSON
+      , integer : Integer → JSON
+      , null : JSON
+      , object : List { mapKey : Text, mapValue : JSON } → JSON
+      , string : Text → JSON
+      }
+    )
+→ JSON
+`
+
 func mkJSONType() core.Value {
-	term, err := parser.ParseFile("./dhall-lang/Prelude/JSON/Type.dhall")
+	term, err := parser.Parse("JSON/Type", []byte(jsonTypeSource))
 	if err != nil {
 		panic(err)
 	}
@@ -49,75 +62,165 @@ func mkJSONType() core.Value {
 	return core.Eval(term)
 }
 
+// Unmarshaler is the interface implemented by types that can decode
+// a Dhall value into themselves.  UnmarshalDhall is given the
+// evaluated value, with any outer Some stripped; it is not called for
+// None values.
+type Unmarshaler interface {
+	UnmarshalDhall(core.Value) error
+}
+
+var unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+
+// unmarshalerFor returns the Unmarshaler that v should be decoded
+// with, or nil if v's type doesn't implement Unmarshaler.  Nil
+// pointers are allocated.
+func unmarshalerFor(v reflect.Value) Unmarshaler {
+	if v.Kind() == reflect.Ptr && v.Type().Implements(unmarshalerType) {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Interface().(Unmarshaler)
+	}
+	if v.Kind() != reflect.Interface && v.CanAddr() &&
+		reflect.PtrTo(v.Type()).Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler)
+	}
+	return nil
+}
+
 // Unmarshal takes dhall input as a byte array and parses it, resolves
 // imports, typechecks, evaluates, and unmarshals it into the given
 // variable.
+//
+// As well as typechecking the input, Unmarshal checks that its type
+// can be decoded into the type of the given variable before decoding
+// anything, and returns a *TypeMismatchError listing every mismatch
+// if not.
 func Unmarshal(b []byte, out interface{}) error {
-	term, err := parser.Parse("-", b)
-	if err != nil {
-		return err
-	}
-	return unmarshalTerm(term, out)
+	return DecoderConfig{}.Unmarshal(b, out)
+}
+
+// UnmarshalContext is like Unmarshal, but gives up and returns
+// ctx.Err() if ctx is cancelled or its deadline passes while
+// resolving imports or evaluating.
+func UnmarshalContext(ctx context.Context, b []byte, out interface{}) error {
+	return DecoderConfig{}.UnmarshalContext(ctx, b, out)
+}
+
+// UnmarshalWith is like Unmarshal, but the Dhall input can refer to
+// the given bindings as free variables.  For example, with the
+// binding
+//
+//  "region": {Type: core.Text, Value: "eu-west-1"}
+//
+// the input can use `region` as a Text value.
+func UnmarshalWith(bindings map[string]BindingValue, b []byte, out interface{}) error {
+	return DecoderConfig{Bindings: bindings}.Unmarshal(b, out)
 }
 
 // UnmarshalReader takes dhall input as a byte array and parses it, resolves
 // imports, typechecks, evaluates, and unmarshals it into the given
 // variable.
 func UnmarshalReader(filename string, r io.Reader, out interface{}) error {
-	term, err := parser.ParseReader(filename, r)
-	if err != nil {
-		return err
-	}
-	return unmarshalTerm(term, out)
+	return DecoderConfig{}.UnmarshalReader(filename, r, out)
 }
 
 // UnmarshalFile takes dhall input from a file and parses it, resolves
 // imports, typechecks, evaluates, and unmarshals it into the given
 // variable.
 func UnmarshalFile(filename string, out interface{}) error {
-	term, err := parser.ParseFile(filename)
-	if err != nil {
-		return err
-	}
-	return unmarshalTerm(term, out)
-}
-
-func unmarshalTerm(term term.Term, out interface{}) error {
-	resolved, err := imports.Load(term)
-	if err != nil {
-		return err
-	}
-	_, err = core.TypeOf(resolved)
-	if err != nil {
-		return err
-	}
-	return Decode(core.Eval(resolved), out)
+	return DecoderConfig{}.UnmarshalFile(filename, out)
 }
 
 // Decode takes a core.Value and unmarshals it into the given
 // variable.
+//
+// A Dhall record is decoded into a struct field by field.  Struct
+// fields with no matching record field are left unchanged, and record
+// fields with no matching struct field are ignored; use a
+// DecoderConfig to treat either of these as an error.
+//
+// A struct field is matched with the record field named in its
+// `dhall` tag, such as `dhall:"timeoutSeconds"`, or with the record
+// field with the same name as the Go field if it has no tag name.  The
+// tag `dhall:"-"` skips a field.  As in encoding/json, the fields of
+// an embedded struct are treated as fields of the outer struct, unless
+// the embedded struct has a tag name; the tag option `inline`, as in
+// `dhall:",inline"`, does the same for any struct-typed field.
+//
+// Types which implement Unmarshaler decode themselves, at any level
+// of nesting.
+//
+// Dhall Text can be decoded into any type which implements
+// encoding.TextUnmarshaler, as well as url.URL and time.Duration.  A
+// time.Duration can also be decoded from a record with `seconds` and
+// `nanos` fields.  Dhall Text can also be decoded into a byte slice or
+// array, as its UTF-8 encoding.  (This version of the Dhall standard
+// has no Bytes type.)
+//
+// A Dhall List can be decoded into a slice, or into an array of the
+// same length.
+//
+// A Dhall union value can be decoded into a struct with one field
+// per alternative, matched by name in the same way as record fields.
+// The field for the alternative present in the union value is set,
+// and all other fields are left as their zero value.  Fields for
+// alternatives with a value are typically pointers; fields for empty
+// alternatives must be bools, and are set to true.  Unions where every
+// alternative is empty (ie enums) can also be decoded into a string,
+// holding the alternative name.
+//
+// A Dhall function can be decoded into a Go function type with one
+// parameter per Dhall argument.  The Go function's results are decoded
+// from the Dhall function's result: a single result directly, and
+// several results by position from the fields `_1`, `_2` and so on of
+// a Dhall record.  If the Go function's last result is an error, any
+// failure to encode its arguments or decode its results is returned
+// there; otherwise, the Go function panics.  Go functions can be
+// passed as arguments where the Dhall function expects a function;
+// see core.NewCallable.
 func Decode(e core.Value, out interface{}) error {
-	v := reflect.ValueOf(out)
-	return decode(e, v.Elem())
+	return DecoderConfig{}.Decode(e, out)
 }
 
 // encode converts a reflect.Value to a core.Value with the given
-// Dhall type
-func encode(val reflect.Value, typ core.Value) (core.Value, error) {
+// Dhall type.  Struct fields without a tag name are named by
+// c.FieldNamer, if it is not nil, and Go functions decode their
+// arguments according to c.
+func encode(val reflect.Value, typ core.Value, c DecoderConfig) (core.Value, error) {
 	if opt, ok := typ.(core.OptionalOf); ok {
 		switch val.Kind() {
 		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
 			reflect.Ptr, reflect.Slice:
 			if val.IsNil() {
-				return core.NoneOf{Type: typ}, nil
+				return core.NoneOf{Type: opt.Type}, nil
 			}
 		}
-		dhallVal, err := encode(val, opt.Type)
+		dhallVal, err := encode(val, opt.Type, c)
 		if err != nil {
 			return nil, err
 		}
 		return core.Some{Val: dhallVal}, nil
 	}
+	if m := marshalerFor(val); m != nil {
+		return encodeMarshaler(m, typ)
+	}
+	if _, ok := typ.(core.Pi); ok && core.AlphaEquivalent(typ, JSONType) {
+		return encodeJSON(val)
+	}
+	if typ == core.Text {
+		text, ok, err := encodeText(val)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return core.PlainTextLit(text), nil
+		}
+	}
+	if r, ok := typ.(core.RecordType); ok && val.Type() == durationType {
+		return encodeDuration(val, r)
+	}
 	switch val.Kind() {
 	case reflect.Bool:
 		if typ == core.Bool {
@@ -138,9 +241,20 @@ func encode(val reflect.Value, typ core.Value) (core.Value, error) {
 			return core.DoubleLit(val.Float()), nil
 		}
 		// no Complex32 or Complex64
-		// no Array
+	case reflect.Array:
+		if typ == core.Text && isBytesType(val.Type()) {
+			return encodeBytes(val)
+		}
+		e, ok := typ.(core.ListOf)
+		if !ok {
+			break
+		}
+		return encodeList(val, e, c)
 		// no Chan
-	case reflect.Func: // not implemented
+	case reflect.Func:
+		if pi, ok := typ.(core.Pi); ok {
+			return encodeFunc(val, pi, c)
+		}
 		// no Interface
 	case reflect.Map:
 		listOf, ok := typ.(core.ListOf)
@@ -151,115 +265,358 @@ func encode(val reflect.Value, typ core.Value) (core.Value, error) {
 		if !ok {
 			break
 		}
-		if !isMapEntryType(mapEntryType) {
+		if !internal.IsMapEntryType(mapEntryType) {
 			break
 		}
 		if val.Len() == 0 {
-			return core.EmptyList{Type: mapEntryType}, nil
+			return core.EmptyList{Type: listOf}, nil
 		}
 		l := make(core.NonEmptyList, val.Len())
-		iter := val.MapRange()
-		i := 0
-		for iter.Next() {
-			key, err := encode(iter.Key(), mapEntryType["mapKey"])
+		for i, k := range sortedMapKeys(val) {
+			key, err := encode(k, mapEntryType["mapKey"], c)
 			if err != nil {
 				return nil, err
 			}
-			val, err := encode(iter.Value(), mapEntryType["mapValue"])
+			value, err := encode(val.MapIndex(k), mapEntryType["mapValue"], c)
 			if err != nil {
 				return nil, err
 			}
 			l[i] = core.RecordLit{
 				"mapKey":   key,
-				"mapValue": val,
+				"mapValue": value,
 			}
-			i++
 		}
 		return l, nil
 	case reflect.Ptr:
-		return encode(val.Elem(), typ)
+		return encode(val.Elem(), typ, c)
 	case reflect.Slice:
+		if typ == core.Text && isBytesType(val.Type()) {
+			return encodeBytes(val)
+		}
 		e, ok := typ.(core.ListOf)
 		if !ok {
 			break
 		}
-		if val.Len() == 0 {
-			return core.EmptyList{Type: e.Type}, nil
-		}
-		l := make(core.NonEmptyList, val.Len())
-		var err error
-		for i := 0; i < val.Len() && err == nil; i++ {
-			l[i], err = encode(val.Index(i), e.Type)
-		}
-		return l, err
+		return encodeList(val, e, c)
 	case reflect.String:
 		if typ == core.Text {
 			return core.PlainTextLit(val.String()), nil
 		}
+		if u, ok := typ.(core.UnionType); ok {
+			alt := val.String()
+			if altType, ok := u[alt]; ok && altType == nil {
+				return core.UnionVal{Type: u, Alternative: alt}, nil
+			}
+		}
 	case reflect.Struct:
+		if u, ok := typ.(core.UnionType); ok {
+			return encodeUnion(val, u, c)
+		}
 		e, ok := typ.(core.RecordType)
 		if !ok {
 			break
 		}
-		rec := core.RecordLit{}
-	fields:
-		for key, typ := range e {
-			var err error
-			structType := val.Type()
-			for i := 0; i < structType.NumField(); i++ {
-				tag := structType.Field(i).Tag.Get("dhall")
-				if key == tag {
-					rec[key], err = encode(val.Field(i), typ)
-					if err != nil {
-						return nil, err
-					}
-					continue fields
-				}
+		return encodeRecord(val, e, c)
+		// no UnsafePointer
+	}
+	return nil, fmt.Errorf("Can't encode %v as %v", val, typ)
+}
+
+// encodeList converts a slice or array to a Dhall list of type typ.
+func encodeList(val reflect.Value, typ core.ListOf, c DecoderConfig) (core.Value, error) {
+	if val.Len() == 0 {
+		return core.EmptyList{Type: typ}, nil
+	}
+	l := make(core.NonEmptyList, val.Len())
+	var err error
+	for i := 0; i < val.Len() && err == nil; i++ {
+		l[i], err = encode(val.Index(i), typ.Type, c)
+	}
+	return l, err
+}
+
+// isBytesType reports whether t is a slice or array of bytes.
+func isBytesType(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) &&
+		t.Elem().Kind() == reflect.Uint8
+}
+
+// encodeBytes converts a slice or array of bytes to Dhall Text.  The
+// bytes must be valid UTF-8.
+func encodeBytes(val reflect.Value) (core.Value, error) {
+	b := make([]byte, val.Len())
+	reflect.Copy(reflect.ValueOf(b), val)
+	if !utf8.Valid(b) {
+		return nil, fmt.Errorf("Can't encode %v as Text: it isn't valid UTF-8", val.Type())
+	}
+	return core.PlainTextLit(b), nil
+}
+
+// sortedMapKeys returns the keys of the map val in a stable order,
+// so that encoding a map always gives the same Dhall list.
+func sortedMapKeys(val reflect.Value) []reflect.Value {
+	keys := val.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16,
+			reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16,
+			reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		}
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	})
+	return keys
+}
+
+// encodeRecord converts a struct to a Dhall record value of type typ.
+// Empty fields tagged with `omitempty` become None or an empty list,
+// if their Dhall type is Optional or List.
+func encodeRecord(val reflect.Value, typ core.RecordType, c DecoderConfig) (core.Value, error) {
+	fields := make(map[string]structField)
+	for _, field := range structFields(val.Type(), c.FieldNamer) {
+		fields[field.name] = field
+	}
+	rec := core.RecordLit{}
+	for key, fieldType := range typ {
+		field, ok := fields[key]
+		if !ok {
+			return nil, fmt.Errorf("Can't encode %v as %v: no field for %s", val, typ, key)
+		}
+		fieldVal, ok := fieldByIndex(val, field.index, false)
+		if !ok {
+			// inside a nil embedded pointer
+			fieldVal = reflect.Zero(field.typ)
+		}
+		if field.omitEmpty && isEmptyValue(fieldVal) {
+			switch fieldType := fieldType.(type) {
+			case core.OptionalOf:
+				rec[key] = core.NoneOf{Type: fieldType.Type}
+				continue
+			case core.ListOf:
+				rec[key] = core.EmptyList{Type: fieldType}
+				continue
 			}
-			rec[key], err = encode(val.FieldByName(key), typ)
-			if err != nil {
-				return nil, err
+		}
+		var err error
+		rec[key], err = encode(fieldVal, fieldType, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
+
+// encodeUnion converts a struct with one field per alternative to a
+// Dhall union value of type typ.  Exactly one of the struct's fields
+// must be set: a non-nil field for an alternative with a value, or a
+// true bool field for an empty alternative.
+func encodeUnion(val reflect.Value, typ core.UnionType, c DecoderConfig) (core.Value, error) {
+	var result core.Value
+	for _, structField := range structFields(val.Type(), c.FieldNamer) {
+		field, ok := fieldByIndex(val, structField.index, false)
+		if !ok || !isSet(field) {
+			continue
+		}
+		alt := structField.name
+		altType, ok := typ[alt]
+		if !ok {
+			return nil, fmt.Errorf("Can't encode %v: %s is not an alternative of %v", val, alt, typ)
+		}
+		if result != nil {
+			return nil, fmt.Errorf("Can't encode %v: more than one alternative is set", val)
+		}
+		if altType == nil {
+			if field.Kind() != reflect.Bool {
+				return nil, fmt.Errorf("Can't encode %v: empty alternative %s must be a bool field", val, alt)
 			}
+			result = core.UnionVal{Type: typ, Alternative: alt}
+			continue
 		}
-		return rec, nil
-		// no UnsafePointer
+		altVal, err := encode(field, altType, c)
+		if err != nil {
+			return nil, err
+		}
+		result = core.UnionVal{Type: typ, Alternative: alt, Val: altVal}
 	}
-	return nil, fmt.Errorf("Can't encode %v as %v", val, typ)
+	if result == nil {
+		return nil, fmt.Errorf("Can't encode %v as %v: no alternative is set", val, typ)
+	}
+	return result, nil
+}
+
+// isSet reports whether a field of a union struct selects its
+// alternative.
+func isSet(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
+		reflect.Ptr, reflect.Slice:
+		return !v.IsNil()
+	}
+	return false
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// funcResults returns the result types of the function type fnType,
+// leaving out a final error result, and whether there is one.
+func funcResults(fnType reflect.Type) ([]reflect.Type, bool) {
+	var outs []reflect.Type
+	for i := 0; i < fnType.NumOut(); i++ {
+		outs = append(outs, fnType.Out(i))
+	}
+	if len(outs) > 0 && outs[len(outs)-1] == errorType {
+		return outs[:len(outs)-1], true
+	}
+	return outs, false
 }
 
 // dhallShim takes a Callable and wraps it so that it can be passed
 // to reflect.MakeFunc().  This means it converts reflect.Value inputs
 // to core.Value inputs, and converts core.Value outputs to
 // reflect.Value outputs.
-func dhallShim(out reflect.Type, dhallFunc core.Callable) func([]reflect.Value) []reflect.Value {
-	return func(args []reflect.Value) []reflect.Value {
+//
+// If the Go arguments can't be encoded, the result can't be decoded,
+// or a Go function passed to dhallFunc fails, the error is returned
+// as the function's final result if it is an error, and panicked with
+// otherwise.
+func (c DecoderConfig) dhallShim(fnType reflect.Type, dhallFunc core.Callable) func([]reflect.Value) []reflect.Value {
+	outs, returnsErr := funcResults(fnType)
+	fail := func(err error) []reflect.Value {
+		if !returnsErr {
+			panic(err)
+		}
+		results := make([]reflect.Value, fnType.NumOut())
+		for i := range outs {
+			results[i] = reflect.Zero(outs[i])
+		}
+		results[len(outs)] = reflect.ValueOf(&err).Elem()
+		return results
+	}
+	return func(args []reflect.Value) (results []reflect.Value) {
+		// a Go function passed as an argument may fail
+		var callErr error
+		defer func() {
+			if callErr != nil {
+				results = fail(callErr)
+			}
+		}()
+		defer catchCallbackError(&callErr)
 		var expr core.Value = dhallFunc
-		for _, arg := range args {
+		for i, arg := range args {
 			fn := expr.(core.Callable)
-			dhallArg, err := encode(arg, fn.ArgType())
+			dhallArg, err := encode(arg, fn.ArgType(), c)
 			if err != nil {
-				// if the func was well-typed, this shouldn't happen
-				panic(err)
+				return fail(fmt.Errorf("Can't encode argument %d: %v", i+1, err))
 			}
 			expr = fn.Call(dhallArg)
 		}
-		ptr := reflect.New(out)
-		err := decode(expr, ptr.Elem())
+		d := &decoder{DecoderConfig: c}
+		results, err := d.decodeResults(expr, outs, "")
+		if err == nil {
+			err = d.mismatchError()
+		}
 		if err != nil {
-			// if the func was well-typed, this shouldn't happen
-			panic(err)
+			return fail(err)
 		}
-		return []reflect.Value{ptr.Elem()}
+		if returnsErr {
+			results = append(results, reflect.Zero(errorType))
+		}
+		return results
 	}
 }
 
-func mkTestVal(t reflect.Type) reflect.Value {
-	if t.Kind() == reflect.Ptr {
-		return reflect.New(t.Elem())
+// mkTestVal returns a Go value of type t which can be encoded as the
+// Dhall type typ, if there is one.  Usually this is the zero value,
+// but union types need one of their alternatives to be selected.
+// Struct fields are named as for encode.
+func mkTestVal(t reflect.Type, typ core.Value, names FieldNamer) reflect.Value {
+	if opt, ok := typ.(core.OptionalOf); ok {
+		typ = opt.Type
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(mkTestVal(t.Elem(), typ, names))
+		return ptr
+	case reflect.Func:
+		// a function which returns zero values
+		return reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value {
+			results := make([]reflect.Value, t.NumOut())
+			for i := range results {
+				results[i] = reflect.Zero(t.Out(i))
+			}
+			return results
+		})
+	case reflect.String:
+		if u, ok := typ.(core.UnionType); ok {
+			var alts []string
+			for alt, altType := range u {
+				if altType == nil {
+					alts = append(alts, alt)
+				}
+			}
+			if len(alts) > 0 {
+				sort.Strings(alts)
+				return reflect.ValueOf(alts[0]).Convert(t)
+			}
+		}
+	case reflect.Struct:
+		val := reflect.New(t).Elem()
+		switch typ := typ.(type) {
+		case core.RecordType:
+			for _, structField := range structFields(t, names) {
+				fieldType, ok := typ[structField.name]
+				if !ok {
+					continue
+				}
+				field, ok := fieldByIndex(val, structField.index, true)
+				if ok && field.CanSet() {
+					field.Set(mkTestVal(structField.typ, fieldType, names))
+				}
+			}
+		case core.UnionType:
+			for _, structField := range structFields(t, names) {
+				altType, ok := typ[structField.name]
+				if !ok {
+					continue
+				}
+				field, ok := fieldByIndex(val, structField.index, true)
+				if !ok || !field.CanSet() {
+					continue
+				}
+				if altType == nil {
+					if field.Kind() == reflect.Bool {
+						field.SetBool(true)
+						break
+					}
+					continue
+				}
+				field.Set(mkTestVal(structField.typ, altType, names))
+				if isSet(field) {
+					break
+				}
+			}
+		}
+		return val
 	}
 	return reflect.Zero(t)
 }
 
+// overflowError returns an error saying that e is out of range for
+// the type of v.
+func overflowError(e core.Value, v reflect.Value) error {
+	return fmt.Errorf("value %v overflows %v", core.Quote(e), v.Type())
+}
+
 // flattenSome(e) returns:
 //
 //  flattenSome(x) if e is Some x
@@ -274,13 +631,19 @@ func flattenSome(e core.Value) core.Value {
 	return e
 }
 
-func decode(e core.Value, v reflect.Value) error {
+func (d *decoder) decode(e core.Value, v reflect.Value, path string) error {
 	e = flattenSome(e)
 	if _, ok := e.(core.NoneOf); ok {
 		// TODO: should we fail if a None doesn't match the type?
 		// (similar to EmptyList below)
 		return nil
 	}
+	if u := unmarshalerFor(v); u != nil {
+		if err := u.UnmarshalDhall(e); err != nil {
+			return d.decodeError(e, v, path, err)
+		}
+		return nil
+	}
 	if c, ok := e.(core.Callable); ok {
 		if c.ArgType() == core.Type {
 			t, err := core.TypeOf(core.Quote(e))
@@ -288,13 +651,13 @@ func decode(e core.Value, v reflect.Value) error {
 				return err
 			}
 			if core.AlphaEquivalent(t, JSONType) {
-				return decodeJSON(e, v)
+				return d.decodeJSON(e, v, path)
 			}
 		}
 	}
 	if v.Kind() == reflect.Ptr {
 		v.Set(reflect.New(v.Type().Elem()))
-		return decode(e, v.Elem())
+		return d.decode(e, v.Elem(), path)
 	}
 types:
 	switch e := e.(type) {
@@ -311,10 +674,16 @@ types:
 		switch v.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16,
 			reflect.Int32, reflect.Int64:
+			if uint64(e) > math.MaxInt64 || v.OverflowInt(int64(e)) {
+				return d.decodeError(e, v, path, overflowError(e, v))
+			}
 			v.SetInt(int64(e))
 			return nil
 		case reflect.Uint, reflect.Uint8, reflect.Uint16,
 			reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if v.OverflowUint(uint64(e)) {
+				return d.decodeError(e, v, path, overflowError(e, v))
+			}
 			v.SetUint(uint64(e))
 			return nil
 		case reflect.Interface:
@@ -325,8 +694,27 @@ types:
 		switch v.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16,
 			reflect.Int32, reflect.Int64:
+			if v.OverflowInt(int64(e)) {
+				return d.decodeError(e, v, path, overflowError(e, v))
+			}
 			v.SetInt(int64(e))
 			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16,
+			reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if e < 0 {
+				if !d.AllowNegativeUnsigned {
+					return d.decodeError(e, v, path, fmt.Errorf("negative value %d can't be stored in unsigned type %v", e, v.Type()))
+				}
+				// the value must fit in the signed type of the
+				// same size
+				if bits := v.Type().Bits(); bits < 64 && int64(e) < -(1<<(bits-1)) {
+					return d.decodeError(e, v, path, overflowError(e, v))
+				}
+			} else if v.OverflowUint(uint64(e)) {
+				return d.decodeError(e, v, path, overflowError(e, v))
+			}
+			v.SetUint(uint64(e))
+			return nil
 		case reflect.Interface:
 			v.Set(reflect.ValueOf(int(e)))
 			return nil
@@ -334,6 +722,9 @@ types:
 	case core.DoubleLit:
 		switch v.Kind() {
 		case reflect.Float32, reflect.Float64:
+			if v.OverflowFloat(float64(e)) {
+				return d.decodeError(e, v, path, overflowError(e, v))
+			}
 			v.SetFloat(float64(e))
 			return nil
 		case reflect.Interface:
@@ -341,6 +732,12 @@ types:
 			return nil
 		}
 	case core.PlainTextLit:
+		if ok, err := decodeText(string(e), v); ok {
+			if err != nil {
+				return d.decodeError(e, v, path, err)
+			}
+			return nil
+		}
 		switch v.Kind() {
 		case reflect.String:
 			v.SetString(string(e))
@@ -348,12 +745,30 @@ types:
 		case reflect.Interface:
 			v.Set(reflect.ValueOf(string(e)))
 			return nil
+		case reflect.Slice:
+			if isBytesType(v.Type()) {
+				v.SetBytes([]byte(e))
+				return nil
+			}
+		case reflect.Array:
+			if isBytesType(v.Type()) {
+				if len(e) != v.Len() {
+					return d.decodeError(e, v, path, fmt.Errorf("%d bytes of Text can't be decoded into an array of length %d", len(e), v.Len()))
+				}
+				reflect.Copy(v, reflect.ValueOf([]byte(e)))
+				return nil
+			}
 		}
 	case core.EmptyList:
 		switch v.Kind() {
 		case reflect.Slice:
 			v.Set(reflect.MakeSlice(v.Type(), 0, 0))
 			return nil
+		case reflect.Array:
+			if v.Len() != 0 {
+				return d.decodeError(e, v, path, fmt.Errorf("a list of length 0 can't be decoded into an array of length %d", v.Len()))
+			}
+			return nil
 		case reflect.Map:
 			// initialise with new (non-nil) value
 			v.Set(reflect.MakeMap(v.Type()))
@@ -361,8 +776,16 @@ types:
 			// it should at least be a mapKey/mapValue type
 			return nil
 		case reflect.Interface:
-			recordType, ok := e.Type.(core.RecordType)
-			if ok && isMapEntryType(recordType) {
+			elemType := e.Type
+			if listOf, ok := elemType.(core.ListOf); ok {
+				elemType = listOf.Type
+			}
+			recordType, ok := elemType.(core.RecordType)
+			if ok && internal.IsMapEntryType(recordType) {
+				if d.PreserveFieldOrder && recordType["mapKey"] == core.Text {
+					v.Set(reflect.ValueOf(OrderedMap{}))
+					return nil
+				}
 				mapType := reflect.TypeOf(map[interface{}]interface{}{})
 				if recordType["mapKey"] == core.Text {
 					// special case for Text keys, since this is such
@@ -379,7 +802,12 @@ types:
 		}
 	case core.NonEmptyList:
 		recordLit, ok := e[0].(core.RecordLit)
-		if ok && isMapEntryType(recordLit) &&
+		if ok && internal.IsMapEntryType(recordLit) && v.Kind() == reflect.Interface && d.PreserveFieldOrder {
+			if _, ok := recordLit["mapKey"].(core.PlainTextLit); ok {
+				return d.decodeOrderedMapEntries(e, v, path)
+			}
+		}
+		if ok && internal.IsMapEntryType(recordLit) &&
 			(v.Kind() == reflect.Map || v.Kind() == reflect.Interface) {
 			mapType := reflect.TypeOf(map[interface{}]interface{}{})
 			if v.Kind() == reflect.Map {
@@ -395,11 +823,12 @@ types:
 				entry := r.(core.RecordLit)
 				key := reflect.New(mapType.Key()).Elem()
 				val := reflect.New(mapType.Elem()).Elem()
-				err := decode(entry["mapKey"], key)
+				entryPath := fmt.Sprintf("%s[%v]", path, core.Quote(entry["mapKey"]))
+				err := d.decode(entry["mapKey"], key, entryPath)
 				if err != nil {
 					return err
 				}
-				err = decode(entry["mapValue"], val)
+				err = d.decode(entry["mapValue"], val, entryPath)
 				if err != nil {
 					return err
 				}
@@ -416,7 +845,7 @@ types:
 			}
 			slice := reflect.MakeSlice(sliceType, len(e), len(e))
 			for i, expr := range e {
-				err := decode(expr, slice.Index(i))
+				err := d.decode(expr, slice.Index(i), fmt.Sprintf("%s[%d]", path, i))
 				if err != nil {
 					return err
 				}
@@ -424,24 +853,28 @@ types:
 			v.Set(slice)
 			return nil
 		}
-	case core.RecordLit:
-		if v.Kind() == reflect.Struct {
-			structType := v.Type()
-			for i := 0; i < structType.NumField(); i++ {
-				// FIXME ignores fields in RecordLit not in Struct
-				tag := structType.Field(i).Tag.Get("dhall")
-				var err error
-				if tag != "" {
-					err = decode(e[tag], v.Field(i))
-				} else {
-					err = decode(e[structType.Field(i).Name], v.Field(i))
-				}
+		if v.Kind() == reflect.Array {
+			if len(e) != v.Len() {
+				return d.decodeError(e, v, path, fmt.Errorf("a list of length %d can't be decoded into an array of length %d", len(e), v.Len()))
+			}
+			for i, expr := range e {
+				err := d.decode(expr, v.Index(i), fmt.Sprintf("%s[%d]", path, i))
 				if err != nil {
 					return err
 				}
 			}
 			return nil
 		}
+	case core.RecordLit:
+		if v.Type() == durationType {
+			return d.decodeDuration(e, v, path)
+		}
+		if v.Kind() == reflect.Struct {
+			return d.decodeRecord(e, v, path)
+		}
+		if v.Kind() == reflect.Interface && d.PreserveFieldOrder {
+			return d.decodeOrderedRecord(e, v, path)
+		}
 		if v.Kind() == reflect.Interface {
 			// decode into a map[string]interface{}
 			var m map[string]interface{}
@@ -451,7 +884,7 @@ types:
 				key := reflect.New(reflect.TypeOf(k)).Elem()
 				val := reflect.New(mapType.Elem()).Elem()
 				key.SetString(k)
-				err := decode(v, val)
+				err := d.decode(v, val, path+"."+k)
 				if err != nil {
 					return err
 				}
@@ -460,16 +893,53 @@ types:
 			v.Set(newMap)
 			return nil
 		}
+	case core.UnionVal:
+		switch v.Kind() {
+		case reflect.Struct:
+			// decode into the field named after the alternative,
+			// leaving all other fields zero
+			v.Set(reflect.Zero(v.Type()))
+			for _, structField := range structFields(v.Type(), d.FieldNamer) {
+				if structField.name != e.Alternative {
+					continue
+				}
+				field, ok := fieldByIndex(v, structField.index, true)
+				if !ok {
+					break types
+				}
+				if e.Val == nil {
+					if field.Kind() != reflect.Bool {
+						break types
+					}
+					field.SetBool(true)
+					return nil
+				}
+				return d.decode(e.Val, field, path+"."+e.Alternative)
+			}
+		case reflect.String:
+			if e.Val == nil {
+				v.SetString(e.Alternative)
+				return nil
+			}
+		case reflect.Interface:
+			// like dhall-to-json, we drop the alternative name
+			// unless it is all we have
+			if e.Val == nil {
+				v.Set(reflect.ValueOf(e.Alternative))
+				return nil
+			}
+			return d.decode(e.Val, v, path)
+		}
 	case core.Callable:
 		if v.Kind() == reflect.Func {
 			fnType := v.Type()
 			if fnType.NumIn() == 0 {
-				return fmt.Errorf("You must decode into a function type with at least one input parameter, not %v", fnType)
+				return d.decodeError(e, v, path, errors.New("You must decode into a function type with at least one input parameter"))
 			}
-			if fnType.NumOut() != 1 {
-				return fmt.Errorf("You must decode into a function type with exactly one output parameter, not %v", fnType)
+			outs, _ := funcResults(fnType)
+			if len(outs) == 0 {
+				return d.decodeError(e, v, path, errors.New("You must decode into a function type with at least one output parameter other than error"))
 			}
-			returnType := fnType.Out(0)
 
 			var result core.Value = e
 			for i := 0; i < fnType.NumIn(); i++ {
@@ -477,37 +947,194 @@ types:
 				if !ok {
 					break types
 				}
-				testValue := mkTestVal(fnType.In(i))
-				testDhallVal, err := encode(testValue, callable.ArgType())
+				testValue := mkTestVal(fnType.In(i), callable.ArgType(), d.FieldNamer)
+				testDhallVal, err := encode(testValue, callable.ArgType(), d.DecoderConfig)
 				if err != nil {
-					return err
+					return d.decodeError(e, v, path, err)
 				}
 				result = callable.Call(testDhallVal)
 			}
-			err := decode(result, reflect.New(fnType.Out(0)).Elem())
-			if err != nil {
+			if _, err := d.decodeResults(result, outs, path); err != nil {
 				return err
 			}
-			fn := reflect.MakeFunc(fnType, dhallShim(returnType, e.(core.Callable)))
+			fn := reflect.MakeFunc(fnType, d.DecoderConfig.dhallShim(fnType, e.(core.Callable)))
 			v.Set(fn)
 			return nil
 		}
 	}
-	return fmt.Errorf("Don't know how to decode %v into %v", e, v.Kind())
+	return d.decodeError(e, v, path, nil)
+}
+
+// decodeResults decodes the result of calling a Dhall function into
+// new values of the Go types outs.  A single result is decoded
+// directly; several results are decoded by position from the fields
+// `_1`, `_2` and so on of a Dhall record.
+func (d *decoder) decodeResults(e core.Value, outs []reflect.Type, path string) ([]reflect.Value, error) {
+	results := make([]reflect.Value, len(outs))
+	for i, out := range outs {
+		results[i] = reflect.New(out).Elem()
+	}
+	if len(outs) == 1 {
+		return results, d.decode(e, results[0], path)
+	}
+	rec, ok := e.(core.RecordLit)
+	if !ok {
+		return nil, fmt.Errorf("Can't decode %v into %d results: expected a record with fields _1 to _%d",
+			core.Quote(e), len(outs), len(outs))
+	}
+	for i := range outs {
+		name := fmt.Sprintf("_%d", i+1)
+		field, ok := rec[name]
+		if !ok {
+			return nil, fmt.Errorf("Can't decode %v into %d results: missing field %s",
+				core.Quote(e), len(outs), name)
+		}
+		if err := d.decode(field, results[i], path+"."+name); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// decodeRecord decodes a record literal into the struct v.  Record
+// fields without a matching struct field, and struct fields without a
+// matching record field, are recorded as mismatches; struct fields
+// without a matching record field are left unchanged.
+func (d *decoder) decodeRecord(e core.RecordLit, v reflect.Value, path string) error {
+	seen := make(map[string]bool, len(e))
+	for _, structField := range structFields(v.Type(), d.FieldNamer) {
+		name := structField.name
+		fieldVal, ok := e[name]
+		if !ok {
+			d.missing = append(d.missing, path+"."+name)
+			continue
+		}
+		seen[name] = true
+		field, ok := fieldByIndex(v, structField.index, true)
+		if !ok {
+			return d.decodeError(fieldVal, v, path+"."+name,
+				errors.New("can't set field of nil pointer to unexported struct"))
+		}
+		err := d.decode(fieldVal, field, path+"."+name)
+		if err != nil {
+			return err
+		}
+	}
+	var unknown []string
+	for name := range e {
+		if !seen[name] {
+			unknown = append(unknown, path+"."+name)
+		}
+	}
+	sort.Strings(unknown)
+	d.unknown = append(d.unknown, unknown...)
+	return nil
 }
 
 // decodeJSON decodes values of Prelude's JSON Type
-func decodeJSON(e core.Value, v reflect.Value) error {
+func (d *decoder) decodeJSON(e core.Value, v reflect.Value, path string) error {
 	e1, ok := e.(core.Callable)
 	if !ok {
-		return errors.New("haven't thought this through yet")
+		return d.decodeError(e, v, path, errors.New("haven't thought this through yet"))
 	}
 	// the value we pass in doesn't matter here
 	val1 := e1.Call(core.Type)
 	e2, ok := val1.(core.Callable)
 	if !ok {
-		return errors.New("haven't thought this through yet")
+		return d.decodeError(e, v, path, errors.New("haven't thought this through yet"))
 	}
 	val := e2.Call(jsonConstructors)
-	return decode(val, v)
+	return d.decode(val, v, path)
+}
+
+// encodeJSON converts val, which is made of maps with string keys,
+// slices, numbers, strings, bools and nils (such as a value produced
+// by encoding/json), into a value of Prelude's JSON Type.
+func encodeJSON(val reflect.Value) (core.Value, error) {
+	body, err := jsonTerm(val)
+	if err != nil {
+		return nil, err
+	}
+	jsonType := core.Quote(JSONType).(term.Pi)
+	constructorsType := jsonType.Body.(term.Pi).Type
+	return core.Eval(term.NewLambda("JSON", term.Type,
+		term.NewLambda("json", constructorsType, body))), nil
+}
+
+// jsonTerm returns the body of the Church-encoded JSON value for val,
+// in a context where `JSON` is the JSON type and `json` is the record
+// of constructors.
+func jsonTerm(val reflect.Value) (term.Term, error) {
+	constructor := func(name string) term.Term {
+		return term.Field{Record: term.NewVar("json"), FieldName: name}
+	}
+	switch val.Kind() {
+	case reflect.Invalid:
+		return constructor("null"), nil
+	case reflect.Interface, reflect.Ptr:
+		if val.IsNil() {
+			return constructor("null"), nil
+		}
+		return jsonTerm(val.Elem())
+	case reflect.Bool:
+		return term.Apply(constructor("bool"), term.BoolLit(val.Bool())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16,
+		reflect.Int32, reflect.Int64:
+		return term.Apply(constructor("integer"), term.IntegerLit(val.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		i := int(val.Uint())
+		if i < 0 || uint64(i) != val.Uint() {
+			return nil, fmt.Errorf("Can't encode %v as JSON: it overflows Integer", val)
+		}
+		return term.Apply(constructor("integer"), term.IntegerLit(i)), nil
+	case reflect.Float32, reflect.Float64:
+		return term.Apply(constructor("double"), term.DoubleLit(val.Float())), nil
+	case reflect.String:
+		return term.Apply(constructor("string"), term.PlainText(val.String())), nil
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.IsNil() {
+			return constructor("null"), nil
+		}
+		if val.Len() == 0 {
+			return term.Apply(constructor("array"),
+				term.EmptyList{Type: term.Apply(term.List, term.NewVar("JSON"))}), nil
+		}
+		l := make(term.NonEmptyList, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			var err error
+			l[i], err = jsonTerm(val.Index(i))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return term.Apply(constructor("array"), l), nil
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if val.IsNil() {
+			return constructor("null"), nil
+		}
+		if val.Len() == 0 {
+			return term.Apply(constructor("object"),
+				term.EmptyList{Type: term.Apply(term.List, term.RecordType{
+					"mapKey":   term.Text,
+					"mapValue": term.NewVar("JSON"),
+				})}), nil
+		}
+		l := make(term.NonEmptyList, 0, val.Len())
+		for _, k := range sortedMapKeys(val) {
+			value, err := jsonTerm(val.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, term.RecordLit{
+				"mapKey":   term.PlainText(k.String()),
+				"mapValue": value,
+			})
+		}
+		return term.Apply(constructor("object"), l), nil
+	}
+	return nil, fmt.Errorf("Can't encode %v as JSON", val)
 }
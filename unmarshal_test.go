@@ -1,7 +1,15 @@
 package dhall_test
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/url"
 	"reflect"
+	"strings"
+	"time"
 
 	. "github.com/philandstuff/dhall-golang/v6"
 	"github.com/philandstuff/dhall-golang/v6/core"
@@ -38,6 +46,86 @@ type testTaggedStruct struct {
 	Bar string
 }
 
+type testEmbedded struct {
+	Foo uint
+	Bar string `dhall:"bar"`
+}
+
+type testStructWithOptions struct {
+	testEmbedded
+	Skipped string           `dhall:"-"`
+	Nested  testTaggedStruct `dhall:",inline"`
+	Bar     string           // shadows testEmbedded.Bar
+}
+
+// TestEmbedded is exported so that decoding can allocate it when it
+// is embedded as a pointer.
+type TestEmbedded testEmbedded
+
+type testStructWithPointer struct {
+	*TestEmbedded
+	Baz bool
+}
+
+type testUnion struct {
+	Postgres *testStruct
+	Sqlite   *string `dhall:"sqlite"`
+	Memory   bool
+}
+
+var testUnionType = core.UnionType{
+	"Postgres": core.RecordType{"Foo": core.Natural, "Bar": core.Text},
+	"sqlite":   core.Text,
+	"Memory":   nil,
+}
+
+// testSize implements Unmarshaler and Marshaler, and is represented
+// in Dhall as a record with an amount and a unit.
+type testSize uint64
+
+var testSizeUnit = core.UnionType{"KB": nil, "MB": nil}
+
+func (s *testSize) UnmarshalDhall(v core.Value) error {
+	var size struct {
+		Amount uint64 `dhall:"amount"`
+		Unit   string `dhall:"unit"`
+	}
+	if err := Decode(v, &size); err != nil {
+		return err
+	}
+	switch size.Unit {
+	case "KB":
+		*s = testSize(size.Amount << 10)
+	case "MB":
+		*s = testSize(size.Amount << 20)
+	default:
+		return fmt.Errorf("unknown unit %s", size.Unit)
+	}
+	return nil
+}
+
+func (s testSize) MarshalDhall() (core.Value, error) {
+	if s%(1<<20) == 0 {
+		return core.RecordLit{
+			"amount": core.NaturalLit(s >> 20),
+			"unit":   core.UnionVal{Type: testSizeUnit, Alternative: "MB"},
+		}, nil
+	}
+	if s%(1<<10) != 0 {
+		return nil, fmt.Errorf("%d is not a whole number of KB", s)
+	}
+	return core.RecordLit{
+		"amount": core.NaturalLit(s >> 10),
+		"unit":   core.UnionVal{Type: testSizeUnit, Alternative: "KB"},
+	}, nil
+}
+
+type testSizes struct {
+	Max  testSize
+	Min  *testSize
+	List []testSize
+}
+
 var _ = Describe("Decode", func() {
 	DescribeTable("Simple types", DecodeAndCompare,
 		Entry("unmarshals DoubleLit into float32",
@@ -103,6 +191,24 @@ var _ = Describe("Decode", func() {
 			core.RecordLit{"baz": core.NaturalLit(3), "Bar": core.PlainTextLit("xyzzy")},
 			new(testTaggedStruct),
 			testTaggedStruct{Foo: 3, Bar: "xyzzy"}),
+		Entry("unmarshals record into struct with embedded and inline structs",
+			core.RecordLit{
+				"Foo": core.NaturalLit(3),
+				"bar": core.PlainTextLit("embedded"),
+				"Bar": core.PlainTextLit("outer"),
+				"baz": core.NaturalLit(4),
+			},
+			&testStructWithOptions{Skipped: "unchanged"},
+			testStructWithOptions{
+				testEmbedded: testEmbedded{Foo: 3, Bar: "embedded"},
+				Skipped:      "unchanged",
+				Nested:       testTaggedStruct{Foo: 4},
+				Bar:          "outer",
+			}),
+		Entry("unmarshals record into struct with embedded pointer",
+			core.RecordLit{"Foo": core.NaturalLit(3), "bar": core.PlainTextLit("x"), "Baz": core.True},
+			new(testStructWithPointer),
+			testStructWithPointer{TestEmbedded: &TestEmbedded{Foo: 3, Bar: "x"}, Baz: true}),
 		Entry("unmarshals None {Foo : Natural, Bar : Text} into struct",
 			core.NoneOf{core.RecordType{"Foo": core.Natural, "Bar": core.Text}},
 			new(testStruct),
@@ -120,6 +226,33 @@ var _ = Describe("Decode", func() {
 			core.EmptyList{core.RecordType{"mapKey": core.Natural, "mapValue": core.Text}},
 			new(map[int]string),
 			map[int]string{}),
+		Entry("unmarshals union with a value into union struct",
+			core.UnionVal{
+				Type:        testUnionType,
+				Alternative: "Postgres",
+				Val:         core.RecordLit{"Foo": core.NaturalLit(3), "Bar": core.PlainTextLit("xyzzy")},
+			},
+			new(testUnion),
+			testUnion{Postgres: &testStruct{Foo: 3, Bar: "xyzzy"}}),
+		Entry("unmarshals union with a value into tagged union struct field",
+			core.UnionVal{
+				Type:        testUnionType,
+				Alternative: "sqlite",
+				Val:         core.PlainTextLit("/tmp/db"),
+			},
+			&testUnion{Memory: true},
+			testUnion{Sqlite: func(s string) *string { return &s }("/tmp/db")}),
+		Entry("unmarshals empty union alternative into union struct",
+			core.UnionVal{Type: testUnionType, Alternative: "Memory"},
+			new(testUnion),
+			testUnion{Memory: true}),
+		Entry("unmarshals enum into string",
+			core.UnionVal{
+				Type:        core.UnionType{"Prod": nil, "Staging": nil},
+				Alternative: "Staging",
+			},
+			new(string),
+			"Staging"),
 	)
 	// Testing various identity functions ensures we support both
 	// encoding and decoding each particular type
@@ -179,6 +312,18 @@ var _ = Describe("Decode", func() {
 				term.RecordType{"mapKey": term.Text, "mapValue": term.Natural}),
 			map[string]uint{"foo": 1, "bar": 2},
 		),
+		Entry("union into union struct",
+			core.Quote(testUnionType),
+			testUnion{Postgres: &testStruct{Foo: 1, Bar: "howdy"}},
+		),
+		Entry("union into union struct with empty alternative",
+			core.Quote(testUnionType),
+			testUnion{Memory: true},
+		),
+		Entry("enum into string",
+			term.UnionType{"Prod": nil, "Staging": nil},
+			"Prod",
+		),
 	)
 	Describe("Function types", func() {
 		It("Decodes the Natural successor function", func() {
@@ -285,6 +430,18 @@ var _ = Describe("Decode", func() {
 			core.RecordLit{"foo": core.PlainTextLit("bar")},
 			new(interface{}),
 			map[string]interface{}{"foo": "bar"}),
+		Entry("union as its value",
+			core.UnionVal{
+				Type:        testUnionType,
+				Alternative: "sqlite",
+				Val:         core.PlainTextLit("/tmp/db"),
+			},
+			new(interface{}),
+			"/tmp/db"),
+		Entry("empty union alternative as string",
+			core.UnionVal{Type: testUnionType, Alternative: "Memory"},
+			new(interface{}),
+			"Memory"),
 	)
 	// TODO expected errors
 })
@@ -343,6 +500,73 @@ var _ = Describe("Unmarshal", func() {
 				λ(x : Text) → "Hello, " ++ x ++ "!"
 			`, new(func(string) string), "Brian", "Hello, Brian!"),
 		)
+		It("Decodes a function with an error result", func() {
+			var fn func(uint) (uint, error)
+			err := Unmarshal([]byte(`λ(x : Natural) → x + 1`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			result, err := fn(37)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(uint(38)))
+		})
+		It("Decodes multiple results by position from a record", func() {
+			var fn func(uint) (uint, string, error)
+			err := Unmarshal([]byte(`λ(x : Natural) → { _1 = x + 1, _2 = Natural/show x }`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			n, s, err := fn(37)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(uint(38)))
+			Expect(s).To(Equal("37"))
+		})
+		It("Returns decoding failures through the error result", func() {
+			var fn func(uint8) (uint8, error)
+			err := Unmarshal([]byte(`λ(x : Natural) → x * 1000`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = fn(1)
+			var decodeErr *DecodeError
+			Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		})
+		It("Passes Go functions to Dhall functions", func() {
+			var fn func(func(string) string) struct{ Name string }
+			err := Unmarshal([]byte(`λ(slug : Text → Text) → { Name = slug "Hello World" }`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			result := fn(func(s string) string {
+				return strings.ToLower(strings.Replace(s, " ", "-", -1))
+			})
+			Expect(result.Name).To(Equal("hello-world"))
+		})
+		It("Passes Go functions with several parameters to Dhall functions", func() {
+			var fn func(func(uint, uint) uint) uint
+			err := Unmarshal([]byte(`λ(f : Natural → Natural → Natural) → f (f 1 2) 3`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fn(func(a, b uint) uint { return a*10 + b })).To(Equal(uint(123)))
+		})
+		It("Reports errors from Go functions passed to Dhall functions", func() {
+			var fn func(func(string) (string, error)) (string, error)
+			err := Unmarshal([]byte(`λ(f : Text → Text) → f "x"`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = fn(func(string) (string, error) { return "", errors.New("failed") })
+			Expect(err).To(HaveOccurred())
+		})
+		It("Passes empty omitempty fields to Dhall functions as None", func() {
+			type options struct {
+				Name    string
+				Comment string `dhall:"comment,omitempty"`
+			}
+			var fn func(options) (string, error)
+			err := Unmarshal([]byte(`
+				λ(o : { Name : Text, comment : Optional Text }) →
+					merge { None = o.Name, Some = λ(c : Text) → c } o.comment
+			`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fn(options{Name: "a"})).To(Equal("a"))
+			Expect(fn(options{Name: "a", Comment: "b"})).To(Equal("b"))
+		})
+		It("Panics on decoding failures without an error result", func() {
+			var fn func(uint8) uint8
+			err := Unmarshal([]byte(`λ(x : Natural) → x * 1000`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(func() { fn(1) }).To(Panic())
+		})
 		DescribeTable("Expected type errors", ExpectUnmarshalError,
 			Entry("Incompatible output parameter type", `
 				λ(x : Natural) → x
@@ -350,9 +574,15 @@ var _ = Describe("Unmarshal", func() {
 			Entry("No output parameters", `
 				λ(x : Natural) → x
 			`, new(func(uint))),
-			Entry("Multiple output parameters", `
+			Entry("Only an error output parameter", `
+				λ(x : Natural) → x
+			`, new(func(uint) error)),
+			Entry("Multiple output parameters from a non-record", `
 				λ(x : Natural) → x
-			`, new(func(uint) (uint, error))),
+			`, new(func(uint) (uint, string))),
+			Entry("Multiple output parameters from a record without positional fields", `
+				λ(x : Natural) → { a = x, b = Natural/show x }
+			`, new(func(uint) (uint, string))),
 			Entry("No input parameters", `
 				λ(x : Natural) → x
 			`, new(func() uint)),
@@ -361,6 +591,119 @@ var _ = Describe("Unmarshal", func() {
 			`, new(func(string) uint)),
 		)
 	})
+	DescribeTable("Types implementing Unmarshaler", UnmarshalAndCompare,
+		Entry("value receiver",
+			`{ amount = 2, unit = < KB | MB >.KB }`,
+			new(testSize),
+			testSize(2048)),
+		Entry("nested at every level",
+			`let Unit = < KB | MB >
+			 in { Max = { amount = 1, unit = Unit.MB }
+			    , Min = Some { amount = 1, unit = Unit.KB }
+			    , List = [ { amount = 3, unit = Unit.KB } ]
+			    }`,
+			new(testSizes),
+			testSizes{
+				Max:  testSize(1 << 20),
+				Min:  &[]testSize{1 << 10}[0],
+				List: []testSize{3 << 10},
+			}),
+	)
+	DescribeTable("Numbers at the limits of their Go types", UnmarshalAndCompare,
+		Entry("Natural into uint8", `255`, new(uint8), uint8(255)),
+		Entry("Natural into int8", `127`, new(int8), int8(127)),
+		Entry("Integer into int8", `-128`, new(int8), int8(-128)),
+		Entry("positive Integer into uint16", `+65535`, new(uint16), uint16(65535)),
+		Entry("Double into float32", `1.5`, new(float32), float32(1.5)),
+		Entry("Infinity into float32", `Infinity`, new(float32), float32(math.Inf(1))),
+	)
+	DescribeTable("Numbers out of range of their Go types",
+		func(source string, ptr interface{}, expected string) {
+			err := Unmarshal([]byte(source), ptr)
+			var decodeErr *DecodeError
+			Expect(errors.As(err, &decodeErr)).To(BeTrue())
+			Expect(decodeErr.Err).To(MatchError(expected))
+		},
+		Entry("Natural into uint8", `300`, new(uint8), "value 300 overflows uint8"),
+		Entry("Natural into int8", `128`, new(int8), "value 128 overflows int8"),
+		Entry("Integer into int16", `-32769`, new(int16), "value -32769 overflows int16"),
+		Entry("Integer into uint8", `+256`, new(uint8), "value +256 overflows uint8"),
+		Entry("negative Integer into uint", `-1`, new(uint), "negative value -1 can't be stored in unsigned type uint"),
+		Entry("Double into float32", `1e300`, new(float32), "value 1e+300 overflows float32"),
+	)
+	DescribeTable("Arrays and bytes", UnmarshalAndCompare,
+		Entry("List into array", `[ 1, 2, 3 ]`, new([3]uint), [3]uint{1, 2, 3}),
+		Entry("empty List into empty array", `[] : List Natural`, new([0]uint), [0]uint{}),
+		Entry("nested Lists into arrays", `[ [ True ], [ False ] ]`, new([2][1]bool), [2][1]bool{{true}, {false}}),
+		Entry("Text into byte slice", `"héllo"`, new([]byte), []byte("héllo")),
+		Entry("Text into byte array", `"key"`, new([3]byte), [3]byte{'k', 'e', 'y'}),
+		Entry("List Natural into byte slice", `[ 1, 255 ]`, new([]byte), []byte{1, 255}),
+	)
+	DescribeTable("Arrays of the wrong length",
+		func(source string, ptr interface{}, expected string) {
+			err := Unmarshal([]byte(source), ptr)
+			var decodeErr *DecodeError
+			Expect(errors.As(err, &decodeErr)).To(BeTrue())
+			Expect(decodeErr.Err).To(MatchError(expected))
+		},
+		Entry("short List", `[ 1, 2 ]`, new([3]uint), "a list of length 2 can't be decoded into an array of length 3"),
+		Entry("long List", `[ 1, 2 ]`, new([1]uint), "a list of length 2 can't be decoded into an array of length 1"),
+		Entry("empty List", `[] : List Natural`, new([1]uint), "a list of length 0 can't be decoded into an array of length 1"),
+		Entry("Text", `"ab"`, new([3]byte), "2 bytes of Text can't be decoded into an array of length 3"),
+	)
+	It("Fails to decode a Natural too big for int64", func() {
+		var actual int64
+		err := Decode(core.NaturalLit(math.MaxUint64), &actual)
+		var decodeErr *DecodeError
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		Expect(decodeErr.Err).To(MatchError("value 18446744073709551615 overflows int64"))
+	})
+	DescribeTable("Text into standard library types", UnmarshalAndCompare,
+		Entry("net.IP", `"10.0.0.1"`, new(net.IP), net.IPv4(10, 0, 0, 1)),
+		Entry("*url.URL",
+			`"https://example.com/a?b=c"`,
+			new(*url.URL),
+			&url.URL{Scheme: "https", Host: "example.com", Path: "/a", RawQuery: "b=c"}),
+		Entry("time.Duration", `"1m30s"`, new(time.Duration), 90*time.Second),
+		Entry("time.Duration from record",
+			`{ seconds = 5, nanos = 100 }`,
+			new(time.Duration),
+			5*time.Second+100*time.Nanosecond),
+		Entry("time.Duration from Natural", `1000`, new(time.Duration), time.Microsecond),
+	)
+	It("Decodes Text into big.Int", func() {
+		var actual big.Int
+		err := Unmarshal([]byte(`"123456789012345678901234567890"`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.String()).To(Equal("123456789012345678901234567890"))
+	})
+	It("Returns errors from UnmarshalText", func() {
+		var actual net.IP
+		err := Unmarshal([]byte(`"not an IP"`), &actual)
+		Expect(err).To(HaveOccurred())
+	})
+	It("Returns an error for durations which overflow time.Duration", func() {
+		var actual time.Duration
+		err := Unmarshal([]byte(`{ seconds = 10000000000, nanos = 0 }`), &actual)
+		var decodeErr *DecodeError
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		Expect(decodeErr.Err).To(MatchError("value { nanos = 0, seconds = 10000000000 } overflows time.Duration"))
+
+		err = Unmarshal([]byte(`{ seconds = 9223372036, nanos = 900000000 }`), &actual)
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+	})
+	It("Returns errors for invalid durations", func() {
+		var actual time.Duration
+		err := Unmarshal([]byte(`"5 seconds"`), &actual)
+		Expect(err).To(HaveOccurred())
+	})
+	It("Returns errors from UnmarshalDhall", func() {
+		var actual testSize
+		err := Unmarshal([]byte(`{ amount = 2, unit = "GB" }`), &actual)
+		var decodeErr *DecodeError
+		Expect(errors.As(err, &decodeErr)).To(BeTrue())
+		Expect(decodeErr.Err).To(MatchError("unknown unit GB"))
+	})
 	DescribeTable("Dhall JSON types into Go", UnmarshalAndCompare,
 		Entry("unmarshals JSON.null into pointer",
 			`./dhall-lang/Prelude/JSON/null.dhall`,
@@ -405,4 +748,39 @@ var _ = Describe("Unmarshal", func() {
 			new(bool),
 			true),
 	)
+	DescribeTable("Go values into Dhall JSON types",
+		func(input interface{}, expected interface{}) {
+			var fn func(interface{}) interface{}
+			err := Unmarshal([]byte(`
+let JSON = ./dhall-lang/Prelude/JSON/package.dhall
+in  λ(j : JSON.Type) → JSON.array [ j ]`), &fn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fn(input)).To(Equal([]interface{}{expected}))
+		},
+		Entry("nil", nil, nil),
+		Entry("string", "foo", "foo"),
+		Entry("bool", true, true),
+		Entry("int", 3, 3),
+		Entry("uint", uint8(3), 3),
+		Entry("float64", 2.5, 2.5),
+		Entry("empty slice", []interface{}{}, []interface{}{}),
+		Entry("slice", []string{"a", "b"}, []interface{}{"a", "b"}),
+		Entry("empty map", map[string]interface{}{}, map[string]interface{}{}),
+		Entry("nested values",
+			map[string]interface{}{"a": []interface{}{1, "b", nil}, "c": map[string]bool{"d": false}},
+			map[string]interface{}{"a": []interface{}{1, "b", nil}, "c": map[string]interface{}{"d": false}}),
+	)
+	It("Fails to encode values with no JSON equivalent", func() {
+		var fn func(interface{}) interface{}
+		err := Unmarshal([]byte(`λ(j : ./dhall-lang/Prelude/JSON/Type.dhall) → j`), &fn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(func() { fn(make(chan int)) }).To(Panic())
+	})
+	It("Returns encoding failures through the error result", func() {
+		var fn func(interface{}) (interface{}, error)
+		err := Unmarshal([]byte(`λ(j : ./dhall-lang/Prelude/JSON/Type.dhall) → j`), &fn)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = fn(make(chan int))
+		Expect(err).To(HaveOccurred())
+	})
 })